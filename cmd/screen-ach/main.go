@@ -0,0 +1,133 @@
+// Copyright The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+// screen-ach screens NACHA files with a Watchman server before they're released.
+//
+//	screen-ach [-address http://localhost:8084] [-minMatch 0.70] [-blockMatch 0.95] file.ach ...
+//
+// The screening results are written to stdout as JSON. The exit code is 0 when every file is clear,
+// 2 when a file needs a review, 3 when a file is blocked and 1 on errors.
+package main
+
+import (
+	"cmp"
+	"context"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/moov-io/watchman/pkg/search"
+)
+
+const (
+	exitClear  = 0
+	exitError  = 1
+	exitReview = 2
+	exitBlock  = 3
+)
+
+func main() {
+	os.Exit(run(context.Background(), os.Args[1:], os.Stdout, os.Stderr))
+}
+
+func run(ctx context.Context, args []string, stdout, stderr io.Writer) int {
+	fs := flag.NewFlagSet("screen-ach", flag.ContinueOnError)
+	fs.SetOutput(stderr)
+
+	address := fs.String("address", cmp.Or(os.Getenv("WATCHMAN_ADDRESS"), "http://localhost:8084"), "Address of the Watchman server")
+	minMatch := fs.Float64("minMatch", 0, "Lowest score of entities needing a review, the server's default when zero")
+	blockMatch := fs.Float64("blockMatch", 0, "Lowest score of entities blocking a file, the server's default when zero")
+	timeout := fs.Duration("timeout", 60*time.Second, "Timeout of screening each file")
+	if err := fs.Parse(args); err != nil {
+		return exitError
+	}
+	if fs.NArg() == 0 {
+		fmt.Fprintln(stderr, "ERROR: no ACH files given")
+		return exitError
+	}
+
+	params := make(url.Values)
+	if *minMatch > 0 {
+		params.Set("minMatch", strconv.FormatFloat(*minMatch, 'f', -1, 64))
+	}
+	if *blockMatch > 0 {
+		params.Set("blockMatch", strconv.FormatFloat(*blockMatch, 'f', -1, 64))
+	}
+	addr := strings.TrimSuffix(*address, "/") + "/v2/screen/ach"
+	if len(params) > 0 {
+		addr += "?" + params.Encode()
+	}
+
+	httpClient := &http.Client{Timeout: *timeout}
+	encoder := json.NewEncoder(stdout)
+	encoder.SetIndent("", "  ")
+
+	exitCode := exitClear
+	for _, where := range fs.Args() {
+		resp, err := screenFile(ctx, httpClient, addr, where)
+		if err != nil {
+			fmt.Fprintf(stderr, "ERROR: screening %s: %v\n", where, err)
+			return exitError
+		}
+
+		err = encoder.Encode(struct {
+			File string `json:"file"`
+			search.ACHScreeningResponse
+		}{
+			File:                 where,
+			ACHScreeningResponse: resp,
+		})
+		if err != nil {
+			fmt.Fprintf(stderr, "ERROR: writing results of %s: %v\n", where, err)
+			return exitError
+		}
+
+		switch resp.Decision {
+		case search.ScreeningBlock:
+			exitCode = exitBlock
+		case search.ScreeningReview:
+			exitCode = max(exitCode, exitReview)
+		}
+	}
+	return exitCode
+}
+
+func screenFile(ctx context.Context, httpClient *http.Client, addr, where string) (search.ACHScreeningResponse, error) {
+	var out search.ACHScreeningResponse
+
+	fd, err := os.Open(where)
+	if err != nil {
+		return out, fmt.Errorf("opening ACH file: %w", err)
+	}
+	defer fd.Close()
+
+	req, err := http.NewRequestWithContext(ctx, "POST", addr, fd)
+	if err != nil {
+		return out, fmt.Errorf("creating screening request: %w", err)
+	}
+
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return out, fmt.Errorf("screening POST: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		body, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+		return out, fmt.Errorf("screening POST failed with status %d: %s", resp.StatusCode, strings.TrimSpace(string(body)))
+	}
+
+	err = json.NewDecoder(resp.Body).Decode(&out)
+	if err != nil {
+		return out, fmt.Errorf("decoding screening response: %w", err)
+	}
+	return out, nil
+}
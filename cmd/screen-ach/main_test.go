@@ -0,0 +1,61 @@
+// Copyright The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/moov-io/watchman/pkg/search"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestRun(t *testing.T) {
+	var path, query string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		path, query = r.URL.Path, r.URL.RawQuery
+
+		body, _ := io.ReadAll(r.Body)
+		decision := search.ScreeningClear
+		if bytes.Contains(body, []byte("blocked")) {
+			decision = search.ScreeningBlock
+		}
+		json.NewEncoder(w).Encode(search.ACHScreeningResponse{Decision: decision})
+	}))
+	t.Cleanup(server.Close)
+
+	dir := t.TempDir()
+	clearFile, blockedFile := filepath.Join(dir, "clear.ach"), filepath.Join(dir, "blocked.ach")
+	require.NoError(t, os.WriteFile(clearFile, []byte("clear"), 0600))
+	require.NoError(t, os.WriteFile(blockedFile, []byte("blocked"), 0600))
+
+	ctx := context.Background()
+
+	var stdout, stderr bytes.Buffer
+	code := run(ctx, []string{"-address", server.URL, "-minMatch", "0.8", clearFile}, &stdout, &stderr)
+	require.Equal(t, exitClear, code, stderr.String())
+	require.Equal(t, "/v2/screen/ach", path)
+	require.Equal(t, "minMatch=0.8", query)
+	require.Contains(t, stdout.String(), `"decision": "clear"`)
+
+	code = run(ctx, []string{"-address", server.URL, clearFile, blockedFile}, &stdout, &stderr)
+	require.Equal(t, exitBlock, code, stderr.String())
+
+	// Errors
+	code = run(ctx, []string{"-address", server.URL}, &stdout, &stderr)
+	require.Equal(t, exitError, code)
+
+	code = run(ctx, []string{"-address", server.URL, filepath.Join(dir, "missing.ach")}, &stdout, &stderr)
+	require.Equal(t, exitError, code)
+	require.Contains(t, stderr.String(), "opening ACH file")
+}
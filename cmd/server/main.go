@@ -27,6 +27,7 @@ import (
 	"github.com/moov-io/watchman/internal/ingest"
 	"github.com/moov-io/watchman/internal/mcp"
 	"github.com/moov-io/watchman/internal/postalpool"
+	"github.com/moov-io/watchman/internal/prepare"
 
 	"github.com/moov-io/watchman/internal/search"
 	"github.com/moov-io/watchman/internal/webui"
@@ -103,6 +104,21 @@ func main() {
 		os.Exit(1)
 	}
 
+	// Setup stopwords, synonyms and abbreviations
+	if err := prepare.ConfigureAnalysis(conf.Analysis); err != nil {
+		logger.Fatal().LogErrorf("problem setting up analysis dictionaries: %v", err)
+		os.Exit(1)
+	}
+
+	// Setup custom name variants (optional)
+	if path := os.Getenv("NAME_VARIANTS_FILE"); path != "" {
+		if err := prepare.LoadNameVariantsFile(path); err != nil {
+			logger.Fatal().LogErrorf("problem setting up name variants: %v", err)
+			os.Exit(1)
+		}
+		logger.Info().Logf("loaded name variants from %s", path)
+	}
+
 	downloader, err := download.NewDownloader(logger, conf.Download, geocodingService)
 	if err != nil {
 		logger.Fatal().LogErrorf("problem setting up downloader: %v", err)
@@ -152,7 +168,7 @@ func main() {
 		logger.Fatal().LogErrorf("problem setting up address parsing pool: %v", err)
 		os.Exit(1)
 	}
-	searchController := search.NewController(logger, searchService, addressParsingPool)
+	searchController := search.NewController(logger, searchService, addressParsingPool, geocodingService)
 	searchController.AppendRoutes(router)
 
 	refreshController := download.NewRefreshController(logger, refreshManager)
@@ -10,32 +10,33 @@ type EntityFormat string
 var (
 	EntityWatchman EntityFormat = "watchman"
 	EntitySenzing  EntityFormat = "senzing"
+	EntityFTM      EntityFormat = "ftm"
 )
 
 func ChooseEntityFormat(headers http.Header, queryParam string) (EntityFormat, string) {
-	// Look for senzing
-	format, sub := findSenzingFormat(headers.Get("Accept"))
-	if format != "" {
-		return format, sub
-	}
-	format, sub = findSenzingFormat(queryParam)
-	if format != "" {
-		return format, sub
+	// Look for senzing or ftm
+	for _, input := range []string{headers.Get("Accept"), queryParam} {
+		format, sub := findEntityFormat(input)
+		if format != "" {
+			return format, sub
+		}
 	}
 
 	// Default
 	return EntityWatchman, "json"
 }
 
-func findSenzingFormat(input string) (EntityFormat, string) {
+func findEntityFormat(input string) (EntityFormat, string) {
 	parts := strings.Split(input, ",")
 
 	for _, part := range parts {
 		mediaType := strings.TrimSpace(strings.Split(part, ";")[0])
 
-		remainder, found := strings.CutPrefix(mediaType, "senzing")
-		if found {
-			return EntitySenzing, strings.TrimPrefix(remainder, "/")
+		for _, format := range []EntityFormat{EntitySenzing, EntityFTM} {
+			remainder, found := strings.CutPrefix(mediaType, string(format))
+			if found {
+				return format, strings.TrimPrefix(remainder, "/")
+			}
 		}
 	}
 
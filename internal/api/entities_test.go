@@ -52,6 +52,20 @@ func TestChooseEntityFormat(t *testing.T) {
 			expectedFormat:    api.EntitySenzing,
 			expectedSubformat: "jsonl",
 		},
+		{
+			name: "header ftm",
+			headers: map[string]string{
+				"accept": "application/json, ftm/json",
+			},
+			expectedFormat:    api.EntityFTM,
+			expectedSubformat: "json",
+		},
+		{
+			name:              "query ftm",
+			queryParam:        "ftm",
+			expectedFormat:    api.EntityFTM,
+			expectedSubformat: "",
+		},
 	}
 
 	for _, tc := range cases {
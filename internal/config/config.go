@@ -10,6 +10,7 @@ import (
 	"github.com/moov-io/watchman/internal/geocoding"
 	"github.com/moov-io/watchman/internal/ingest"
 	"github.com/moov-io/watchman/internal/postalpool"
+	"github.com/moov-io/watchman/internal/prepare"
 	"github.com/moov-io/watchman/internal/search"
 	"github.com/moov-io/watchman/internal/webui"
 
@@ -36,6 +37,8 @@ type Config struct {
 	PostalPool postalpool.Config
 	Geocoding  geocoding.Config
 
+	Analysis prepare.AnalysisConfig
+
 	Ingest ingest.Config
 
 	MCP MCPConfig
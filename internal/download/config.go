@@ -46,4 +46,8 @@ type OpenSanctionsConfig struct {
 type SenzingList struct {
 	SourceList search.SourceList
 	Location   string
+
+	// Format of the file at Location, either "senzing" (the default) or "ftm" for
+	// FollowTheMoney entities such as OpenSanctions' entities.ftm.json
+	Format string
 }
@@ -14,6 +14,8 @@ import (
 
 	"github.com/moov-io/base/log"
 	"github.com/moov-io/base/telemetry"
+	"github.com/moov-io/watchman/internal/geocoding"
+	"github.com/moov-io/watchman/internal/prepare"
 	"github.com/moov-io/watchman/internal/tfidf"
 	"github.com/moov-io/watchman/pkg/search"
 
@@ -47,6 +49,9 @@ type downloader struct {
 // knownDownloadableLists is the set of standard built-in lists that watchman
 // can download.  It is used when validating IgnoredDownloadErrors entries.
 var knownDownloadableLists = []search.SourceList{
+	search.SourceAUDFAT,
+	search.SourceCASEMA,
+	search.SourceCHSECO,
 	search.SourceEUCSL,
 	search.SourceUKCSL,
 	search.SourceUSCSL,
@@ -55,6 +60,11 @@ var knownDownloadableLists = []search.SourceList{
 	search.SourceUSFinCEN311,
 	search.SourceUNCSL,
 	search.SourceUSTEL,
+	search.SourceWorldBankDebarred,
+	search.SourceADBDebarred,
+	search.SourceAfDBDebarred,
+	search.SourceIDBDebarred,
+	search.SourceEBRDDebarred,
 }
 
 func (dl *downloader) RefreshAll(ctx context.Context) (Stats, error) {
@@ -66,6 +76,7 @@ func (dl *downloader) RefreshAll(ctx context.Context) (Stats, error) {
 	stats := Stats{
 		Lists:      make(map[string]int),
 		ListHashes: make(map[string]string),
+		SubLists:   make(map[string]map[string]int),
 		StartedAt:  time.Now().In(time.UTC),
 	}
 
@@ -80,6 +91,16 @@ func (dl *downloader) RefreshAll(ctx context.Context) (Stats, error) {
 		"initial_data_directory": log.String(expandInitialDir(initialDataDirectory(dl.conf))),
 	})
 
+	// Pick up changes to stopwords, synonyms and abbreviations. Entities are normalized with them
+	// here and they're put in use as the entities are swapped into the index.
+	analysis, err := prepare.LoadAnalysis()
+	if err != nil {
+		return stats, fmt.Errorf("reloading analysis dictionaries: %w", err)
+	}
+	if analysis != nil {
+		logger.Info().Log("analysis dictionaries changed, entities will be normalized with them")
+	}
+
 	g, ctx := errgroup.WithContext(ctx)
 	preparedLists := make(chan preparedList, 10)
 
@@ -113,6 +134,17 @@ func (dl *downloader) RefreshAll(ctx context.Context) (Stats, error) {
 			stats.Lists[string(list.ListName)] = len(entities)
 			stats.ListHashes[string(list.ListName)] = list.Hash
 
+			// Consolidated lists also count the entities of each list they're made from
+			for _, entity := range entities {
+				if entity.SubList == "" {
+					continue
+				}
+				if stats.SubLists[string(list.ListName)] == nil {
+					stats.SubLists[string(list.ListName)] = make(map[string]int)
+				}
+				stats.SubLists[string(list.ListName)][entity.SubList]++
+			}
+
 			stats.Entities = append(stats.Entities, entities...)
 		}
 	}()
@@ -249,6 +281,89 @@ func (dl *downloader) RefreshAll(ctx context.Context) (Stats, error) {
 		})
 	}
 
+	// Canadian Autonomous Sanctions List
+	if slices.Contains(requestedLists, search.SourceCASEMA) {
+		listsLoaded = append(listsLoaded, search.SourceCASEMA)
+
+		producerWg.Add(1)
+		g.Go(func() error {
+			defer producerWg.Done()
+
+			err := loadCASEMARecords(ctx, logger, dl.conf, preparedLists)
+			if err != nil {
+				if slices.Contains(ignoredLists, search.SourceCASEMA) {
+					logger.Warn().Logf("ignoring error loading %s: %v", search.SourceCASEMA, err)
+					return nil
+				}
+				return fmt.Errorf("loading CA SEMA records: %w", err)
+			}
+			return nil
+		})
+	}
+
+	// Australian DFAT Consolidated List
+	if slices.Contains(requestedLists, search.SourceAUDFAT) {
+		listsLoaded = append(listsLoaded, search.SourceAUDFAT)
+
+		producerWg.Add(1)
+		g.Go(func() error {
+			defer producerWg.Done()
+
+			err := loadAUDFATRecords(ctx, logger, dl.conf, preparedLists)
+			if err != nil {
+				if slices.Contains(ignoredLists, search.SourceAUDFAT) {
+					logger.Warn().Logf("ignoring error loading %s: %v", search.SourceAUDFAT, err)
+					return nil
+				}
+				return fmt.Errorf("loading AU DFAT records: %w", err)
+			}
+			return nil
+		})
+	}
+
+	// Swiss SECO sanctions list
+	if slices.Contains(requestedLists, search.SourceCHSECO) {
+		listsLoaded = append(listsLoaded, search.SourceCHSECO)
+
+		producerWg.Add(1)
+		g.Go(func() error {
+			defer producerWg.Done()
+
+			err := loadCHSECORecords(ctx, logger, dl.conf, preparedLists)
+			if err != nil {
+				if slices.Contains(ignoredLists, search.SourceCHSECO) {
+					logger.Warn().Logf("ignoring error loading %s: %v", search.SourceCHSECO, err)
+					return nil
+				}
+				return fmt.Errorf("loading CH SECO records: %w", err)
+			}
+			return nil
+		})
+	}
+
+	// Debarment lists of multilateral development banks
+	for _, list := range debarmentLists {
+		if !slices.Contains(requestedLists, list) {
+			continue
+		}
+		listsLoaded = append(listsLoaded, list)
+
+		producerWg.Add(1)
+		g.Go(func() error {
+			defer producerWg.Done()
+
+			err := loadDebarmentRecords(ctx, logger, dl.conf, list, preparedLists)
+			if err != nil {
+				if slices.Contains(ignoredLists, list) {
+					logger.Warn().Logf("ignoring error loading %s: %v", list, err)
+					return nil
+				}
+				return fmt.Errorf("loading %s records: %w", list, err)
+			}
+			return nil
+		})
+	}
+
 	// OpenSanctions lists
 	for _, list := range dl.conf.OpenSanctions.Lists {
 		listsLoaded = append(listsLoaded, normalizeListName(list.SourceList))
@@ -340,7 +455,7 @@ func (dl *downloader) RefreshAll(ctx context.Context) (Stats, error) {
 	})
 
 	// Wait for both producers and consumer to finish
-	err := g.Wait()
+	err = g.Wait()
 	<-resultsDone
 
 	if err != nil {
@@ -349,9 +464,22 @@ func (dl *downloader) RefreshAll(ctx context.Context) (Stats, error) {
 
 	logger.Info().Logf("finished all lists: %v", time.Since(start))
 
+	// Normalize the entities again with changed dictionaries, which searches aren't using yet
+	if analysis != nil {
+		for idx := range stats.Entities {
+			stats.Entities[idx] = stats.Entities[idx].NormalizeWith(analysis)
+		}
+		logger.Info().Logf("normalized %d entities with changed analysis dictionaries", len(stats.Entities))
+	}
+
 	// Build TF-IDF index from all entity names
 	stats.TFIDFIndex = buildTFIDFIndex(logger, stats.Entities)
 
+	// Build the spatial index from geocoded addresses
+	stats.GeoIndex = geocoding.NewIndex(stats.Entities)
+	logger.Info().Logf("built geo index: %d addresses", stats.GeoIndex.Size())
+
+	stats.Analysis = analysis
 	stats.EndedAt = time.Now().In(time.UTC)
 
 	return stats, nil
@@ -0,0 +1,72 @@
+// Copyright The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+package download
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/moov-io/base/log"
+	"github.com/moov-io/base/telemetry"
+	"github.com/moov-io/watchman/pkg/search"
+	"github.com/moov-io/watchman/pkg/sources/au_dfat"
+)
+
+func loadAUDFATRecords(ctx context.Context, logger log.Logger, conf Config, responseCh chan preparedList) error {
+	ctx, span := telemetry.StartSpan(ctx, "load-au-dfat-records")
+	defer span.End()
+
+	start := time.Now()
+
+	files, err := au_dfat.DownloadSanctionsList(ctx, logger, initialDataDirectory(conf))
+	if err != nil {
+		return fmt.Errorf("AU DFAT download: %w", err)
+	}
+
+	span.AddEvent("finished downloading")
+
+	if len(files) == 0 {
+		return fmt.Errorf("unexpected %d AU DFAT files found", len(files))
+	}
+
+	logger.Debug().Logf("finished AU DFAT download: %v", time.Since(start))
+	start = time.Now()
+
+	var records []au_dfat.Record
+	hasher := sha256.New()
+
+	for filename, fd := range files {
+		found, err := au_dfat.Read(io.TeeReader(fd, hasher))
+		if closeErr := fd.Close(); closeErr != nil && err == nil {
+			err = closeErr
+		}
+		if err != nil {
+			return fmt.Errorf("parsing AU DFAT %s: %w", filename, err)
+		}
+		records = append(records, found...)
+	}
+	listHash := hex.EncodeToString(hasher.Sum(nil))
+	entities := au_dfat.GroupEntities(records)
+
+	span.AddEvent("finished parsing")
+
+	logger.Debug().Logf("finished AU DFAT preparation: %d entities in %v", len(entities), time.Since(start))
+
+	if len(entities) == 0 && conf.ErrorOnEmptyList {
+		return errors.New("no entities parsed from AU DFAT")
+	}
+
+	responseCh <- preparedList{
+		ListName: search.SourceAUDFAT,
+		Entities: entities,
+		Hash:     listHash,
+	}
+
+	return nil
+}
@@ -0,0 +1,74 @@
+// Copyright The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+package download
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/moov-io/base/log"
+	"github.com/moov-io/base/telemetry"
+	"github.com/moov-io/watchman/pkg/search"
+	"github.com/moov-io/watchman/pkg/sources/ca_sema"
+)
+
+func loadCASEMARecords(ctx context.Context, logger log.Logger, conf Config, responseCh chan preparedList) error {
+	ctx, span := telemetry.StartSpan(ctx, "load-ca-sema-records")
+	defer span.End()
+
+	start := time.Now()
+
+	files, err := ca_sema.DownloadSanctionsList(ctx, logger, initialDataDirectory(conf))
+	if err != nil {
+		return fmt.Errorf("CA SEMA download: %w", err)
+	}
+
+	span.AddEvent("finished downloading")
+
+	if len(files) == 0 {
+		return fmt.Errorf("unexpected %d CA SEMA files found", len(files))
+	}
+
+	logger.Debug().Logf("finished CA SEMA download: %v", time.Since(start))
+	start = time.Now()
+
+	var entities []search.Entity[search.Value]
+	hasher := sha256.New()
+
+	for filename, fd := range files {
+		// Hash the file while streaming the XML
+		reader := ca_sema.NewReader(io.TeeReader(fd, hasher))
+		err := reader.Read(func(record ca_sema.Record) {
+			entities = append(entities, record.ToEntity())
+		})
+		if closeErr := fd.Close(); closeErr != nil && err == nil {
+			err = closeErr
+		}
+		if err != nil {
+			return fmt.Errorf("parsing CA SEMA %s: %w", filename, err)
+		}
+	}
+	listHash := hex.EncodeToString(hasher.Sum(nil))
+
+	span.AddEvent("finished parsing")
+
+	logger.Debug().Logf("finished CA SEMA preparation: %d entities in %v", len(entities), time.Since(start))
+
+	if len(entities) == 0 && conf.ErrorOnEmptyList {
+		return errors.New("no entities parsed from CA SEMA")
+	}
+
+	responseCh <- preparedList{
+		ListName: search.SourceCASEMA,
+		Entities: entities,
+		Hash:     listHash,
+	}
+
+	return nil
+}
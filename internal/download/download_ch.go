@@ -0,0 +1,71 @@
+// Copyright The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+package download
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/moov-io/base/log"
+	"github.com/moov-io/base/telemetry"
+	"github.com/moov-io/watchman/pkg/search"
+	"github.com/moov-io/watchman/pkg/sources/ch_seco"
+)
+
+func loadCHSECORecords(ctx context.Context, logger log.Logger, conf Config, responseCh chan preparedList) error {
+	ctx, span := telemetry.StartSpan(ctx, "load-ch-seco-records")
+	defer span.End()
+
+	start := time.Now()
+
+	files, err := ch_seco.DownloadSanctionsList(ctx, logger, initialDataDirectory(conf))
+	if err != nil {
+		return fmt.Errorf("CH SECO download: %w", err)
+	}
+
+	span.AddEvent("finished downloading")
+
+	if len(files) == 0 {
+		return fmt.Errorf("unexpected %d CH SECO files found", len(files))
+	}
+
+	logger.Debug().Logf("finished CH SECO download: %v", time.Since(start))
+	start = time.Now()
+
+	var entities []search.Entity[search.Value]
+	hasher := sha256.New()
+
+	for filename, fd := range files {
+		list, err := ch_seco.Read(io.TeeReader(fd, hasher))
+		if closeErr := fd.Close(); closeErr != nil && err == nil {
+			err = closeErr
+		}
+		if err != nil {
+			return fmt.Errorf("parsing CH SECO %s: %w", filename, err)
+		}
+		entities = append(entities, list.ToEntities()...)
+	}
+	listHash := hex.EncodeToString(hasher.Sum(nil))
+
+	span.AddEvent("finished parsing")
+
+	logger.Debug().Logf("finished CH SECO preparation: %d entities in %v", len(entities), time.Since(start))
+
+	if len(entities) == 0 && conf.ErrorOnEmptyList {
+		return errors.New("no entities parsed from CH SECO")
+	}
+
+	responseCh <- preparedList{
+		ListName: search.SourceCHSECO,
+		Entities: entities,
+		Hash:     listHash,
+	}
+
+	return nil
+}
@@ -0,0 +1,110 @@
+// Copyright The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+package download
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/moov-io/base/log"
+	"github.com/moov-io/base/telemetry"
+	"github.com/moov-io/watchman/pkg/search"
+	"github.com/moov-io/watchman/pkg/sources/debarment"
+)
+
+// debarmentLists are the debarment lists of multilateral development banks
+var debarmentLists = []search.SourceList{
+	search.SourceWorldBankDebarred,
+	search.SourceADBDebarred,
+	search.SourceAfDBDebarred,
+	search.SourceIDBDebarred,
+	search.SourceEBRDDebarred,
+}
+
+func loadDebarmentRecords(ctx context.Context, logger log.Logger, conf Config, list search.SourceList, responseCh chan preparedList) error {
+	ctx, span := telemetry.StartSpan(ctx, "load-debarment-records")
+	defer span.End()
+
+	start := time.Now()
+
+	var files map[string]io.ReadCloser
+	var err error
+	if list == search.SourceWorldBankDebarred {
+		files, err = debarment.DownloadWorldBank(ctx, logger, initialDataDirectory(conf))
+	} else {
+		files, err = debarment.DownloadMDB(ctx, logger, initialDataDirectory(conf), list)
+	}
+	if err != nil {
+		return fmt.Errorf("%s download: %w", list, err)
+	}
+
+	span.AddEvent("finished downloading")
+
+	if len(files) == 0 {
+		return fmt.Errorf("unexpected %d %s files found", len(files), list)
+	}
+
+	logger.Debug().Logf("finished %s download: %v", list, time.Since(start))
+	start = time.Now()
+
+	var entities []search.Entity[search.Value]
+	hasher := sha256.New()
+
+	for filename, fd := range files {
+		found, err := readDebarmentFile(io.TeeReader(fd, hasher), list)
+		if closeErr := fd.Close(); closeErr != nil && err == nil {
+			err = closeErr
+		}
+		if err != nil {
+			return fmt.Errorf("parsing %s %s: %w", list, filename, err)
+		}
+		entities = append(entities, found...)
+	}
+	listHash := hex.EncodeToString(hasher.Sum(nil))
+
+	span.AddEvent("finished parsing")
+
+	logger.Debug().Logf("finished %s preparation: %d entities in %v", list, len(entities), time.Since(start))
+
+	if len(entities) == 0 && conf.ErrorOnEmptyList {
+		return fmt.Errorf("no entities parsed from %s", list)
+	}
+
+	responseCh <- preparedList{
+		ListName: list,
+		Entities: entities,
+		Hash:     listHash,
+	}
+
+	return nil
+}
+
+// readDebarmentFile reads the World Bank's JSON response or the CSV export of another institution's list
+func readDebarmentFile(r io.Reader, list search.SourceList) ([]search.Entity[search.Value], error) {
+	var out []search.Entity[search.Value]
+
+	if list == search.SourceWorldBankDebarred {
+		firms, err := debarment.ReadWorldBank(r)
+		if err != nil {
+			return nil, err
+		}
+		for _, firm := range firms {
+			out = append(out, firm.ToEntity())
+		}
+		return out, nil
+	}
+
+	records, err := debarment.ReadMDB(r)
+	if err != nil {
+		return nil, err
+	}
+	for _, record := range records {
+		out = append(out, record.ToEntity(list))
+	}
+	return out, nil
+}
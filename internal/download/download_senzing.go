@@ -9,10 +9,12 @@ import (
 	"io"
 	"os"
 	"slices"
+	"strings"
 
 	"github.com/moov-io/base/log"
 	"github.com/moov-io/watchman/pkg/download"
 	"github.com/moov-io/watchman/pkg/search"
+	"github.com/moov-io/watchman/pkg/sources/ftm"
 	"github.com/moov-io/watchman/pkg/sources/senzing"
 
 	"golang.org/x/sync/errgroup"
@@ -56,8 +58,8 @@ func prepareSenzingRecords(ctx context.Context, logger log.Logger, params senzin
 	return g.Wait()
 }
 
-// processSenzingList downloads and parses one Senzing-format list (used for
-// both config.Senzing and config.OpenSanctions lists). It sends a preparedList
+// processSenzingList downloads and parses one Senzing or FtM formatted list (used
+// for both config.Senzing and config.OpenSanctions lists). It sends a preparedList
 // to responseCh on success. For lists present in params.ignoredLists, download,
 // read, or empty-list errors are logged at warn and suppressed.
 func processSenzingList(ctx context.Context, logger log.Logger, dl *download.Downloader, initialDir string, params senzingDownload, loc SenzingList, responseCh chan preparedList) error {
@@ -89,7 +91,7 @@ func processSenzingList(ctx context.Context, logger log.Logger, dl *download.Dow
 
 	r, hashbuf := hashWriter(contents)
 
-	entities, err := senzing.ReadEntities(r, source)
+	entities, err := readListEntities(r, source, loc.Format)
 	if err != nil {
 		if ignored {
 			logger.Warn().Logf("ignoring error parsing %s: %v", source, err)
@@ -114,6 +116,16 @@ func processSenzingList(ctx context.Context, logger log.Logger, dl *download.Dow
 	return nil
 }
 
+func readListEntities(r io.Reader, source search.SourceList, format string) ([]search.Entity[search.Value], error) {
+	switch strings.ToLower(strings.TrimSpace(format)) {
+	case "", "senzing":
+		return senzing.ReadEntities(r, source)
+	case "ftm":
+		return ftm.ReadEntities(r, source)
+	}
+	return nil, fmt.Errorf("unknown format %q", format)
+}
+
 func calculateHash(input []byte) string {
 	h := sha256.Sum256(input)
 	return hex.EncodeToString(h[:])
@@ -39,3 +39,36 @@ func TestDownloader_Senzing(t *testing.T) {
 	require.Equal(t, 3, stats.Lists["senzing-persons"])
 	require.NotEmpty(t, stats.ListHashes["senzing-persons"])
 }
+
+func TestDownloader_FTM(t *testing.T) {
+	logger := log.NewTestLogger()
+
+	location, err := filepath.Abs(filepath.Join("..", "..", "pkg", "sources", "ftm", "testdata", "entities.ftm.json"))
+	require.NoError(t, err)
+
+	conf := download.Config{
+		OpenSanctions: download.OpenSanctionsConfig{
+			Lists: []download.SenzingList{
+				{
+					SourceList: "opensanctions_sanctions",
+					Location:   "file://" + location,
+					Format:     "ftm",
+				},
+			},
+		},
+	}
+	dl, err := download.NewDownloader(logger, conf, nil)
+	require.NoError(t, err)
+
+	stats, err := dl.RefreshAll(context.Background())
+	require.NoError(t, err)
+	require.Len(t, stats.Entities, 4)
+	require.Equal(t, 4, stats.Lists["opensanctions_sanctions"])
+
+	conf.OpenSanctions.Lists[0].Format = "xml"
+	dl, err = download.NewDownloader(logger, conf, nil)
+	require.NoError(t, err)
+
+	_, err = dl.RefreshAll(context.Background())
+	require.ErrorContains(t, err, `unknown format "xml"`)
+}
@@ -39,6 +39,35 @@ func TestDownloader_RefreshAll_InitialDir(t *testing.T) {
 	require.NotEmpty(t, stats.ListHashes[name])
 }
 
+func TestDownloader_RefreshAll_SubLists(t *testing.T) {
+	logger := log.NewTestLogger()
+	conf := download.Config{
+		InitialDataDirectory: filepath.Join("..", "..", "test", "testdata"),
+		ErrorOnEmptyList:     true,
+		IncludedLists: []search.SourceList{
+			search.SourceUSNonSDN,
+		},
+	}
+
+	dl, err := download.NewDownloader(logger, conf, nil)
+	require.NoError(t, err)
+
+	stats, err := dl.RefreshAll(context.Background())
+	require.NoError(t, err)
+
+	require.Equal(t, map[string]int{"us_non_sdn": 442}, stats.Lists)
+
+	expected := map[string]int{
+		"SSI":    286,
+		"PLC":    78,
+		"CMIC":   68,
+		"NS-MBS": 7,
+		"CAP":    1,
+	}
+	require.Equal(t, expected, stats.SubLists["us_non_sdn"])
+	require.Len(t, stats.ListHashes, 1)
+}
+
 func TestDownloader_Geocode(t *testing.T) {
 	logger := log.NewTestLogger()
 	conf := download.Config{
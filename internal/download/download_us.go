@@ -4,15 +4,23 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"os"
 	"time"
 
 	"github.com/moov-io/base/telemetry"
+	"github.com/moov-io/watchman/pkg/download"
 	"github.com/moov-io/watchman/pkg/search"
 	"github.com/moov-io/watchman/pkg/sources/csl_us"
 	"github.com/moov-io/watchman/pkg/sources/ofac"
 	"github.com/moov-io/watchman/pkg/sources/us_non_sdn"
 
 	"github.com/moov-io/base/log"
+	"github.com/moov-io/base/strx"
+)
+
+var (
+	// useOFACAdvancedXML reads the SDN and Non-SDN lists from OFAC's advanced XML files instead of CSV files
+	useOFACAdvancedXML = strx.Yes(os.Getenv("OFAC_ADVANCED_XML"))
 )
 
 func loadOFACRecords(ctx context.Context, logger log.Logger, conf Config, responseCh chan preparedList) error {
@@ -20,7 +28,11 @@ func loadOFACRecords(ctx context.Context, logger log.Logger, conf Config, respon
 	defer span.End()
 
 	start := time.Now()
-	files, err := ofac.Download(ctx, logger, initialDataDirectory(conf))
+	downloadFiles := ofac.Download
+	if useOFACAdvancedXML {
+		downloadFiles = ofac.DownloadAdvanced
+	}
+	files, err := downloadFiles(ctx, logger, initialDataDirectory(conf))
 	if err != nil {
 		return fmt.Errorf("OFAC download: %v", err)
 	}
@@ -35,14 +47,12 @@ func loadOFACRecords(ctx context.Context, logger log.Logger, conf Config, respon
 	logger.Debug().Logf("finished OFAC download: %v", time.Since(start))
 	start = time.Now()
 
-	res, err := ofac.Read(files)
+	entities, hash, err := readOFACFiles(files)
 	if err != nil {
 		return fmt.Errorf("parsing OFAC: %w", err)
 	}
 	span.AddEvent("finished parsing")
 
-	entities := ofac.GroupIntoEntities(res.SDNs, res.Addresses, res.SDNComments, res.AlternateIdentities)
-
 	logger.Debug().Logf("finished OFAC preparation: %v", time.Since(start))
 	span.AddEvent("finished OFAC preparation")
 
@@ -53,7 +63,7 @@ func loadOFACRecords(ctx context.Context, logger log.Logger, conf Config, respon
 	responseCh <- preparedList{
 		ListName: search.SourceUSOFAC,
 		Entities: entities,
-		Hash:     res.ListHash,
+		Hash:     hash,
 	}
 	return nil
 }
@@ -63,7 +73,11 @@ func loadUSNonSDNRecords(ctx context.Context, logger log.Logger, conf Config, re
 	defer span.End()
 
 	start := time.Now()
-	files, err := us_non_sdn.Download(ctx, logger, initialDataDirectory(conf))
+	downloadFiles := us_non_sdn.Download
+	if useOFACAdvancedXML {
+		downloadFiles = us_non_sdn.DownloadAdvanced
+	}
+	files, err := downloadFiles(ctx, logger, initialDataDirectory(conf))
 	if err != nil {
 		return fmt.Errorf("US Non-SDN download: %v", err)
 	}
@@ -79,16 +93,12 @@ func loadUSNonSDNRecords(ctx context.Context, logger log.Logger, conf Config, re
 	start = time.Now()
 
 	// The US Non-SDN list downloads OFAC-compatible files
-	res, err := ofac.Read(files)
+	entities, hash, err := readOFACFiles(files, ofac.WithSourceList(search.SourceUSNonSDN), us_non_sdn.WithSubList())
 	if err != nil {
 		return fmt.Errorf("parsing US Non-SDN: %w", err)
 	}
 	span.AddEvent("finished parsing")
 
-	entities := ofac.GroupIntoEntities(res.SDNs, res.Addresses, res.SDNComments, res.AlternateIdentities,
-		ofac.WithSourceList(search.SourceUSNonSDN),
-	)
-
 	logger.Debug().Logf("finished US Non-SDN preparation: %v", time.Since(start))
 	span.AddEvent("finished US Non-SDN preparation")
 
@@ -99,11 +109,28 @@ func loadUSNonSDNRecords(ctx context.Context, logger log.Logger, conf Config, re
 	responseCh <- preparedList{
 		ListName: search.SourceUSNonSDN,
 		Entities: entities,
-		Hash:     res.ListHash,
+		Hash:     hash,
 	}
 	return nil
 }
 
+// readOFACFiles parses either the CSV or advanced XML files published by OFAC
+func readOFACFiles(files download.Files, overrides ...ofac.MappingOverride) ([]search.Entity[search.Value], string, error) {
+	if useOFACAdvancedXML {
+		res, err := ofac.ReadAdvanced(files)
+		if err != nil {
+			return nil, "", err
+		}
+		return ofac.GroupAdvancedEntities(res.Entries, overrides...), res.ListHash, nil
+	}
+
+	res, err := ofac.Read(files)
+	if err != nil {
+		return nil, "", err
+	}
+	return ofac.GroupIntoEntities(res.SDNs, res.Addresses, res.SDNComments, res.AlternateIdentities, overrides...), res.ListHash, nil
+}
+
 func loadCSLUSRecords(ctx context.Context, logger log.Logger, conf Config, responseCh chan preparedList) error {
 	ctx, span := telemetry.StartSpan(ctx, "load-us-csl-records")
 	defer span.End()
@@ -3,6 +3,8 @@ package download
 import (
 	"time"
 
+	"github.com/moov-io/watchman/internal/geocoding"
+	"github.com/moov-io/watchman/internal/prepare"
 	"github.com/moov-io/watchman/internal/tfidf"
 	"github.com/moov-io/watchman/pkg/search"
 )
@@ -14,11 +16,21 @@ type Stats struct {
 	// This is built from all entity names after loading and used during search.
 	TFIDFIndex *tfidf.Index `json:"-"`
 
+	// GeoIndex contains the coordinates of geocoded addresses for radius searches.
+	GeoIndex *geocoding.Index `json:"-"`
+
 	Lists      map[string]int    `json:"lists"`
 	ListHashes map[string]string `json:"listHashes"`
 
+	// SubLists is the entity count of each list a consolidated list is made from, e.g. "EL" within "us_csl"
+	SubLists map[string]map[string]int `json:"subLists,omitempty"`
+
 	StartedAt time.Time `json:"startedAt"`
 	EndedAt   time.Time `json:"endedAt"`
 
 	Version string `json:"version"`
+
+	// Analysis holds changed analysis dictionaries the entities were normalized with. They're
+	// put in use as the entities are swapped into the index.
+	Analysis *prepare.Analysis `json:"-"`
 }
@@ -14,6 +14,7 @@ func Equal[T any](tb testing.TB, e1, e2 search.Entity[T]) {
 	require.Equal(tb, e1.Source, e2.Source)
 
 	require.Equal(tb, e1.SourceID, e2.SourceID)
+	require.Equal(tb, e1.SubList, e2.SubList)
 
 	if e1.Person != nil && e2.Person != nil {
 		require.Equal(tb, e1.Person.Name, e2.Person.Name)
@@ -26,6 +27,7 @@ func Equal[T any](tb testing.TB, e1, e2 search.Entity[T]) {
 		require.Equal(tb, e1.Person.DeathDate, e2.Person.DeathDate)
 
 		require.ElementsMatch(tb, e1.Person.Titles, e2.Person.Titles)
+		require.ElementsMatch(tb, e1.Person.Positions, e2.Person.Positions)
 		require.ElementsMatch(tb, e1.Person.GovernmentIDs, e2.Person.GovernmentIDs)
 	}
 
@@ -81,12 +83,15 @@ func Equal[T any](tb testing.TB, e1, e2 search.Entity[T]) {
 	require.ElementsMatch(tb, e1.Contact.PhoneNumbers, e2.Contact.PhoneNumbers)
 	require.ElementsMatch(tb, e1.Contact.FaxNumbers, e2.Contact.FaxNumbers)
 	require.ElementsMatch(tb, e1.Contact.Websites, e2.Contact.Websites)
+	require.Equal(tb, e1.Contact.Country, e2.Contact.Country)
 
 	require.ElementsMatch(tb, e1.Addresses, e2.Addresses)
 	require.ElementsMatch(tb, e1.CryptoAddresses, e2.CryptoAddresses)
 
 	require.ElementsMatch(tb, e1.Affiliations, e2.Affiliations)
 	require.Equal(tb, e1.SanctionsInfo, e2.SanctionsInfo)
+	require.ElementsMatch(tb, e1.Topics, e2.Topics)
+	require.Equal(tb, e1.Debarment, e2.Debarment)
 	require.ElementsMatch(tb, e1.HistoricalInfo, e2.HistoricalInfo)
 
 	// require.Equal(tb, e1.PreparedFields, e2.PreparedFields) // TODO(adam): want to check these?
@@ -10,16 +10,23 @@ type Config struct {
 	// Provider configuration for the geocoding API.
 	Provider ProviderConfig
 
+	// Gazetteer configures offline geocoding from a local GeoNames file.
+	// It's used as the "gazetteer" provider or before calling another provider.
+	Gazetteer GazetteerConfig
+
 	// RateLimit configuration to control API request rates.
 	RateLimit RateLimitConfig
 
 	// Cache configuration for L1 (in-memory) and L2 (database) caching.
 	Cache CacheConfig
+
+	// Query configures geocoding the addresses of search requests.
+	Query QueryConfig
 }
 
 // ProviderConfig holds settings for a geocoding provider.
 type ProviderConfig struct {
-	// Name of the provider: "opencage", "google", or "nominatim"
+	// Name of the provider: "opencage", "google", "nominatim", or "gazetteer"
 	Name string
 
 	// APIKey for authentication with the provider.
@@ -34,6 +41,12 @@ type ProviderConfig struct {
 	Timeout time.Duration
 }
 
+// GazetteerConfig holds settings for offline geocoding.
+type GazetteerConfig struct {
+	// File is the path to a GeoNames dump (e.g. allCountries.txt, cities500.txt or their .zip file).
+	File string
+}
+
 // RateLimitConfig controls the rate of geocoding API requests.
 type RateLimitConfig struct {
 	// RequestsPerSecond defines the sustained request rate.
@@ -59,6 +72,18 @@ type CacheConfig struct {
 	L2Enabled bool
 }
 
+// QueryConfig controls geocoding of search request addresses. Lookups add latency to searches
+// and share the rate limit used while refreshing, so they're disabled by default.
+type QueryConfig struct {
+	// Enabled geocodes the addresses of search requests.
+	Enabled bool
+
+	// Timeout limits how long a search waits on geocoding. Addresses which aren't
+	// geocoded in time are searched without coordinates.
+	// Default: 2s
+	Timeout time.Duration
+}
+
 // DefaultConfig returns a Config with sensible defaults.
 func DefaultConfig() Config {
 	return Config{
@@ -76,5 +101,9 @@ func DefaultConfig() Config {
 			L1TTL:     24 * time.Hour,
 			L2Enabled: true,
 		},
+		Query: QueryConfig{
+			Enabled: false,
+			Timeout: 2 * time.Second,
+		},
 	}
 }
@@ -0,0 +1,256 @@
+package geocoding
+
+import (
+	"archive/zip"
+	"bufio"
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/moov-io/watchman/internal/norm"
+	"github.com/moov-io/watchman/internal/prepare"
+	"github.com/moov-io/watchman/pkg/search"
+)
+
+// GazetteerGeocoder implements the Geocoder interface from a local GeoNames dump.
+// Addresses are resolved to the coordinates of their city, state or country without
+// any network calls.
+//
+// See https://download.geonames.org/export/dump/ for the files. allCountries.txt includes
+// states and countries while the smaller cities files (e.g. cities500.txt) only include cities.
+type GazetteerGeocoder struct {
+	// places are keyed by "country|name" and "country|admin1|name"
+	places map[string]gazetteerPlace
+
+	// regions (first-level administrative divisions) are keyed by "country|admin1"
+	regions map[string]gazetteerPlace
+	// regionCodes maps "country|name" and "country|admin1" of a region onto its admin1 code
+	regionCodes map[string]string
+
+	// countries are keyed by their ISO 3166 alpha-2 code
+	countries map[string]gazetteerPlace
+}
+
+type gazetteerPlace struct {
+	latitude   float64
+	longitude  float64
+	population int64
+}
+
+// NewGazetteerGeocoder loads the GeoNames file at conf.File. Zip files from the GeoNames
+// downloads are read directly.
+func NewGazetteerGeocoder(conf GazetteerConfig) (*GazetteerGeocoder, error) {
+	if conf.File == "" {
+		return nil, fmt.Errorf("gazetteer file is required")
+	}
+
+	var r io.Reader
+	if strings.EqualFold(filepath.Ext(conf.File), ".zip") {
+		zr, err := zip.OpenReader(conf.File)
+		if err != nil {
+			return nil, fmt.Errorf("opening gazetteer zip: %w", err)
+		}
+		defer zr.Close()
+
+		var found *zip.File
+		for _, f := range zr.File {
+			if strings.EqualFold(filepath.Ext(f.Name), ".txt") && !strings.HasPrefix(strings.ToLower(f.Name), "readme") {
+				found = f
+				break
+			}
+		}
+		if found == nil {
+			return nil, fmt.Errorf("no gazetteer file found in %s", conf.File)
+		}
+
+		fd, err := found.Open()
+		if err != nil {
+			return nil, fmt.Errorf("opening %s in gazetteer zip: %w", found.Name, err)
+		}
+		defer fd.Close()
+
+		r = fd
+	} else {
+		fd, err := os.Open(conf.File)
+		if err != nil {
+			return nil, fmt.Errorf("opening gazetteer file: %w", err)
+		}
+		defer fd.Close()
+
+		r = fd
+	}
+
+	g, err := readGazetteer(r)
+	if err != nil {
+		return nil, fmt.Errorf("reading gazetteer %s: %w", conf.File, err)
+	}
+	return g, nil
+}
+
+// GeoNames columns, see the "geoname" table at https://download.geonames.org/export/dump/readme.txt
+const (
+	geonamesName           = 1
+	geonamesASCIIName      = 2
+	geonamesAlternateNames = 3
+	geonamesLatitude       = 4
+	geonamesLongitude      = 5
+	geonamesFeatureClass   = 6
+	geonamesFeatureCode    = 7
+	geonamesCountryCode    = 8
+	geonamesAdmin1Code     = 10
+	geonamesPopulation     = 14
+
+	geonamesMinColumns = 15
+)
+
+// gazetteerAlternateNamesMinPopulation is the population a city needs for its alternate names to be
+// indexed. allCountries.txt lists alternate names in many languages for millions of places and
+// indexing all of them takes tens of millions of keys. Smaller places are found by their name and
+// ASCII name.
+const gazetteerAlternateNamesMinPopulation = 15000
+
+func readGazetteer(r io.Reader) (*GazetteerGeocoder, error) {
+	g := &GazetteerGeocoder{
+		places:      make(map[string]gazetteerPlace),
+		regions:     make(map[string]gazetteerPlace),
+		regionCodes: make(map[string]string),
+		countries:   make(map[string]gazetteerPlace),
+	}
+
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 0, 64*1024), 4*1024*1024) // alternate names can be long
+
+	var line int
+	for scanner.Scan() {
+		line++
+
+		columns := strings.Split(scanner.Text(), "\t")
+		if len(columns) < geonamesMinColumns {
+			continue
+		}
+
+		lat, err := strconv.ParseFloat(columns[geonamesLatitude], 64)
+		if err != nil {
+			return nil, fmt.Errorf("line %d: parsing latitude: %w", line, err)
+		}
+		lon, err := strconv.ParseFloat(columns[geonamesLongitude], 64)
+		if err != nil {
+			return nil, fmt.Errorf("line %d: parsing longitude: %w", line, err)
+		}
+		population, _ := strconv.ParseInt(columns[geonamesPopulation], 10, 64)
+
+		place := gazetteerPlace{latitude: lat, longitude: lon, population: population}
+		country := strings.ToLower(columns[geonamesCountryCode])
+		admin1 := strings.ToLower(columns[geonamesAdmin1Code])
+
+		switch columns[geonamesFeatureClass] {
+		case "P": // cities, towns and villages
+			for _, name := range gazetteerNames(columns, population >= gazetteerAlternateNamesMinPopulation) {
+				addPlace(g.places, country+"|"+name, place)
+				addPlace(g.places, country+"|"+admin1+"|"+name, place)
+			}
+			if admin1 != "" {
+				g.regionCodes[country+"|"+admin1] = admin1
+			}
+
+		case "A":
+			switch columns[geonamesFeatureCode] {
+			case "ADM1":
+				addPlace(g.regions, country+"|"+admin1, place)
+				g.regionCodes[country+"|"+admin1] = admin1
+				for _, name := range gazetteerNames(columns, true) {
+					g.regionCodes[country+"|"+name] = admin1
+				}
+
+			case "PCL", "PCLD", "PCLF", "PCLI", "PCLIX", "PCLS", "TERR":
+				addPlace(g.countries, country, place)
+			}
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	return g, nil
+}
+
+// gazetteerNames returns the normalized name and ASCII name of a row, along with its alternate names when alternates is true.
+func gazetteerNames(columns []string, alternates bool) []string {
+	names := []string{columns[geonamesName], columns[geonamesASCIIName]}
+	if alternates {
+		names = append(names, strings.Split(columns[geonamesAlternateNames], ",")...)
+	}
+
+	out := make([]string, 0, len(names))
+	for _, name := range names {
+		if name = prepare.LowerAndRemovePunctuation(name); name != "" {
+			out = append(out, name)
+		}
+	}
+	return out
+}
+
+// addPlace keeps the most populous place for each key
+func addPlace(places map[string]gazetteerPlace, key string, place gazetteerPlace) {
+	if existing, exists := places[key]; !exists || place.population > existing.population {
+		places[key] = place
+	}
+}
+
+// Geocode returns the coordinates of the address's city, state or country, whichever is found first.
+func (g *GazetteerGeocoder) Geocode(ctx context.Context, addr search.Address) (*Coordinates, error) {
+	if g == nil {
+		return nil, nil
+	}
+
+	country := strings.ToLower(norm.CountryCode(addr.Country))
+	if country == "" {
+		return nil, nil // city and state names are only unique within a country
+	}
+
+	admin1 := g.regionCode(country, prepare.LowerAndRemovePunctuation(addr.State))
+
+	if city := prepare.LowerAndRemovePunctuation(addr.City); city != "" {
+		key := country + "|" + city
+		if admin1 != "" {
+			key = country + "|" + admin1 + "|" + city
+		}
+		if place, exists := g.places[key]; exists {
+			return place.coordinates("city"), nil
+		}
+	}
+	if admin1 != "" {
+		if place, exists := g.regions[country+"|"+admin1]; exists {
+			return place.coordinates("state"), nil
+		}
+	}
+	if place, exists := g.countries[country]; exists {
+		return place.coordinates("country"), nil
+	}
+	return nil, nil
+}
+
+// regionCode returns the admin1 code of a state given by name or code. US states, Canadian
+// provinces and others use their postal abbreviation as the admin1 code.
+func (g *GazetteerGeocoder) regionCode(country, state string) string {
+	if state == "" {
+		return ""
+	}
+	return g.regionCodes[country+"|"+state]
+}
+
+func (p gazetteerPlace) coordinates(accuracy string) *Coordinates {
+	return &Coordinates{
+		Latitude:  p.latitude,
+		Longitude: p.longitude,
+		Accuracy:  accuracy,
+	}
+}
+
+// Name returns the provider name.
+func (g *GazetteerGeocoder) Name() string {
+	return "gazetteer"
+}
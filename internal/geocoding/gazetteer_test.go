@@ -0,0 +1,220 @@
+package geocoding
+
+import (
+	"archive/zip"
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/moov-io/base/log"
+	"github.com/moov-io/watchman/pkg/search"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestGazetteerGeocoder(t *testing.T) {
+	g, err := NewGazetteerGeocoder(GazetteerConfig{
+		File: filepath.Join("testdata", "geonames.txt"),
+	})
+	require.NoError(t, err)
+	require.Equal(t, "gazetteer", g.Name())
+
+	ctx := context.Background()
+	cases := []struct {
+		addr     search.Address
+		lat, lon float64
+		accuracy string
+	}{
+		// Cities
+		{search.Address{Line1: "Tverskaya St 7", City: "Moscow", Country: "Russia"}, 55.75222, 37.61556, "city"},
+		{search.Address{City: "Moskva", Country: "RU"}, 55.75222, 37.61556, "city"},
+		{search.Address{City: "Москва", Country: "Russian Federation"}, 55.75222, 37.61556, "city"},
+		{search.Address{City: "sao paulo", Country: "Brazil"}, -23.5475, -46.63611, "city"},
+		{search.Address{City: "Los Angeles", State: "California", Country: "US"}, 34.05223, -118.24368, "city"},
+
+		// Most populous city with the name, or the one in the given state
+		{search.Address{City: "Moscow", Country: "United States"}, 46.73239, -117.00017, "city"},
+		{search.Address{City: "Moscow", State: "AL", Country: "US"}, 31.79349, -87.19502, "city"},
+
+		// States and countries
+		{search.Address{City: "Unknown Town", State: "CA", Country: "US"}, 37.25022, -119.75126, "state"},
+		{search.Address{State: "Moskovskaya Oblast", Country: "RU"}, 55.75, 37.5, "state"},
+		{search.Address{City: "Unknown Town", Country: "USA"}, 39.76, -98.5, "country"},
+	}
+	for _, tc := range cases {
+		t.Run(tc.addr.Format(), func(t *testing.T) {
+			coords, err := g.Geocode(ctx, tc.addr)
+			require.NoError(t, err)
+			require.NotNil(t, coords)
+
+			require.InDelta(t, tc.lat, coords.Latitude, 0.0001)
+			require.InDelta(t, tc.lon, coords.Longitude, 0.0001)
+			require.Equal(t, tc.accuracy, coords.Accuracy)
+		})
+	}
+
+	// Not found
+	for _, addr := range []search.Address{
+		{City: "Moscow"}, // no country
+		{City: "Rotterdam", Country: "Atlantis"},
+		{City: "Amsterdam", Country: "NL"}, // no country row in the file
+	} {
+		coords, err := g.Geocode(ctx, addr)
+		require.NoError(t, err)
+		require.Nil(t, coords)
+	}
+
+	var empty *GazetteerGeocoder
+	coords, err := empty.Geocode(ctx, search.Address{City: "Moscow", Country: "RU"})
+	require.NoError(t, err)
+	require.Nil(t, coords)
+}
+
+func TestGazetteerGeocoder_AlternateNames(t *testing.T) {
+	rows := strings.Join([]string{
+		"1	Kyiv	Kyiv	Kiev,Київ	50.45466	30.5238	P	PPLC	UA		12				2797553		187	Europe/Kyiv	2024-01-01",
+		"2	Hrebinky	Hrebinky	Grebenki,Гребінки	49.95	30.17	P	PPLA4	UA		13				4000		170	Europe/Kyiv	2024-01-01",
+	}, "\n")
+	g, err := readGazetteer(strings.NewReader(rows))
+	require.NoError(t, err)
+
+	ctx := context.Background()
+	for _, city := range []string{"Kyiv", "Kiev", "Київ", "Hrebinky"} {
+		coords, err := g.Geocode(ctx, search.Address{City: city, Country: "UA"})
+		require.NoError(t, err)
+		require.NotNil(t, coords, city)
+	}
+
+	// Alternate names of small places aren't indexed
+	coords, err := g.Geocode(ctx, search.Address{City: "Grebenki", Country: "UA"})
+	require.NoError(t, err)
+	require.Nil(t, coords)
+
+	// Each name is indexed with and without the state
+	require.Len(t, g.places, 2*(3+1))
+}
+
+func TestGazetteerGeocoder_Zip(t *testing.T) {
+	contents, err := os.ReadFile(filepath.Join("testdata", "geonames.txt"))
+	require.NoError(t, err)
+
+	where := filepath.Join(t.TempDir(), "cities500.zip")
+	fd, err := os.Create(where)
+	require.NoError(t, err)
+
+	zw := zip.NewWriter(fd)
+	w, err := zw.Create("readme.txt")
+	require.NoError(t, err)
+	w.Write([]byte("The data format is tab-delimited text in utf8 encoding."))
+
+	w, err = zw.Create("cities500.txt")
+	require.NoError(t, err)
+	w.Write(contents)
+
+	require.NoError(t, zw.Close())
+	require.NoError(t, fd.Close())
+
+	g, err := NewGazetteerGeocoder(GazetteerConfig{File: where})
+	require.NoError(t, err)
+
+	coords, err := g.Geocode(context.Background(), search.Address{City: "Rotterdam", Country: "NL"})
+	require.NoError(t, err)
+	require.InDelta(t, 51.9225, coords.Latitude, 0.0001)
+}
+
+func TestGazetteerGeocoder_Errors(t *testing.T) {
+	_, err := NewGazetteerGeocoder(GazetteerConfig{})
+	require.ErrorContains(t, err, "gazetteer file is required")
+
+	_, err = NewGazetteerGeocoder(GazetteerConfig{File: filepath.Join(t.TempDir(), "missing.txt")})
+	require.ErrorContains(t, err, "opening gazetteer file")
+
+	where := filepath.Join(t.TempDir(), "invalid.txt")
+	row := "1\tName\tName\t\tnorth\t10\tP\tPPL\tUS\t\tCA\t\t\t\t0\n"
+	require.NoError(t, os.WriteFile(where, []byte(row), 0600))
+
+	_, err = NewGazetteerGeocoder(GazetteerConfig{File: where})
+	require.ErrorContains(t, err, "line 1: parsing latitude")
+}
+
+type mockGeocoder struct {
+	calls  int
+	coords *Coordinates
+}
+
+func (g *mockGeocoder) Geocode(ctx context.Context, addr search.Address) (*Coordinates, error) {
+	g.calls++
+	return g.coords, nil
+}
+
+func (g *mockGeocoder) Name() string {
+	return "mock"
+}
+
+func TestService_Gazetteer(t *testing.T) {
+	conf := Config{
+		Enabled: true,
+		Provider: ProviderConfig{
+			Name: "gazetteer",
+		},
+		Gazetteer: GazetteerConfig{
+			File: filepath.Join("testdata", "geonames.txt"),
+		},
+	}
+	ctx := context.Background()
+
+	t.Run("standalone", func(t *testing.T) {
+		svc, err := NewService(log.NewTestLogger(), conf, nil)
+		require.NoError(t, err)
+		require.Nil(t, svc.gazetteer)
+
+		addresses := svc.GeocodeAddresses(ctx, []search.Address{
+			{Line1: "Tverskaya St 7", City: "Moscow", Country: "RU"},
+			{City: "Nowhere", Country: "XX"},
+		})
+		require.InDelta(t, 55.75222, addresses[0].Latitude, 0.0001)
+		require.Zero(t, addresses[1].Latitude)
+	})
+
+	t.Run("before remote provider", func(t *testing.T) {
+		conf := conf
+		conf.Provider.Name = "nominatim"
+		conf.RateLimit = RateLimitConfig{RequestsPerSecond: 10, Burst: 10}
+
+		svc, err := NewService(log.NewTestLogger(), conf, nil)
+		require.NoError(t, err)
+		require.NotNil(t, svc.gazetteer)
+
+		remote := &mockGeocoder{coords: &Coordinates{Latitude: 55.7586, Longitude: 37.6110, Accuracy: "rooftop"}}
+		svc.geocoder = remote
+
+		// Cities are resolved offline
+		coords, err := svc.GeocodeAddress(ctx, search.Address{City: "Moscow", Country: "RU"})
+		require.NoError(t, err)
+		require.Equal(t, "city", coords.Accuracy)
+		require.Equal(t, 0, remote.calls)
+
+		// Street addresses are sent to the provider
+		coords, err = svc.GeocodeAddress(ctx, search.Address{Line1: "Tverskaya St 7", City: "Moscow", Country: "RU"})
+		require.NoError(t, err)
+		require.Equal(t, "rooftop", coords.Accuracy)
+		require.Equal(t, 1, remote.calls)
+
+		// Unless the provider can't find them
+		remote.coords = nil
+		coords, err = svc.GeocodeAddress(ctx, search.Address{Line1: "Unknown St 1", City: "Moscow", Country: "RU"})
+		require.NoError(t, err)
+		require.Equal(t, "city", coords.Accuracy)
+		require.Equal(t, 2, remote.calls)
+	})
+
+	t.Run("missing file", func(t *testing.T) {
+		conf := conf
+		conf.Gazetteer.File = ""
+
+		_, err := NewService(log.NewTestLogger(), conf, nil)
+		require.ErrorContains(t, err, "gazetteer file is required")
+	})
+}
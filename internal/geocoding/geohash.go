@@ -0,0 +1,108 @@
+package geocoding
+
+import (
+	"math"
+	"slices"
+	"strings"
+)
+
+const (
+	geohashAlphabet     = "0123456789bcdefghjkmnpqrstuvwxyz"
+	maxGeohashPrecision = 9 // about 5 meters
+
+	kmPerDegree = 111.32
+)
+
+// encodeGeohash returns the geohash of a point with the given number of characters.
+func encodeGeohash(lat, lon float64, precision int) string {
+	latRange := [2]float64{-90, 90}
+	lonRange := [2]float64{-180, 180}
+
+	var sb strings.Builder
+	sb.Grow(precision)
+
+	var bit, ch int
+	evenBit := true // geohashes start with a longitude bit
+
+	for sb.Len() < precision {
+		if evenBit {
+			mid := (lonRange[0] + lonRange[1]) / 2
+			if lon >= mid {
+				ch = ch<<1 | 1
+				lonRange[0] = mid
+			} else {
+				ch <<= 1
+				lonRange[1] = mid
+			}
+		} else {
+			mid := (latRange[0] + latRange[1]) / 2
+			if lat >= mid {
+				ch = ch<<1 | 1
+				latRange[0] = mid
+			} else {
+				ch <<= 1
+				latRange[1] = mid
+			}
+		}
+		evenBit = !evenBit
+
+		if bit++; bit == 5 {
+			sb.WriteByte(geohashAlphabet[ch])
+			bit, ch = 0, 0
+		}
+	}
+	return sb.String()
+}
+
+// geohashCellSize returns the height and width of a geohash cell in degrees.
+func geohashCellSize(precision int) (float64, float64) {
+	bits := 5 * precision
+	latBits := bits / 2
+	lonBits := bits - latBits
+	return 180 / math.Pow(2, float64(latBits)), 360 / math.Pow(2, float64(lonBits))
+}
+
+// geohashPrecisionFor returns the longest geohash where a cell is at least radiusKm tall and wide
+// around lat. Zero is returned when even single character cells are too small.
+//
+// Cells narrow toward the poles, so their width is measured at the poleward edge of the circle.
+// Circles reaching a pole are never covered.
+func geohashPrecisionFor(lat, radiusKm float64) int {
+	edge := math.Min(90, math.Abs(lat)+radiusKm/kmPerDegree)
+
+	for precision := maxGeohashPrecision; precision > 0; precision-- {
+		height, width := geohashCellSize(precision)
+
+		heightKm := height * kmPerDegree
+		widthKm := width * kmPerDegree * math.Cos(edge*math.Pi/180)
+		if heightKm >= radiusKm && widthKm >= radiusKm {
+			return precision
+		}
+	}
+	return 0
+}
+
+// geohashNeighborhood returns the geohash containing the point and the eight cells around it.
+func geohashNeighborhood(lat, lon float64, precision int) []string {
+	height, width := geohashCellSize(precision)
+
+	out := make([]string, 0, 9)
+	for _, dLat := range []float64{-height, 0, height} {
+		for _, dLon := range []float64{-width, 0, width} {
+			nLat := math.Max(-90, math.Min(90, lat+dLat))
+
+			nLon := lon + dLon
+			if nLon < -180 {
+				nLon += 360
+			} else if nLon >= 180 {
+				nLon -= 360
+			}
+
+			hash := encodeGeohash(nLat, nLon, precision)
+			if !slices.Contains(out, hash) {
+				out = append(out, hash)
+			}
+		}
+	}
+	return out
+}
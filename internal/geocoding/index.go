@@ -0,0 +1,113 @@
+package geocoding
+
+import (
+	"cmp"
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/moov-io/watchman/pkg/search"
+)
+
+// Index is a spatial index over the coordinates of geocoded entity addresses.
+// Points are kept sorted by their geohash so nearby points share a prefix.
+type Index struct {
+	entities []search.Entity[search.Value]
+	points   []indexedPoint
+}
+
+type indexedPoint struct {
+	geohash   string
+	latitude  float64
+	longitude float64
+	entity    int
+}
+
+// Nearby is an entity with an address within the searched radius.
+type Nearby struct {
+	Entity search.Entity[search.Value]
+
+	// DistanceKm is the distance to the entity's closest address
+	DistanceKm float64
+}
+
+// NewIndex builds a spatial index of every address with coordinates.
+func NewIndex(entities []search.Entity[search.Value]) *Index {
+	idx := &Index{
+		entities: entities,
+	}
+	for i := range entities {
+		for _, addr := range entities[i].Addresses {
+			if addr.Latitude == 0 && addr.Longitude == 0 {
+				continue
+			}
+			idx.points = append(idx.points, indexedPoint{
+				geohash:   encodeGeohash(addr.Latitude, addr.Longitude, maxGeohashPrecision),
+				latitude:  addr.Latitude,
+				longitude: addr.Longitude,
+				entity:    i,
+			})
+		}
+	}
+	slices.SortFunc(idx.points, func(a, b indexedPoint) int {
+		return strings.Compare(a.geohash, b.geohash)
+	})
+	return idx
+}
+
+// Size returns how many addresses are indexed.
+func (idx *Index) Size() int {
+	if idx == nil {
+		return 0
+	}
+	return len(idx.points)
+}
+
+// Within returns the entities with an address within radiusKm of the point, closest first.
+func (idx *Index) Within(lat, lon, radiusKm float64) []Nearby {
+	if idx == nil || len(idx.points) == 0 || radiusKm <= 0 {
+		return nil
+	}
+
+	// Look through the cell containing the point and its neighbors. Cells are at least
+	// as large as the radius so every point within the radius is in one of them.
+	prefixes := []string{""}
+	if precision := geohashPrecisionFor(lat, radiusKm); precision > 0 {
+		prefixes = geohashNeighborhood(lat, lon, precision)
+	}
+
+	closest := make(map[int]float64)
+	for _, prefix := range prefixes {
+		start := sort.Search(len(idx.points), func(i int) bool {
+			return idx.points[i].geohash >= prefix
+		})
+		for _, point := range idx.points[start:] {
+			if !strings.HasPrefix(point.geohash, prefix) {
+				break
+			}
+
+			distance := search.HaversineDistance(lat, lon, point.latitude, point.longitude)
+			if distance > radiusKm {
+				continue
+			}
+			if current, exists := closest[point.entity]; !exists || distance < current {
+				closest[point.entity] = distance
+			}
+		}
+	}
+
+	out := make([]Nearby, 0, len(closest))
+	for entity, distance := range closest {
+		out = append(out, Nearby{
+			Entity:     idx.entities[entity],
+			DistanceKm: distance,
+		})
+	}
+	slices.SortFunc(out, func(a, b Nearby) int {
+		return cmp.Or(
+			cmp.Compare(a.DistanceKm, b.DistanceKm),
+			strings.Compare(a.Entity.SourceID, b.Entity.SourceID),
+		)
+	})
+	return out
+}
@@ -0,0 +1,116 @@
+package geocoding
+
+import (
+	"math/rand/v2"
+	"strconv"
+	"testing"
+
+	"github.com/moov-io/watchman/pkg/search"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestEncodeGeohash(t *testing.T) {
+	require.Equal(t, "u4pruydqq", encodeGeohash(57.64911, 10.40744, 9))
+	require.Equal(t, "ezs42", encodeGeohash(42.605, -5.603, 5))
+	require.Equal(t, "", encodeGeohash(42.605, -5.603, 0))
+}
+
+func TestGeohashPrecisionFor(t *testing.T) {
+	require.Equal(t, 9, geohashPrecisionFor(0, 0.001))
+	require.Equal(t, 5, geohashPrecisionFor(0, 4))
+	require.Equal(t, 4, geohashPrecisionFor(51.5, 5))
+	require.Equal(t, 0, geohashPrecisionFor(0, 10_000))
+
+	// Cells are measured at the poleward edge of the circle
+	require.Less(t, geohashPrecisionFor(69, 15), geohashPrecisionFor(0, 15))
+	require.Equal(t, geohashPrecisionFor(-69, 15), geohashPrecisionFor(69, 15))
+	require.Equal(t, 0, geohashPrecisionFor(89.9, 50))
+}
+
+func TestIndex_WithinHighLatitudes(t *testing.T) {
+	// Just west of a cell's edge, with a radius barely covered by the cell's width at 75°N.
+	// The address is past the eastern neighbor of the cell, but within the radius.
+	idx := NewIndex([]search.Entity[search.Value]{
+		{SourceID: "east", Addresses: []search.Address{{Latitude: 75.0, Longitude: 1.4065}}},
+	})
+	require.Len(t, idx.Within(75.0, -0.0001, 40.5), 1)
+
+	r := rand.New(rand.NewPCG(1, 2))
+
+	var entities []search.Entity[search.Value]
+	for i := range 5000 {
+		entities = append(entities, search.Entity[search.Value]{
+			SourceID:  strconv.Itoa(i),
+			Addresses: []search.Address{{Latitude: 60 + r.Float64()*29.9, Longitude: r.Float64()*40 - 20}},
+		})
+	}
+	idx = NewIndex(entities)
+
+	// Every address within the radius is found, which is checked against all of them
+	for range 200 {
+		lat, lon, radiusKm := 62+r.Float64()*27, r.Float64()*30-15, 1+r.Float64()*150
+
+		var expected []string
+		for _, e := range entities {
+			if search.HaversineDistance(lat, lon, e.Addresses[0].Latitude, e.Addresses[0].Longitude) <= radiusKm {
+				expected = append(expected, e.SourceID)
+			}
+		}
+
+		var found []string
+		for _, nearby := range idx.Within(lat, lon, radiusKm) {
+			found = append(found, nearby.Entity.SourceID)
+		}
+		require.ElementsMatch(t, expected, found, "lat=%.4f lon=%.4f radius=%.1f", lat, lon, radiusKm)
+	}
+}
+
+func TestIndex_Within(t *testing.T) {
+	entity := func(sourceID string, coords ...[2]float64) search.Entity[search.Value] {
+		e := search.Entity[search.Value]{SourceID: sourceID}
+		for _, c := range coords {
+			e.Addresses = append(e.Addresses, search.Address{Latitude: c[0], Longitude: c[1]})
+		}
+		return e
+	}
+
+	idx := NewIndex([]search.Entity[search.Value]{
+		entity("rotterdam-port", [2]float64{51.9490, 4.1450}),
+		entity("rotterdam-center", [2]float64{51.9225, 4.4792}),
+		entity("antwerp", [2]float64{51.2194, 4.4025}),
+		entity("two-offices", [2]float64{52.3676, 4.9041}, [2]float64{51.9500, 4.1500}),
+		entity("no-coordinates", [2]float64{0, 0}),
+		entity("no-addresses"),
+	})
+	require.Equal(t, 5, idx.Size())
+
+	// Port of Rotterdam (Maasvlakte)
+	found := idx.Within(51.9500, 4.1000, 25)
+	require.Len(t, found, 2)
+
+	require.Equal(t, "rotterdam-port", found[0].Entity.SourceID)
+	require.InDelta(t, 3.09, found[0].DistanceKm, 0.01)
+	require.Equal(t, "two-offices", found[1].Entity.SourceID) // closest office is used
+	require.InDelta(t, 3.43, found[1].DistanceKm, 0.01)
+
+	found = idx.Within(51.9500, 4.1000, 30)
+	require.Len(t, found, 3)
+	require.Equal(t, "rotterdam-center", found[2].Entity.SourceID)
+	require.InDelta(t, 26.0, found[2].DistanceKm, 1.0)
+
+	// Larger radius picks up Antwerp
+	found = idx.Within(51.9500, 4.1000, 100)
+	require.Len(t, found, 4)
+	require.Equal(t, "antwerp", found[3].Entity.SourceID)
+
+	// Radius larger than any geohash cell
+	require.Len(t, idx.Within(0, 0, 10_000), 4)
+
+	require.Empty(t, idx.Within(51.9500, 4.1000, 0))
+	require.Empty(t, idx.Within(-33.8688, 151.2093, 25))
+
+	var empty *Index
+	require.Empty(t, empty.Within(51.9500, 4.1000, 25))
+	require.Equal(t, 0, empty.Size())
+}
@@ -28,6 +28,9 @@ type Service struct {
 	geocoder Geocoder
 	limiter  *rate.Limiter
 
+	// gazetteer resolves addresses offline before the geocoder is called
+	gazetteer *GazetteerGeocoder
+
 	// L1 cache (in-memory LRU with TTL)
 	l1Cache *lru.Cache[string, cacheEntry]
 	l1TTL   time.Duration
@@ -50,10 +53,29 @@ func NewService(logger log.Logger, conf Config, database db.DB) (*Service, error
 		return nil, nil
 	}
 
+	var err error
+
+	// Load the offline gazetteer
+	var gazetteer *GazetteerGeocoder
+	if conf.Gazetteer.File != "" || conf.Provider.Name == "gazetteer" {
+		start := time.Now()
+
+		gazetteer, err = NewGazetteerGeocoder(conf.Gazetteer)
+		if err != nil {
+			return nil, fmt.Errorf("creating gazetteer: %w", err)
+		}
+		logger.Info().Logf("loaded gazetteer from %s in %v", conf.Gazetteer.File, time.Since(start))
+	}
+
 	// Create geocoder based on provider config
-	geocoder, err := createGeocoder(conf.Provider)
-	if err != nil {
-		return nil, fmt.Errorf("creating geocoder: %w", err)
+	var geocoder Geocoder
+	if conf.Provider.Name == "gazetteer" {
+		geocoder, gazetteer = gazetteer, nil
+	} else {
+		geocoder, err = createGeocoder(conf.Provider)
+		if err != nil {
+			return nil, fmt.Errorf("creating geocoder: %w", err)
+		}
 	}
 
 	// Create rate limiter
@@ -61,6 +83,9 @@ func NewService(logger log.Logger, conf Config, database db.DB) (*Service, error
 		rate.Limit(conf.RateLimit.RequestsPerSecond),
 		conf.RateLimit.Burst,
 	)
+	if _, offline := geocoder.(*GazetteerGeocoder); offline {
+		limiter = rate.NewLimiter(rate.Inf, 0)
+	}
 
 	// Set defaults if not configured
 	l1MaxSize := conf.Cache.L1MaxSize
@@ -96,6 +121,8 @@ func NewService(logger log.Logger, conf Config, database db.DB) (*Service, error
 		l1Cache:  l1Cache,
 		l1TTL:    l1TTL,
 		l2Repo:   l2Repo,
+
+		gazetteer: gazetteer,
 	}, nil
 }
 
@@ -131,6 +158,15 @@ func (s *Service) GeocodeAddress(ctx context.Context, addr search.Address) (*Coo
 
 	span.SetAttributes(attribute.String("geocoder.cache", "miss"))
 
+	// The gazetteer resolves addresses without a street. Others are only resolved
+	// to their city when the geocoder fails.
+	local, _ := s.gazetteer.Geocode(ctx, addr)
+	if local != nil && addr.Line1 == "" && addr.Line2 == "" {
+		span.SetAttributes(attribute.String("geocoder.gazetteer", local.Accuracy))
+		s.setL1Cache(cacheKey, local)
+		return local, nil
+	}
+
 	// Rate limit before calling external service
 	if err := s.limiter.Wait(ctx); err != nil {
 		return nil, fmt.Errorf("rate limit wait: %w", err)
@@ -140,7 +176,10 @@ func (s *Service) GeocodeAddress(ctx context.Context, addr search.Address) (*Coo
 	coords, err := s.geocoder.Geocode(ctx, addr)
 	if err != nil {
 		s.logger.Warn().LogErrorf("geocoding failed for address %q: %v", addr.Format(), err)
-		return nil, nil // Graceful degradation
+		return local, nil // Graceful degradation
+	}
+	if coords == nil {
+		coords = local
 	}
 
 	if coords != nil {
@@ -182,6 +221,23 @@ func (s *Service) GeocodeAddresses(ctx context.Context, addresses []search.Addre
 	return result
 }
 
+// GeocodeQueryAddresses geocodes the addresses of a search request when Query.Enabled is set.
+// Lookups are limited to Query.Timeout and addresses which aren't geocoded in time are returned as-is.
+func (s *Service) GeocodeQueryAddresses(ctx context.Context, addresses []search.Address) []search.Address {
+	if s == nil || !s.conf.Query.Enabled || len(addresses) == 0 {
+		return addresses
+	}
+
+	timeout := s.conf.Query.Timeout
+	if timeout <= 0 {
+		timeout = 2 * time.Second
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	return s.GeocodeAddresses(ctx, addresses)
+}
+
 // addressCacheKey generates a normalized cache key for an address.
 func (s *Service) addressCacheKey(addr search.Address) string {
 	return hash(fmt.Appendf(nil, "%s|%s|%s|%s|%s|%s",
@@ -210,7 +266,10 @@ func (s *Service) checkL1Cache(key string) *Coordinates {
 		s.mu.Lock()
 		s.l1Cache.Remove(key)
 		s.mu.Unlock()
+
+		return nil
 	}
+	s.mu.RUnlock()
 
 	return nil
 }
@@ -7,7 +7,10 @@ import (
 
 	"github.com/moov-io/base/log"
 	"github.com/moov-io/watchman/pkg/search"
+
+	lru "github.com/hashicorp/golang-lru/v2"
 	"github.com/stretchr/testify/require"
+	"golang.org/x/time/rate"
 )
 
 func TestService_Disabled(t *testing.T) {
@@ -120,6 +123,43 @@ func TestService_L1Cache_TTLExpiration(t *testing.T) {
 	require.Nil(t, cached, "cache entry should be expired")
 }
 
+func TestService_L1Cache_Miss(t *testing.T) {
+	conf := Config{
+		Enabled: true,
+		Provider: ProviderConfig{
+			Name: "nominatim",
+		},
+		RateLimit: RateLimitConfig{
+			RequestsPerSecond: 10,
+			Burst:             10,
+		},
+		Cache: CacheConfig{
+			L1MaxSize: 100,
+			L1TTL:     time.Hour,
+		},
+	}
+
+	svc, err := NewService(log.NewTestLogger(), conf, nil)
+	require.NoError(t, err)
+	require.NotNil(t, svc)
+
+	// A miss must release the read lock, otherwise storing the result never returns
+	done := make(chan *Coordinates)
+	go func() {
+		missed := svc.checkL1Cache("missing-key")
+		svc.setL1Cache("missing-key", &Coordinates{Latitude: 40.7128, Longitude: -74.0060})
+		done <- missed
+	}()
+
+	select {
+	case missed := <-done:
+		require.Nil(t, missed)
+	case <-time.After(5 * time.Second):
+		t.Fatal("timed out storing coordinates after an L1 cache miss")
+	}
+	require.NotNil(t, svc.checkL1Cache("missing-key"))
+}
+
 func TestDefaultConfig(t *testing.T) {
 	conf := DefaultConfig()
 
@@ -131,4 +171,52 @@ func TestDefaultConfig(t *testing.T) {
 	require.Equal(t, 10000, conf.Cache.L1MaxSize)
 	require.Equal(t, 24*time.Hour, conf.Cache.L1TTL)
 	require.True(t, conf.Cache.L2Enabled)
+	require.False(t, conf.Query.Enabled)
+	require.Equal(t, 2*time.Second, conf.Query.Timeout)
+}
+
+// slowGeocoder blocks until the request is cancelled
+type slowGeocoder struct {
+	calls int
+}
+
+func (g *slowGeocoder) Geocode(ctx context.Context, address search.Address) (*Coordinates, error) {
+	g.calls++
+	<-ctx.Done()
+	return nil, ctx.Err()
+}
+
+func (g *slowGeocoder) Name() string {
+	return "slow"
+}
+
+func TestService_GeocodeQueryAddresses(t *testing.T) {
+	geocoder := &slowGeocoder{}
+	l1Cache, err := lru.New[string, cacheEntry](10)
+	require.NoError(t, err)
+
+	svc := &Service{
+		logger:   log.NewTestLogger(),
+		geocoder: geocoder,
+		limiter:  rate.NewLimiter(rate.Inf, 0),
+		l1Cache:  l1Cache,
+		l1TTL:    time.Hour,
+	}
+	addresses := []search.Address{{Line1: "123 Main St", City: "New York", Country: "US"}}
+
+	t.Run("disabled", func(t *testing.T) {
+		result := svc.GeocodeQueryAddresses(context.Background(), addresses)
+		require.Equal(t, addresses, result)
+		require.Zero(t, geocoder.calls)
+	})
+
+	t.Run("timeout", func(t *testing.T) {
+		svc.conf.Query = QueryConfig{Enabled: true, Timeout: 10 * time.Millisecond}
+
+		start := time.Now()
+		result := svc.GeocodeQueryAddresses(context.Background(), addresses)
+		require.Less(t, time.Since(start), time.Second)
+		require.Equal(t, addresses, result)
+		require.Equal(t, 1, geocoder.calls)
+	})
 }
@@ -7,6 +7,7 @@ import (
 
 	"github.com/moov-io/watchman"
 	"github.com/moov-io/watchman/internal/download"
+	"github.com/moov-io/watchman/internal/geocoding"
 	"github.com/moov-io/watchman/internal/ingest"
 	"github.com/moov-io/watchman/internal/tfidf"
 	"github.com/moov-io/watchman/pkg/search"
@@ -17,6 +18,7 @@ type Lists interface {
 	Update(latest download.Stats)
 	LatestStats() download.Stats
 	GetTFIDFIndex() *tfidf.Index
+	GetGeoIndex() *geocoding.Index
 }
 
 func NewLists(ingestRepository ingest.Repository) Lists {
@@ -65,6 +67,7 @@ func (l *lists) LatestStats() download.Stats {
 	out := download.Stats{
 		Lists:      l.latestStats.Lists,
 		ListHashes: l.latestStats.ListHashes,
+		SubLists:   l.latestStats.SubLists,
 		StartedAt:  l.latestStats.StartedAt,
 		EndedAt:    l.latestStats.EndedAt,
 		Version:    watchman.Version,
@@ -76,6 +79,10 @@ func (l *lists) Update(latest download.Stats) {
 	l.mu.Lock()
 	defer l.mu.Unlock()
 
+	// The entities were normalized with any changed dictionaries, so use both together
+	latest.Analysis.Apply()
+	latest.Analysis = nil
+
 	l.latestStats = latest
 }
 
@@ -85,3 +92,10 @@ func (l *lists) GetTFIDFIndex() *tfidf.Index {
 
 	return l.latestStats.TFIDFIndex
 }
+
+func (l *lists) GetGeoIndex() *geocoding.Index {
+	l.mu.RLock()
+	defer l.mu.RUnlock()
+
+	return l.latestStats.GeoIndex
+}
@@ -2,6 +2,8 @@ package index_test
 
 import (
 	"context"
+	"os"
+	"path/filepath"
 	"testing"
 	"time"
 
@@ -10,6 +12,7 @@ import (
 	"github.com/moov-io/watchman/internal/index"
 	"github.com/moov-io/watchman/internal/ingest"
 	"github.com/moov-io/watchman/internal/ofactest"
+	"github.com/moov-io/watchman/internal/prepare"
 	"github.com/moov-io/watchman/pkg/search"
 
 	"github.com/stretchr/testify/require"
@@ -69,3 +72,53 @@ func TestIndex_GetEntities(t *testing.T) {
 		require.Len(t, found[0].PreparedFields.Addresses, 5) // index.Lists does call .Normalize()
 	})
 }
+
+func TestIndex_UpdateAnalysis(t *testing.T) {
+	t.Cleanup(func() {
+		require.NoError(t, prepare.ConfigureAnalysis(prepare.AnalysisConfig{}))
+	})
+
+	where := filepath.Join(t.TempDir(), "analysis.yml")
+	require.NoError(t, os.WriteFile(where, []byte("Abbreviations:\n  trdg: trading\n"), 0600))
+	require.NoError(t, prepare.ConfigureAnalysis(prepare.AnalysisConfig{File: where}))
+
+	ctx := context.Background()
+	repo := &ingest.MockRepository{}
+	lists := index.NewLists(repo)
+
+	ingested := search.Entity[search.Value]{Name: "Intl Vendor", Source: "vendors", SourceID: "1"}
+	require.NoError(t, repo.Upsert(ctx, "vendors", []search.Entity[search.Value]{ingested.Normalize()}))
+
+	// Changed dictionaries aren't in use while the refreshed entities are normalized with them
+	require.NoError(t, os.WriteFile(where, []byte("Abbreviations:\n  trdg: trading\n  intl: international\n"), 0600))
+	analysis, err := prepare.LoadAnalysis()
+	require.NoError(t, err)
+	require.NotNil(t, analysis)
+
+	entity := search.Entity[search.Value]{Name: "Acme Intl Trdg", Source: search.SourceUSOFAC}.NormalizeWith(analysis)
+	require.Equal(t, "acme international trading", entity.PreparedFields.Name)
+	require.Equal(t, "intl", prepare.ExpandTerms("intl"))
+
+	found, err := lists.GetEntities(ctx, "vendors")
+	require.NoError(t, err)
+	require.Equal(t, "intl vendor", found[0].PreparedFields.Name)
+
+	// They're put in use along with the entities
+	lists.Update(download.Stats{
+		Lists:    map[string]int{string(search.SourceUSOFAC): 1},
+		Entities: []search.Entity[search.Value]{entity},
+		Analysis: analysis,
+	})
+	require.Equal(t, "international", prepare.ExpandTerms("intl"))
+
+	found, err = lists.GetEntities(ctx, search.SourceUSOFAC)
+	require.NoError(t, err)
+	require.Len(t, found, 1)
+	require.Equal(t, "acme international trading", found[0].PreparedFields.Name)
+
+	// Ingested entities are normalized with them as they're read
+	found, err = lists.GetEntities(ctx, "vendors")
+	require.NoError(t, err)
+	require.Len(t, found, 1)
+	require.Equal(t, "international vendor", found[0].PreparedFields.Name)
+}
@@ -8,6 +8,7 @@ import (
 	"github.com/moov-io/base/telemetry"
 	"github.com/moov-io/watchman/internal/api"
 	pubsearch "github.com/moov-io/watchman/pkg/search"
+	"github.com/moov-io/watchman/pkg/sources/ftm"
 	"github.com/moov-io/watchman/pkg/sources/senzing"
 
 	"github.com/gorilla/mux"
@@ -128,7 +129,7 @@ func (c *controller) exportFile(w http.ResponseWriter, r *http.Request) {
 		api.ErrorResponse(w, err)
 		return
 	}
-	logger.Info().Logf("exporting %d entities to senzing format", len(entities))
+	logger.Info().Logf("exporting %d entities to %s format", len(entities), outputFormat)
 
 	switch outputFormat {
 	case api.EntityWatchman:
@@ -144,6 +145,16 @@ func (c *controller) exportFile(w http.ResponseWriter, r *http.Request) {
 			Format:     subformat,
 		}
 		err = senzing.WriteEntities(w, entities, opts)
+
+	case api.EntityFTM:
+		w.Header().Set("Access-Control-Allow-Origin", "*")
+		w.Header().Set("Content-Type", ftm.ContentType(subformat))
+
+		opts := ftm.ExportOptions{
+			Dataset: fileType,
+			Format:  subformat,
+		}
+		err = ftm.WriteEntities(w, entities, opts)
 	}
 	if err != nil {
 		err = logger.Error().LogErrorf("problem rendering export response into %v", outputFormat).Err()
@@ -18,6 +18,7 @@ import (
 	"github.com/moov-io/watchman/internal/ingest"
 	"github.com/moov-io/watchman/internal/search"
 	pubsearch "github.com/moov-io/watchman/pkg/search"
+	"github.com/moov-io/watchman/pkg/sources/ftm"
 	"github.com/moov-io/watchman/pkg/sources/senzing"
 
 	"github.com/gorilla/mux"
@@ -158,6 +159,48 @@ func TestAPI_Senzing(t *testing.T) {
 	})
 }
 
+func TestAPI_FTM(t *testing.T) {
+	setupIngestAPITest(t, func(scope ingestApiSetup) {
+		file, err := os.Open(filepath.Join("testdata", "fincen-person.csv"))
+		require.NoError(t, err)
+		t.Cleanup(func() { file.Close() })
+
+		ctx := context.Background()
+		_, err = scope.client.IngestFile(ctx, "fincen-person", file)
+		require.NoError(t, err)
+
+		// Export the data
+		resp, err := http.Get(scope.server.URL + "/v2/export/fincen-person?format=ftm")
+		require.NoError(t, err)
+
+		if resp != nil && resp.Body != nil {
+			defer resp.Body.Close()
+		}
+
+		bs, err := io.ReadAll(resp.Body)
+		require.NoError(t, err)
+
+		// Make sure the response is JSON Lines
+		require.Equal(t, "application/x-ndjson", resp.Header.Get("Content-Type"))
+		require.True(t, bytes.HasPrefix(bs, []byte("{")))
+
+		entities, err := ftm.ReadEntities(bytes.NewReader(bs), pubsearch.SourceList("ftm"))
+		require.NoError(t, err)
+		require.Len(t, entities, 3)
+
+		// Export as a JSON array
+		resp, err = http.Get(scope.server.URL + "/v2/export/fincen-person?format=ftm/json")
+		require.NoError(t, err)
+		defer resp.Body.Close()
+
+		require.Equal(t, "application/json", resp.Header.Get("Content-Type"))
+
+		bs, err = io.ReadAll(resp.Body)
+		require.NoError(t, err)
+		require.True(t, bytes.HasPrefix(bs, []byte("[")))
+	})
+}
+
 type ingestApiSetup struct {
 	client pubsearch.Client
 	server *httptest.Server
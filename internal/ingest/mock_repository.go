@@ -48,7 +48,8 @@ func (r *MockRepository) Get(ctx context.Context, sourceID string, source search
 	entities := r.entities[string(source)]
 	for i := range entities {
 		if entities[i].SourceID == sourceID {
-			return &entities[i], nil
+			found := entities[i].Normalize()
+			return &found, nil
 		}
 	}
 
@@ -89,5 +90,10 @@ func (r *MockRepository) ListBySource(ctx context.Context, lastSourceID string,
 		endIdx = len(entities)
 	}
 
-	return slices.Clone(entities[startIdx:endIdx]), nil
+	// Entities are normalized as they're read, like the SQL repository, to use the current analysis dictionaries
+	out := make([]search.Entity[search.Value], 0, endIdx-startIdx)
+	for _, entity := range entities[startIdx:endIdx] {
+		out = append(out, entity.Normalize())
+	}
+	return out, nil
 }
@@ -23,6 +23,12 @@ var (
 	FormatSenzing      Format = "senzing"       // auto-detect JSON Lines or JSON Array
 	FormatSenzingJSON  Format = "senzing-json"  // JSON Array format
 	FormatSenzingJSONL Format = "senzing-jsonl" // JSON Lines format
+
+	// FollowTheMoney entity format support
+	// Reference: https://followthemoney.tech/explorer/
+	FormatFTM      Format = "ftm"       // auto-detect JSON Lines or JSON Array
+	FormatFTMJSON  Format = "ftm-json"  // JSON Array format
+	FormatFTMJSONL Format = "ftm-jsonl" // JSON Lines format
 )
 
 type Mapping struct {
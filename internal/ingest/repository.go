@@ -137,6 +137,7 @@ func (r *sqlRepository) queryScanEntities(ctx context.Context, qry string, args
 			return nil, fmt.Errorf("json decode: %w", err)
 		}
 
+		// Normalized as they're read to use the analysis dictionaries in use
 		out = append(out, row.Normalize())
 	}
 	return out, rows.Err()
@@ -13,6 +13,7 @@ import (
 
 	"github.com/moov-io/base/log"
 	"github.com/moov-io/watchman/pkg/search"
+	"github.com/moov-io/watchman/pkg/sources/ftm"
 	"github.com/moov-io/watchman/pkg/sources/senzing"
 )
 
@@ -57,6 +58,9 @@ func (s *service) ReadEntitiesFromFile(ctx context.Context, name string, content
 			case FormatSenzing, FormatSenzingJSON, FormatSenzingJSONL:
 				out, err = s.readEntitiesFromSenzingFile(ctx, fileType, contents)
 
+			case FormatFTM, FormatFTMJSON, FormatFTMJSONL:
+				out, err = s.readEntitiesFromFTMFile(ctx, fileType, contents)
+
 			default:
 				return out, fmt.Errorf("unknown format %v", schema.Format)
 			}
@@ -348,6 +352,23 @@ func (s *service) readEntitiesFromSenzingFile(ctx context.Context, name string,
 	return out, nil
 }
 
+func (s *service) readEntitiesFromFTMFile(ctx context.Context, name string, contents io.Reader) (FileEntities, error) {
+	out := FileEntities{
+		FileType: name,
+	}
+
+	sourceList := search.SourceList(name)
+
+	entities, err := ftm.ReadEntities(maybeDecompressBody(contents), sourceList)
+	if err != nil {
+		return out, fmt.Errorf("reading ftm entities: %w", err)
+	}
+
+	out.Entities = entities
+
+	return out, nil
+}
+
 const defaultPaginationLimit = 1000
 
 func (s *service) GetEntitiesBySource(ctx context.Context, source string) ([]search.Entity[search.Value], error) {
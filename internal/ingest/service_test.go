@@ -12,6 +12,7 @@ import (
 	"github.com/moov-io/watchman/internal/config"
 	"github.com/moov-io/watchman/internal/db"
 	"github.com/moov-io/watchman/internal/ingest"
+	"github.com/moov-io/watchman/internal/norm"
 	"github.com/moov-io/watchman/pkg/search"
 
 	"github.com/stretchr/testify/require"
@@ -131,6 +132,7 @@ func TestService_ReadEntitiesFromFile_FincenBusiness(t *testing.T) {
 							PostalCode:  "90210",
 							State:       "ca",
 							Country:     "united states",
+							Subdivision: "US-CA",
 						},
 					},
 				},
@@ -179,6 +181,7 @@ func TestService_ReadEntitiesFromFile_FincenBusiness(t *testing.T) {
 							PostalCode:  "12946",
 							State:       "ny",
 							Country:     "united states",
+							Subdivision: "US-NY",
 						},
 						{
 							Line1:       "81 this way",
@@ -188,6 +191,7 @@ func TestService_ReadEntitiesFromFile_FincenBusiness(t *testing.T) {
 							PostalCode:  "12946",
 							State:       "ny",
 							Country:     "united states",
+							Subdivision: "US-NY",
 						},
 					},
 				},
@@ -262,6 +266,7 @@ func TestService_ReadEntitiesFromFile_FincenPerson(t *testing.T) {
 					Contact: search.ContactInfo{
 						PhoneNumbers: []string{"6411112345"},
 					},
+					Phones: []norm.Phone{{E164: "+16411112345", National: "6411112345"}},
 					Addresses: []search.PreparedAddress{
 						{
 							Line1:       "193 southfield lane",
@@ -271,6 +276,7 @@ func TestService_ReadEntitiesFromFile_FincenPerson(t *testing.T) {
 							PostalCode:  "90210",
 							State:       "ca",
 							Country:     "united states",
+							Subdivision: "US-CA",
 						},
 						{
 							Line1:       "441 northfield road",
@@ -280,6 +286,7 @@ func TestService_ReadEntitiesFromFile_FincenPerson(t *testing.T) {
 							PostalCode:  "90210",
 							State:       "ca",
 							Country:     "united states",
+							Subdivision: "US-CA",
 						},
 					},
 				},
@@ -319,6 +326,7 @@ func TestService_ReadEntitiesFromFile_FincenPerson(t *testing.T) {
 					Contact: search.ContactInfo{
 						PhoneNumbers: []string{"6411115522"},
 					},
+					Phones: []norm.Phone{{E164: "+16411115522", National: "6411115522"}},
 					Addresses: []search.PreparedAddress{
 						{
 							Line1:       "931 southfield lane",
@@ -328,6 +336,7 @@ func TestService_ReadEntitiesFromFile_FincenPerson(t *testing.T) {
 							PostalCode:  "90210",
 							State:       "ca",
 							Country:     "united states",
+							Subdivision: "US-CA",
 						},
 					},
 				},
@@ -367,6 +376,7 @@ func TestService_ReadEntitiesFromFile_FincenPerson(t *testing.T) {
 					Contact: search.ContactInfo{
 						PhoneNumbers: []string{"6411115432"},
 					},
+					Phones: []norm.Phone{{E164: "+16411115432", National: "6411115432"}},
 					Addresses: []search.PreparedAddress{
 						{
 							Line1:       "391 southfield lane",
@@ -376,6 +386,7 @@ func TestService_ReadEntitiesFromFile_FincenPerson(t *testing.T) {
 							PostalCode:  "90210",
 							State:       "ca",
 							Country:     "united states",
+							Subdivision: "US-CA",
 						},
 					},
 				},
@@ -399,6 +410,39 @@ func TestService_ReadEntitiesFromFile_FincenPerson(t *testing.T) {
 	})
 }
 
+func TestService_ReadEntitiesFromFile_FTM(t *testing.T) {
+	ctx := context.Background()
+	logger := log.NewTestLogger()
+
+	conf := ingest.Config{
+		Files: map[string]ingest.File{
+			"sanctions-ftm": {
+				Format: ingest.FormatFTM,
+			},
+		},
+	}
+	svc := ingest.NewService(logger, conf, nil)
+
+	fd, err := os.Open(filepath.Join("..", "..", "pkg", "sources", "ftm", "testdata", "entities.ftm.json"))
+	require.NoError(t, err)
+	t.Cleanup(func() { fd.Close() })
+
+	parsedFile, err := svc.ReadEntitiesFromFile(ctx, "sanctions-ftm", fd)
+	require.NoError(t, err)
+	require.Equal(t, "sanctions-ftm", parsedFile.FileType)
+	require.Len(t, parsedFile.Entities, 4)
+
+	for _, entity := range parsedFile.Entities {
+		require.Equal(t, search.SourceList("sanctions-ftm"), entity.Source)
+
+		if entity.SourceID == "ofac-11111" {
+			require.Equal(t, "Ivan PETROV", entity.Name)
+			require.NotNil(t, entity.SanctionsInfo)
+			require.Len(t, entity.Affiliations, 2)
+		}
+	}
+}
+
 func ptr[T any](in T) *T {
 	return &in
 }
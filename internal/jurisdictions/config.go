@@ -0,0 +1,37 @@
+package jurisdictions
+
+// Config holds the configuration for jurisdiction screening.
+type Config struct {
+	// Enabled controls whether search queries are screened against embargoed jurisdictions.
+	Enabled bool
+
+	// Regimes to screen against (US, EU, UK, UN). Empty screens against every regime.
+	Regimes []string
+
+	// Embargoes replaces the default table of a regime, keyed by regime.
+	Embargoes map[string][]Embargo
+
+	// FinCEN311 adds jurisdictions under FinCEN Section 311 special measures to the US regime
+	// when the us_fincen_311 list is included.
+	FinCEN311 bool
+}
+
+// Embargo is a jurisdiction under embargo or comprehensive sanctions.
+type Embargo struct {
+	// Country is the ISO 3166 alpha-2 code of the jurisdiction
+	Country string
+
+	// Subdivision limits the embargo to a region of the country by its ISO 3166-2 code (e.g. "UA-43")
+	Subdivision string
+
+	// Program is the sanctions program or regulation imposing the embargo
+	Program string
+}
+
+// DefaultConfig returns a disabled Config which screens against every regime's default table once enabled.
+func DefaultConfig() Config {
+	return Config{
+		Enabled:   false,
+		FinCEN311: true,
+	}
+}
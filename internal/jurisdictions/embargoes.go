@@ -0,0 +1,64 @@
+package jurisdictions
+
+const (
+	RegimeUS = "US"
+	RegimeEU = "EU"
+	RegimeUK = "UK"
+	RegimeUN = "UN"
+)
+
+var (
+	// Regions of Ukraine under territorial sanctions
+	crimea       = Embargo{Country: "UA", Subdivision: "UA-43"}
+	sevastopol   = Embargo{Country: "UA", Subdivision: "UA-40"}
+	donetsk      = Embargo{Country: "UA", Subdivision: "UA-14"}
+	luhansk      = Embargo{Country: "UA", Subdivision: "UA-09"}
+	zaporizhzhia = Embargo{Country: "UA", Subdivision: "UA-23"}
+	kherson      = Embargo{Country: "UA", Subdivision: "UA-65"}
+
+	// DefaultEmbargoes are the jurisdictions under comprehensive sanctions by each regime.
+	// Operators should review these against current regulations and override them with Config.Embargoes.
+	//
+	// UN sanctions on Iran (UNSCR 1737 and 2231) target listed parties and goods rather than the
+	// whole country, so Iran isn't in the UN table. The US, EU and UK lifted most of their sanctions
+	// on Syria in 2025 and the remaining measures target listed parties, so Syria isn't in any table.
+	DefaultEmbargoes = map[string][]Embargo{
+		RegimeUS: {
+			{Country: "CU", Program: "Cuban Assets Control Regulations (31 CFR 515)"},
+			{Country: "IR", Program: "Iranian Transactions and Sanctions Regulations (31 CFR 560)"},
+			{Country: "KP", Program: "North Korea Sanctions Regulations (31 CFR 510)"},
+			program(crimea, "Ukraine-/Russia-Related Sanctions (EO 13685)"),
+			program(sevastopol, "Ukraine-/Russia-Related Sanctions (EO 13685)"),
+			program(donetsk, "Ukraine-/Russia-Related Sanctions (EO 14065)"),
+			program(luhansk, "Ukraine-/Russia-Related Sanctions (EO 14065)"),
+		},
+		RegimeEU: {
+			{Country: "KP", Program: "Council Regulation (EU) 2017/1509"},
+			{Country: "IR", Program: "Council Regulation (EU) No 267/2012"},
+			program(crimea, "Council Regulation (EU) No 692/2014"),
+			program(sevastopol, "Council Regulation (EU) No 692/2014"),
+			program(donetsk, "Council Regulation (EU) 2022/263"),
+			program(luhansk, "Council Regulation (EU) 2022/263"),
+			program(zaporizhzhia, "Council Regulation (EU) 2022/263"),
+			program(kherson, "Council Regulation (EU) 2022/263"),
+		},
+		RegimeUK: {
+			{Country: "KP", Program: "The Democratic People's Republic of Korea (Sanctions) (EU Exit) Regulations 2019"},
+			{Country: "IR", Program: "The Iran (Sanctions) Regulations 2023"},
+			program(crimea, "The Russia (Sanctions) (EU Exit) Regulations 2019"),
+			program(sevastopol, "The Russia (Sanctions) (EU Exit) Regulations 2019"),
+			program(donetsk, "The Russia (Sanctions) (EU Exit) Regulations 2019"),
+			program(luhansk, "The Russia (Sanctions) (EU Exit) Regulations 2019"),
+			program(zaporizhzhia, "The Russia (Sanctions) (EU Exit) Regulations 2019"),
+			program(kherson, "The Russia (Sanctions) (EU Exit) Regulations 2019"),
+		},
+		RegimeUN: {
+			{Country: "KP", Program: "UN Security Council Resolution 1718 (2006)"},
+		},
+	}
+)
+
+func program(e Embargo, name string) Embargo {
+	e.Program = name
+	return e
+}
@@ -0,0 +1,261 @@
+package jurisdictions
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/moov-io/watchman/internal/norm"
+	"github.com/moov-io/watchman/pkg/search"
+	"github.com/moov-io/watchman/pkg/sources/fincen_311"
+)
+
+// Screener finds query fields located in jurisdictions under embargo or comprehensive sanctions.
+type Screener struct {
+	regimes   []string
+	embargoes map[string][]Embargo
+
+	fincen311 bool
+
+	mu sync.RWMutex
+	// listed are FinCEN 311 jurisdictions from the latest data refresh
+	listed    []Embargo
+	listedAt  time.Time
+	hasListed bool
+}
+
+// NewScreener returns a Screener for the configured regimes, or nil when screening is disabled.
+func NewScreener(conf Config) (*Screener, error) {
+	if !conf.Enabled {
+		return nil, nil
+	}
+
+	regimes := conf.Regimes
+	if len(regimes) == 0 {
+		regimes = []string{RegimeUS, RegimeEU, RegimeUK, RegimeUN}
+	}
+
+	s := &Screener{
+		embargoes: make(map[string][]Embargo),
+		fincen311: conf.FinCEN311,
+	}
+	for _, regime := range regimes {
+		regime = strings.ToUpper(strings.TrimSpace(regime))
+		if regime == "" || slices.Contains(s.regimes, regime) {
+			continue
+		}
+
+		table, exists := DefaultEmbargoes[regime]
+		for key, embargoes := range conf.Embargoes {
+			// config keys can be lowercased when they're read
+			if strings.EqualFold(key, regime) {
+				table, exists = embargoes, true
+			}
+		}
+		if !exists {
+			return nil, fmt.Errorf("no embargo table for %s regime", regime)
+		}
+
+		for _, e := range table {
+			country := norm.CountryCode(e.Country)
+			if country == "" {
+				return nil, fmt.Errorf("%s regime: unknown country %q", regime, e.Country)
+			}
+			s.embargoes[regime] = append(s.embargoes[regime], Embargo{
+				Country:     country,
+				Subdivision: strings.ToUpper(strings.TrimSpace(e.Subdivision)),
+				Program:     e.Program,
+			})
+		}
+		s.regimes = append(s.regimes, regime)
+	}
+	return s, nil
+}
+
+// NeedsFinCEN311 returns true when FinCEN 311 jurisdictions haven't been read from the refresh at refreshedAt.
+func (s *Screener) NeedsFinCEN311(refreshedAt time.Time) bool {
+	if s == nil || !s.fincen311 || !slices.Contains(s.regimes, RegimeUS) {
+		return false
+	}
+
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	return !s.hasListed || !s.listedAt.Equal(refreshedAt)
+}
+
+// UpdateFinCEN311 replaces the FinCEN 311 jurisdictions with the active jurisdictions found in entities.
+func (s *Screener) UpdateFinCEN311(refreshedAt time.Time, entities []search.Entity[search.Value]) {
+	if s == nil {
+		return
+	}
+
+	var listed []Embargo
+	for _, entity := range entities {
+		if entity.Source != search.SourceUSFinCEN311 {
+			continue
+		}
+
+		var sm fincen_311.SpecialMeasure
+		switch v := entity.SourceData.(type) {
+		case fincen_311.SpecialMeasure:
+			sm = v
+		case *fincen_311.SpecialMeasure:
+			sm = *v
+		default:
+			continue
+		}
+		if sm.EntityType != fincen_311.SMTypeJurisdiction || sm.IsRescinded {
+			continue
+		}
+
+		if country := norm.CountryCode(sm.EntityName); country != "" {
+			listed = append(listed, Embargo{
+				Country: country,
+				Program: "FinCEN Section 311 Special Measures",
+			})
+		}
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.listed = listed
+	s.listedAt = refreshedAt
+	s.hasListed = true
+}
+
+// Screen returns the jurisdiction hits of a normalized query's addresses, phone and fax numbers,
+// government IDs and place of birth.
+func (s *Screener) Screen(query search.Entity[search.Value]) []search.JurisdictionHit {
+	if s == nil {
+		return nil
+	}
+
+	s.mu.RLock()
+	listed := s.listed
+	s.mu.RUnlock()
+
+	var out []search.JurisdictionHit
+	add := func(field, value, country, subdivision string) {
+		if country == "" && subdivision == "" {
+			return
+		}
+		for _, regime := range s.regimes {
+			embargoes := s.embargoes[regime]
+			if regime == RegimeUS && s.fincen311 {
+				embargoes = append(slices.Clip(embargoes), listed...)
+			}
+
+			for _, e := range embargoes {
+				if !e.covers(country, subdivision) {
+					continue
+				}
+				hit := search.JurisdictionHit{
+					Regime:      regime,
+					Program:     e.Program,
+					Country:     e.Country,
+					Subdivision: e.Subdivision,
+					Field:       field,
+					Value:       value,
+				}
+				if !slices.Contains(out, hit) {
+					out = append(out, hit)
+				}
+			}
+		}
+	}
+
+	for idx, addr := range query.Addresses {
+		var subdivision string
+		if idx < len(query.PreparedFields.Addresses) {
+			subdivision = query.PreparedFields.Addresses[idx].Subdivision
+		}
+		add("address", formatAddress(addr), norm.CountryCode(addr.Country), subdivision)
+	}
+
+	for _, phone := range query.PreparedFields.Phones {
+		for _, country := range norm.PhoneCountries(phone) {
+			add("phone", phone.E164, country, "")
+		}
+	}
+	for _, fax := range query.PreparedFields.Faxes {
+		for _, country := range norm.PhoneCountries(fax) {
+			add("fax", fax.E164, country, "")
+		}
+	}
+
+	for _, id := range governmentIDs(query) {
+		field := "governmentID"
+		switch id.Type {
+		case search.GovernmentIDPassport, search.GovernmentIDDiplomaticPass,
+			search.GovernmentIDCitizenship, search.GovernmentIDNationality:
+			field = "nationality"
+		}
+		add(field, strings.TrimSpace(id.Country+" "+id.Identifier), norm.CountryCode(id.Country), "")
+	}
+
+	if query.Person != nil && query.Person.PlaceOfBirth != "" {
+		country, subdivision := placeOfBirth(query.Person.PlaceOfBirth)
+		add("placeOfBirth", query.Person.PlaceOfBirth, country, subdivision)
+	}
+
+	return out
+}
+
+// covers returns true when the embargo applies to the country or subdivision.
+// Embargoes on a region only cover addresses within that region.
+func (e Embargo) covers(country, subdivision string) bool {
+	if e.Subdivision != "" {
+		return strings.EqualFold(e.Subdivision, subdivision)
+	}
+	return e.Country == country
+}
+
+func governmentIDs(query search.Entity[search.Value]) []search.GovernmentID {
+	switch {
+	case query.Person != nil:
+		return query.Person.GovernmentIDs
+	case query.Business != nil:
+		return query.Business.GovernmentIDs
+	case query.Organization != nil:
+		return query.Organization.GovernmentIDs
+	}
+	return nil
+}
+
+func formatAddress(addr search.Address) string {
+	var parts []string
+	for _, part := range []string{addr.Line1, addr.Line2, addr.City, addr.State, addr.PostalCode, addr.Country} {
+		if part = strings.TrimSpace(part); part != "" {
+			parts = append(parts, part)
+		}
+	}
+	return strings.Join(parts, ", ")
+}
+
+// placeOfBirth reads the country and subdivision from text such as "Simferopol, Crimea, Ukraine"
+func placeOfBirth(text string) (string, string) {
+	parts := strings.Split(text, ",")
+	for i := range parts {
+		parts[i] = strings.TrimSpace(parts[i])
+	}
+
+	country := parts[len(parts)-1]
+
+	var state, city string
+	if len(parts) > 1 {
+		city = parts[0]
+	}
+	if len(parts) > 2 {
+		state = parts[len(parts)-2]
+	}
+
+	var subdivision string
+	if sub, found := norm.LookupSubdivision(country, state, city); found {
+		subdivision = sub.Code
+	}
+	return norm.CountryCode(country), subdivision
+}
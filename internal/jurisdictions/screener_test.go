@@ -0,0 +1,192 @@
+package jurisdictions
+
+import (
+	"testing"
+	"time"
+
+	"github.com/moov-io/watchman/pkg/search"
+	"github.com/moov-io/watchman/pkg/sources/fincen_311"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewScreener(t *testing.T) {
+	s, err := NewScreener(Config{})
+	require.NoError(t, err)
+	require.Nil(t, s)
+	require.Empty(t, s.Screen(search.Entity[search.Value]{}))
+
+	conf := DefaultConfig()
+	require.False(t, conf.Enabled)
+
+	conf.Enabled = true
+	s, err = NewScreener(conf)
+	require.NoError(t, err)
+	require.Equal(t, []string{"US", "EU", "UK", "UN"}, s.regimes)
+
+	// Iran is under targeted, not comprehensive, UN sanctions
+	for _, e := range s.embargoes[RegimeUN] {
+		require.NotEqual(t, "IR", e.Country)
+	}
+
+	// Most sanctions on Syria were lifted in 2025
+	for regime, embargoes := range s.embargoes {
+		for _, e := range embargoes {
+			require.NotEqual(t, "SY", e.Country, regime)
+		}
+	}
+
+	s, err = NewScreener(Config{
+		Enabled: true,
+		Regimes: []string{"us", "un"},
+		Embargoes: map[string][]Embargo{
+			"us": {{Country: "Cuba", Program: "CACR"}},
+		},
+	})
+	require.NoError(t, err)
+	require.Equal(t, []string{"US", "UN"}, s.regimes)
+	require.Equal(t, []Embargo{{Country: "CU", Program: "CACR"}}, s.embargoes["US"])
+
+	_, err = NewScreener(Config{Enabled: true, Regimes: []string{"CA"}})
+	require.ErrorContains(t, err, "no embargo table for CA regime")
+
+	_, err = NewScreener(Config{
+		Enabled:   true,
+		Regimes:   []string{"US"},
+		Embargoes: map[string][]Embargo{"US": {{Country: "Atlantis"}}},
+	})
+	require.ErrorContains(t, err, `unknown country "Atlantis"`)
+}
+
+func TestScreener_Screen(t *testing.T) {
+	s, err := NewScreener(Config{Enabled: true, Regimes: []string{"US", "EU"}})
+	require.NoError(t, err)
+
+	t.Run("addresses", func(t *testing.T) {
+		query := search.Entity[search.Value]{
+			Type: search.EntityBusiness,
+			Addresses: []search.Address{
+				{Line1: "Calle 23", City: "Havana", Country: "Cuba"},
+				{Line1: "ul. Kirova 12", City: "Simferopol", Country: "Russia"},
+				{City: "Melitopol", Country: "Ukraine"},
+				{City: "Kyiv", Country: "Ukraine"},
+			},
+		}.Normalize()
+
+		hits := s.Screen(query)
+		require.Len(t, hits, 4)
+
+		require.Equal(t, search.JurisdictionHit{
+			Regime:  "US",
+			Program: "Cuban Assets Control Regulations (31 CFR 515)",
+			Country: "CU",
+			Field:   "address",
+			Value:   "Calle 23, Havana, Cuba",
+		}, hits[0])
+
+		require.Equal(t, "US", hits[1].Regime)
+		require.Equal(t, "UA-43", hits[1].Subdivision)
+		require.Equal(t, "EU", hits[2].Regime)
+		require.Equal(t, "UA-43", hits[2].Subdivision)
+
+		// Zaporizhzhia is only under EU territorial sanctions
+		require.Equal(t, "EU", hits[3].Regime)
+		require.Equal(t, "UA-23", hits[3].Subdivision)
+	})
+
+	t.Run("phone numbers", func(t *testing.T) {
+		query := search.Entity[search.Value]{
+			Contact: search.ContactInfo{
+				PhoneNumbers: []string{"+850 2 381 4100", "+1 555 123 4567"},
+				FaxNumbers:   []string{"+98 21 8896 1234"},
+			},
+		}.Normalize()
+
+		hits := s.Screen(query)
+		require.Len(t, hits, 4)
+		require.Equal(t, "phone", hits[0].Field)
+		require.Equal(t, "KP", hits[0].Country)
+		require.Equal(t, "+85023814100", hits[0].Value)
+		require.Equal(t, "fax", hits[3].Field)
+		require.Equal(t, "IR", hits[3].Country)
+	})
+
+	t.Run("nationality and place of birth", func(t *testing.T) {
+		query := search.Entity[search.Value]{
+			Type: search.EntityPerson,
+			Person: &search.Person{
+				PlaceOfBirth: "Pyongyang, North Korea",
+				GovernmentIDs: []search.GovernmentID{
+					{Type: search.GovernmentIDPassport, Country: "IR", Identifier: "A1234"},
+					{Type: search.GovernmentIDTax, Country: "US", Identifier: "123"},
+				},
+			},
+		}.Normalize()
+
+		hits := s.Screen(query)
+		require.Len(t, hits, 4)
+		require.Equal(t, "nationality", hits[0].Field)
+		require.Equal(t, "IR A1234", hits[0].Value)
+		require.Equal(t, "placeOfBirth", hits[2].Field)
+		require.Equal(t, "KP", hits[2].Country)
+	})
+
+	t.Run("no hits", func(t *testing.T) {
+		query := search.Entity[search.Value]{
+			Addresses: []search.Address{{City: "Berlin", Country: "Germany"}},
+		}.Normalize()
+		require.Empty(t, s.Screen(query))
+	})
+}
+
+func TestScreener_FinCEN311(t *testing.T) {
+	s, err := NewScreener(Config{Enabled: true, Regimes: []string{"US"}, FinCEN311: true})
+	require.NoError(t, err)
+
+	refreshedAt := time.Now()
+	require.True(t, s.NeedsFinCEN311(refreshedAt))
+
+	s.UpdateFinCEN311(refreshedAt, []search.Entity[search.Value]{
+		{
+			Source: search.SourceUSFinCEN311,
+			SourceData: fincen_311.SpecialMeasure{
+				EntityName: "Burma",
+				EntityType: fincen_311.SMTypeJurisdiction,
+			},
+		},
+		{
+			Source: search.SourceUSFinCEN311,
+			SourceData: fincen_311.SpecialMeasure{
+				EntityName:  "Ukraine",
+				EntityType:  fincen_311.SMTypeJurisdiction,
+				IsRescinded: true,
+			},
+		},
+		{
+			Source: search.SourceUSFinCEN311,
+			SourceData: fincen_311.SpecialMeasure{
+				EntityName: "Bank of Dandong",
+				EntityType: fincen_311.SMTypeFinancialInstitution,
+			},
+		},
+	})
+	require.False(t, s.NeedsFinCEN311(refreshedAt))
+	require.True(t, s.NeedsFinCEN311(refreshedAt.Add(time.Hour)))
+
+	query := search.Entity[search.Value]{
+		Addresses: []search.Address{
+			{City: "Yangon", Country: "Myanmar"},
+			{City: "Kyiv", Country: "Ukraine"},
+		},
+	}.Normalize()
+
+	hits := s.Screen(query)
+	require.Len(t, hits, 1)
+	require.Equal(t, "MM", hits[0].Country)
+	require.Equal(t, "FinCEN Section 311 Special Measures", hits[0].Program)
+
+	// Without the US regime FinCEN 311 isn't checked
+	s, err = NewScreener(Config{Enabled: true, Regimes: []string{"EU"}, FinCEN311: true})
+	require.NoError(t, err)
+	require.False(t, s.NeedsFinCEN311(refreshedAt))
+}
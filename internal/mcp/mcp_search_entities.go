@@ -45,6 +45,11 @@ type SearchEntitiesRequest struct {
 	MinMatch *float64 `json:"minMatch,omitempty" jsonschema:"Minimum match score threshold (default 0.0)"`
 
 	IncludeDetails *bool `json:"includeDetails,omitempty" jsonschema:"Include field-level match score breakdown per result (names, addresses, IDs, etc.)"`
+	ExcludeExpired *bool `json:"excludeExpired,omitempty" jsonschema:"Exclude debarments which have ended (default false)"`
+
+	Categories     []pubsearch.ListCategory `json:"categories,omitempty" jsonschema:"Only return entities in these categories: sanctions, pep or debarment"`
+	PEPTenureYears *int                     `json:"pepTenureYears,omitempty" jsonschema:"Exclude PEPs who left their last position more than this many years ago"`
+	SubLists       []string                 `json:"subLists,omitempty" jsonschema:"Only return entities from these lists of us_csl or us_non_sdn, e.g. EL, DPL or SSI"`
 }
 
 func (s *Server) HandleSearchEntities(ctx context.Context, req *mcp.CallToolRequest, args SearchEntitiesRequest) (*mcp.CallToolResult, any, error) {
@@ -86,6 +91,14 @@ func (s *Server) HandleSearchEntities(ctx context.Context, req *mcp.CallToolRequ
 	if args.IncludeDetails != nil && *args.IncludeDetails {
 		opts.Debug = true
 	}
+	if args.ExcludeExpired != nil {
+		opts.ExcludeExpired = *args.ExcludeExpired
+	}
+	opts.Categories = args.Categories
+	opts.SubLists = args.SubLists
+	if args.PEPTenureYears != nil {
+		opts.PEPTenureYears = *args.PEPTenureYears
+	}
 
 	// Normalize the request
 	searchReq = searchReq.Normalize()
@@ -6,6 +6,8 @@ import (
 	"cmp"
 	"strings"
 
+	"github.com/moov-io/watchman/internal/prepare"
+
 	"github.com/moov-io/iso3166"
 )
 
@@ -31,6 +33,37 @@ var (
 		"VI":  "Virgin Islands",
 		"VN":  "Vietnam",
 	}
+
+	// officialCountryNames maps formal names which sanctions lists use onto their ISO 3166 code
+	officialCountryNames = map[string]string{
+		"DEMOCRATIC PEOPLE'S REPUBLIC OF KOREA": "KP",
+		"DPRK":                                  "KP",
+		"ISLAMIC REPUBLIC OF IRAN":              "IR",
+		"REPUBLIC OF KOREA":                     "KR",
+	}
+
+	// historicalCountries maps former country names and ISO 3166-3 codes onto their successor state
+	historicalCountries = map[string]string{
+		"BU":                                  "MM",
+		"BURMA":                               "MM",
+		"CS":                                  "RS",
+		"DD":                                  "DE",
+		"EAST GERMANY":                        "DE",
+		"FEDERAL REPUBLIC OF YUGOSLAVIA":      "RS",
+		"GERMAN DEMOCRATIC REPUBLIC":          "DE",
+		"RHODESIA":                            "ZW",
+		"SERBIA AND MONTENEGRO":               "RS",
+		"SOVIET UNION":                        "RU",
+		"SU":                                  "RU",
+		"SWAZILAND":                           "SZ",
+		"UNION OF SOVIET SOCIALIST REPUBLICS": "RU",
+		"USSR":                                "RU",
+		"WEST GERMANY":                        "DE",
+		"YU":                                  "RS",
+		"YUGOSLAVIA":                          "RS",
+		"ZAIRE":                               "CD",
+		"ZR":                                  "CD",
+	}
 )
 
 func Country(input string) string {
@@ -47,7 +80,40 @@ func Country(input string) string {
 		return cmp.Or(over, iso3166.GetName(code))
 	}
 
+	// Formal or former country names and sanctioned regions written as a country
+	if code := officialCountryNames[strings.ToUpper(strings.TrimSpace(input))]; code != "" {
+		return Country(code)
+	}
+	if code := historicalCountries[strings.ToUpper(strings.TrimSpace(input))]; code != "" {
+		return Country(code)
+	}
+	if region, found := sanctionedRegionNames[prepare.LowerAndRemovePunctuation(input)]; found {
+		return Country(region.Country)
+	}
+
 	// return whatever we have
 	over := countryCodeOverrides[strings.ToUpper(input)]
 	return cmp.Or(over, input)
 }
+
+// CountryCode returns the ISO 3166 alpha-2 code of a country code or name, or an empty string
+// when the country is unknown.
+func CountryCode(input string) string {
+	input = strings.ToUpper(strings.TrimSpace(input))
+	if input == "UK" {
+		return "GB"
+	}
+	if code := officialCountryNames[input]; code != "" {
+		return code
+	}
+	if code := historicalCountries[input]; code != "" {
+		return code
+	}
+	if region, found := sanctionedRegionNames[prepare.LowerAndRemovePunctuation(input)]; found {
+		return region.Country
+	}
+	if iso3166.GetName(input) != "" && len(input) == 2 {
+		return input
+	}
+	return iso3166.LookupCode(Country(input))
+}
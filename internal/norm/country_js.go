@@ -5,3 +5,7 @@ package norm
 func Country(input string) string {
 	return input
 }
+
+func CountryCode(input string) string {
+	return ""
+}
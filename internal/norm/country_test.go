@@ -30,6 +30,13 @@ func TestNormalizeCountry(t *testing.T) {
 		{input: "china", expected: "China"},
 		{input: "north korea", expected: "North Korea"},
 		{input: "South KOREA", expected: "South Korea"},
+		{input: "Burma", expected: "Myanmar"},
+		{input: "Zaire", expected: "Congo, Democratic Republic of the"},
+		{input: "USSR", expected: "Russia"},
+		{input: "Soviet Union", expected: "Russia"},
+		{input: "Yugoslavia", expected: "Serbia"},
+		{input: "YU", expected: "Serbia"},
+		{input: "Crimea", expected: "Ukraine"},
 	}
 	for _, tc := range cases {
 		t.Run(tc.input, func(t *testing.T) {
@@ -58,3 +65,20 @@ func TestNormalizeOverrides(t *testing.T) {
 	require.Equal(t, "Virgin Islands", norm.Country("VG"))
 	require.Equal(t, "Virgin Islands", norm.Country("VI"))
 }
+
+func TestCountryCode(t *testing.T) {
+	require.Equal(t, "US", norm.CountryCode("us"))
+	require.Equal(t, "US", norm.CountryCode("USA"))
+	require.Equal(t, "US", norm.CountryCode("United States of America"))
+	require.Equal(t, "GB", norm.CountryCode("UK"))
+	require.Equal(t, "GB", norm.CountryCode("England"))
+	require.Equal(t, "RU", norm.CountryCode("Russian Federation"))
+	require.Equal(t, "SX", norm.CountryCode("SX"))
+	require.Equal(t, "IR", norm.CountryCode("Islamic Republic of Iran"))
+	require.Equal(t, "KP", norm.CountryCode("Democratic People's Republic of Korea"))
+	require.Equal(t, "CD", norm.CountryCode("Zaire"))
+	require.Equal(t, "RU", norm.CountryCode("USSR"))
+	require.Equal(t, "UA", norm.CountryCode("Crimea"))
+	require.Equal(t, "", norm.CountryCode("Atlantis"))
+	require.Equal(t, "", norm.CountryCode(""))
+}
@@ -4,6 +4,7 @@ package norm
 
 import (
 	"cmp"
+	"slices"
 	"strings"
 
 	"github.com/dongri/phonenumber"
@@ -34,3 +35,165 @@ func PhoneNumber(input string) string {
 
 	return phoneNumberCleaner.Replace(cmp.Or(number, input))
 }
+
+var (
+	// missingPhoneCountries are countries which the phonenumber package doesn't include
+	missingPhoneCountries = []phonenumber.ISO3166{
+		{Alpha2: "KP", Alpha3: "PRK", CountryCode: "850", CountryName: "North Korea", PhoneNumberLengths: []int{8, 9, 10}},
+	}
+
+	// phoneCountries are the calling codes and national number lengths of each country
+	phoneCountries = func() map[string]phonenumber.ISO3166 {
+		out := make(map[string]phonenumber.ISO3166)
+		for _, country := range slices.Concat(phonenumber.GetISO3166(), missingPhoneCountries) {
+			if _, exists := out[country.Alpha2]; !exists {
+				out[country.Alpha2] = country
+			}
+		}
+		return out
+	}()
+
+	callingCodes = func() map[string]bool {
+		out := make(map[string]bool)
+		for _, country := range slices.Concat(phonenumber.GetISO3166(), missingPhoneCountries) {
+			out[country.CountryCode] = true
+		}
+		return out
+	}()
+)
+
+const maxE164Digits = 15
+
+// ParsePhoneNumber normalizes a phone number into E.164 and its national number.
+//
+// Numbers written with a "+" or "00" prefix are read as international numbers. Other numbers are
+// read as local numbers from the first of countries (ISO 3166 codes or names) where the length of
+// the number fits. Without countries only mobile numbers written with their calling code are recognized.
+func ParsePhoneNumber(input string, countries ...string) Phone {
+	digits := phoneDigits(input)
+	if digits == "" {
+		return Phone{}
+	}
+
+	trimmed := strings.TrimSpace(input)
+	if strings.HasPrefix(trimmed, "+") || strings.HasPrefix(digits, "00") {
+		return parseInternationalPhone(strings.TrimPrefix(digits, "00"))
+	}
+
+	// Read the number as a local number from each country
+	var candidates []Phone
+	for _, name := range countries {
+		country, exists := phoneCountries[CountryCode(name)]
+		if !exists {
+			continue
+		}
+
+		national, fits := nationalPhoneNumber(digits, country)
+		if national == "" {
+			continue
+		}
+		phone := Phone{
+			E164:     "+" + country.CountryCode + national,
+			National: national,
+		}
+		if len(phone.E164)-1 > maxE164Digits {
+			continue
+		}
+		if fits {
+			return phone
+		}
+		candidates = append(candidates, phone)
+	}
+	if len(candidates) > 0 {
+		return candidates[0]
+	}
+
+	// Look for mobile numbers which include their calling code
+	if country := phonenumber.GetISO3166ByNumber(digits, false); country.Alpha2 != "" {
+		return Phone{
+			E164:     "+" + digits,
+			National: strings.TrimPrefix(digits, country.CountryCode),
+		}
+	}
+
+	return Phone{National: strings.TrimLeft(digits, "0")}
+}
+
+func parseInternationalPhone(digits string) Phone {
+	for size := 1; size <= 3 && size < len(digits); size++ {
+		code := digits[:size]
+		if !callingCodes[code] {
+			continue
+		}
+
+		// Drop the trunk prefix sometimes written as "+44 (0)20..." except in Italy where it's dialed
+		national := digits[size:]
+		if code != "39" {
+			national = strings.TrimPrefix(national, "0")
+		}
+		if national == "" || len(code)+len(national) > maxE164Digits {
+			break
+		}
+		return Phone{
+			E164:     "+" + code + national,
+			National: national,
+		}
+	}
+	return Phone{National: strings.TrimLeft(digits, "0")}
+}
+
+// PhoneCountries returns the ISO 3166 alpha-2 codes of countries which share the calling code
+// of an E.164 number. Numbers without a calling code return nil.
+func PhoneCountries(p Phone) []string {
+	digits := strings.TrimPrefix(p.E164, "+")
+	if digits == "" {
+		return nil
+	}
+	code := strings.TrimSuffix(digits, p.National)
+
+	var out []string
+	for alpha2, country := range phoneCountries {
+		if country.CountryCode == code {
+			out = append(out, alpha2)
+		}
+	}
+	slices.Sort(out)
+	return out
+}
+
+// nationalPhoneNumber removes the calling code or trunk prefix from a number in the country.
+// The number fits when its length is a known length of national numbers in the country.
+func nationalPhoneNumber(digits string, country phonenumber.ISO3166) (string, bool) {
+	fits := func(national string) bool {
+		return slices.Contains(country.PhoneNumberLengths, len(national))
+	}
+
+	// Numbers written with their calling code but without a "+"
+	if withoutCode, found := strings.CutPrefix(digits, country.CountryCode); found {
+		if fits(withoutCode) || fits(strings.TrimPrefix(withoutCode, "0")) {
+			return strings.TrimPrefix(withoutCode, "0"), true
+		}
+	}
+
+	// North American numbers don't have a trunk prefix of 0
+	if country.CountryCode == "1" && strings.HasPrefix(digits, "0") {
+		return strings.TrimLeft(digits, "0"), false
+	}
+
+	national := digits
+	switch country.Alpha2 {
+	case "IT":
+		// Italian numbers keep their leading zero
+	case "RU", "KZ", "BY":
+		if len(national) == 11 && strings.HasPrefix(national, "8") {
+			national = national[1:]
+		}
+		national = strings.TrimLeft(national, "0")
+	default:
+		national = strings.TrimLeft(national, "0")
+	}
+	if len(national) < 4 {
+		return "", false
+	}
+	return national, fits(national)
+}
@@ -2,6 +2,18 @@
 
 package norm
 
+import (
+	"strings"
+)
+
 func PhoneNumber(input string) string {
 	return input
 }
+
+func ParsePhoneNumber(input string, countries ...string) Phone {
+	return Phone{National: strings.TrimLeft(phoneDigits(input), "0")}
+}
+
+func PhoneCountries(p Phone) []string {
+	return nil
+}
@@ -0,0 +1,44 @@
+package norm
+
+import (
+	"strings"
+)
+
+// Phone is a phone number in its international and national forms.
+type Phone struct {
+	// E164 is the international form, e.g. "+442071234567". It's empty when the country is unknown.
+	E164 string
+
+	// National is the national significant number, e.g. "2071234567". When the country is unknown
+	// it's every digit of the number without leading zeros.
+	National string
+}
+
+// Empty returns true when the phone number has no digits.
+func (p Phone) Empty() bool {
+	return p.E164 == "" && p.National == ""
+}
+
+// Matches returns true when both numbers are the same. Numbers from unknown countries are
+// compared by their digits.
+func (p Phone) Matches(other Phone) bool {
+	if p.Empty() || other.Empty() {
+		return false
+	}
+	if p.E164 != "" && other.E164 != "" {
+		return p.E164 == other.E164
+	}
+	return p.National == other.National ||
+		strings.TrimPrefix(p.E164, "+") == other.National ||
+		strings.TrimPrefix(other.E164, "+") == p.National
+}
+
+func phoneDigits(input string) string {
+	var sb strings.Builder
+	for _, r := range input {
+		if r >= '0' && r <= '9' {
+			sb.WriteRune(r)
+		}
+	}
+	return sb.String()
+}
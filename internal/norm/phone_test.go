@@ -113,6 +113,52 @@ func TestPhoneNumber_OFAC(t *testing.T) {
 	}
 }
 
+func TestParsePhoneNumber(t *testing.T) {
+	cases := []struct {
+		input     string
+		countries []string
+		expected  norm.Phone
+	}{
+		// International numbers
+		{"+44 20 7123 4567", nil, norm.Phone{E164: "+442071234567", National: "2071234567"}},
+		{"+44 (0)20 7123 4567", []string{"US"}, norm.Phone{E164: "+442071234567", National: "2071234567"}},
+		{"0044 20 7123 4567", nil, norm.Phone{E164: "+442071234567", National: "2071234567"}},
+		{"+39 06 6982", nil, norm.Phone{E164: "+39066982", National: "066982"}},
+		{"+52 686-383-6864", nil, norm.Phone{E164: "+526863836864", National: "6863836864"}},
+
+		// Local numbers with country hints
+		{"(021) 2201-3392", []string{"Iran"}, norm.Phone{E164: "+982122013392", National: "2122013392"}},
+		{"(98)(21)(22013392)", []string{"IR"}, norm.Phone{E164: "+982122013392", National: "2122013392"}},
+		{"020 7123 4567", []string{"United Kingdom"}, norm.Phone{E164: "+442071234567", National: "2071234567"}},
+		{"8 (495) 123-45-67", []string{"Russia"}, norm.Phone{E164: "+74951234567", National: "4951234567"}},
+		{"(212) 555-1234", []string{"Atlantis", "US"}, norm.Phone{E164: "+12125551234", National: "2125551234"}},
+		{"1-212-555-1234", []string{"US"}, norm.Phone{E164: "+12125551234", National: "2125551234"}},
+		{"020 7123 4567", []string{"US", "GB"}, norm.Phone{E164: "+442071234567", National: "2071234567"}},
+
+		// Unknown countries
+		{"9821227700019", nil, norm.Phone{National: "9821227700019"}},
+		{"0123 4567", nil, norm.Phone{National: "1234567"}},
+		{"917 555 1234", []string{"Atlantis"}, norm.Phone{National: "9175551234"}},
+		{"", []string{"US"}, norm.Phone{}},
+	}
+	for _, tc := range cases {
+		t.Run(tc.input, func(t *testing.T) {
+			got := norm.ParsePhoneNumber(tc.input, tc.countries...)
+			require.Equal(t, tc.expected, got)
+		})
+	}
+}
+
+func TestPhone_Matches(t *testing.T) {
+	london := norm.ParsePhoneNumber("+44 20 7123 4567")
+	require.True(t, london.Matches(norm.ParsePhoneNumber("020 7123 4567", "GB")))
+	require.True(t, london.Matches(norm.ParsePhoneNumber("442071234567")))
+	require.True(t, london.Matches(norm.ParsePhoneNumber("020 7123 4567")))
+	require.False(t, london.Matches(norm.ParsePhoneNumber("020 7123 4567", "IE")))
+	require.False(t, london.Matches(norm.ParsePhoneNumber("+1 207 123 4567")))
+	require.False(t, london.Matches(norm.Phone{}))
+}
+
 func BenchmarkPhoneNumber(b *testing.B) {
 	inputs := []string{
 		"53 212 5078868",
@@ -130,3 +176,14 @@ func BenchmarkPhoneNumber(b *testing.B) {
 		norm.PhoneNumber(inputs[(len(inputs)-1)%b.N])
 	}
 }
+
+func TestPhoneCountries(t *testing.T) {
+	require.Equal(t, []string{"CU"}, norm.PhoneCountries(norm.ParsePhoneNumber("+53 7 832 1234")))
+	require.Equal(t, []string{"IR"}, norm.PhoneCountries(norm.ParsePhoneNumber("0098 21 8896 1234")))
+	require.Equal(t, []string{"KP"}, norm.PhoneCountries(norm.ParsePhoneNumber("+850 2 381 4100")))
+	require.Contains(t, norm.PhoneCountries(norm.ParsePhoneNumber("+1 555 123 4567")), "US")
+	require.Contains(t, norm.PhoneCountries(norm.ParsePhoneNumber("+7 978 123 4567")), "RU")
+
+	require.Empty(t, norm.PhoneCountries(norm.ParsePhoneNumber("555 123 4567")))
+	require.Empty(t, norm.PhoneCountries(norm.Phone{}))
+}
@@ -0,0 +1,16 @@
+package norm
+
+// Subdivision is a first-level administrative division of a country, such as a state or province.
+type Subdivision struct {
+	// Code is the ISO 3166-2 code (e.g. "UA-43")
+	Code string
+
+	Name string
+
+	// Country is the ISO 3166 alpha-2 code of the country the subdivision is part of
+	Country string
+
+	// Sanctioned is true for regions targeted by sanctions programs regardless of which country
+	// the address names, such as Crimea or the occupied regions of Ukraine.
+	Sanctioned bool
+}
@@ -0,0 +1,140 @@
+//go:build !js
+
+package norm
+
+import (
+	"strings"
+
+	"github.com/moov-io/watchman/internal/prepare"
+
+	"github.com/pariz/gountries"
+)
+
+var (
+	sanctionedRegions = []struct {
+		subdivision Subdivision
+		names       []string
+		cities      []string
+	}{
+		{
+			subdivision: Subdivision{Code: "UA-43", Name: "Crimea", Country: "UA", Sanctioned: true},
+			names: []string{
+				"crimea", "krym", "krim", "respublika krym", "republic of crimea", "autonomous republic of crimea",
+				"crimean peninsula",
+			},
+			cities: []string{"simferopol", "kerch", "yalta", "yevpatoria", "evpatoria", "feodosia", "dzhankoi"},
+		},
+		{
+			subdivision: Subdivision{Code: "UA-40", Name: "Sevastopol", Country: "UA", Sanctioned: true},
+			names:       []string{"sevastopol", "sevastopol city"},
+			cities:      []string{"sevastopol"},
+		},
+		{
+			subdivision: Subdivision{Code: "UA-14", Name: "Donetsk", Country: "UA", Sanctioned: true},
+			names: []string{
+				"donetsk", "donetska", "donetsk oblast", "donetsk region", "donetska oblast", "doneck",
+				"donetsk peoples republic", "donetsk people s republic", "dnr", "dpr",
+			},
+			cities: []string{"donetsk", "mariupol", "makiivka", "horlivka"},
+		},
+		{
+			subdivision: Subdivision{Code: "UA-09", Name: "Luhansk", Country: "UA", Sanctioned: true},
+			names: []string{
+				"luhansk", "luhanska", "luhansk oblast", "luhansk region", "luhanska oblast", "lugansk",
+				"lugansk oblast", "luhansk peoples republic", "luhansk people s republic", "lugansk peoples republic",
+				"lugansk people s republic", "lnr", "lpr",
+			},
+			cities: []string{"luhansk", "lugansk", "alchevsk", "sievierodonetsk", "severodonetsk"},
+		},
+		{
+			subdivision: Subdivision{Code: "UA-23", Name: "Zaporizhzhia", Country: "UA", Sanctioned: true},
+			names: []string{
+				"zaporizhzhia", "zaporizhzhia oblast", "zaporizhzhia region", "zaporizhia", "zaporizhzhya",
+				"zaporozhye", "zaporizka oblast",
+			},
+			// the city of Zaporizhzhia remains under government control
+			cities: []string{"melitopol", "berdyansk", "enerhodar"},
+		},
+		{
+			subdivision: Subdivision{Code: "UA-65", Name: "Kherson", Country: "UA", Sanctioned: true},
+			names:       []string{"kherson", "kherson oblast", "kherson region", "khersonska oblast"},
+			cities:      []string{"nova kakhovka", "henichesk", "skadovsk"},
+		},
+	}
+
+	sanctionedRegionNames = func() map[string]Subdivision {
+		out := make(map[string]Subdivision)
+		for _, region := range sanctionedRegions {
+			for _, name := range region.names {
+				out[name] = region.subdivision
+			}
+		}
+		return out
+	}()
+
+	sanctionedRegionCities = func() map[string]Subdivision {
+		out := make(map[string]Subdivision)
+		for _, region := range sanctionedRegions {
+			for _, city := range region.cities {
+				out[city] = region.subdivision
+			}
+		}
+		return out
+	}()
+)
+
+// LookupSubdivision returns the subdivision an address is in. Sanctioned regions are recognized
+// from the state, country or major cities in the region, while other subdivisions need the country
+// along with a state name or code (e.g. "California" or "CA" in the United States).
+func LookupSubdivision(country, state, city string) (Subdivision, bool) {
+	for _, name := range []string{state, country} {
+		if sub, found := sanctionedRegionNames[prepare.LowerAndRemovePunctuation(name)]; found {
+			return sub, true
+		}
+	}
+	if sub, found := sanctionedRegionCities[prepare.LowerAndRemovePunctuation(city)]; found {
+		return sub, true
+	}
+
+	state = strings.TrimSpace(state)
+	if state == "" {
+		return Subdivision{}, false
+	}
+	code := CountryCode(country)
+	if code == "" {
+		return Subdivision{}, false
+	}
+
+	// ISO 3166-2 codes can be given with or without the country prefix
+	state = strings.TrimPrefix(strings.ToUpper(state), code+"-")
+
+	c, err := gountries.New().FindCountryByAlpha(code)
+	if err != nil {
+		return Subdivision{}, false
+	}
+	sub, err := c.FindSubdivisionByCode(state)
+	if err != nil {
+		sub, err = c.FindSubdivisionByName(state)
+		if err != nil {
+			return Subdivision{}, false
+		}
+	}
+
+	out := Subdivision{
+		Code:    code + "-" + sub.Code,
+		Name:    sub.Name,
+		Country: code,
+	}
+	out.Sanctioned = IsSanctionedRegion(out.Code)
+	return out, true
+}
+
+// IsSanctionedRegion returns true when the ISO 3166-2 code is a region targeted by sanctions programs.
+func IsSanctionedRegion(code string) bool {
+	for _, region := range sanctionedRegions {
+		if strings.EqualFold(region.subdivision.Code, code) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,11 @@
+//go:build js
+
+package norm
+
+func LookupSubdivision(country, state, city string) (Subdivision, bool) {
+	return Subdivision{}, false
+}
+
+func IsSanctionedRegion(code string) bool {
+	return false
+}
@@ -0,0 +1,54 @@
+package norm_test
+
+import (
+	"testing"
+
+	"github.com/moov-io/watchman/internal/norm"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestLookupSubdivision(t *testing.T) {
+	cases := []struct {
+		country, state, city string
+		expected             string
+		sanctioned           bool
+	}{
+		{country: "US", state: "CA", expected: "US-CA"},
+		{country: "United States", state: "California", expected: "US-CA"},
+		{country: "United States", state: "US-NY", city: "New York", expected: "US-NY"},
+		{country: "Canada", state: "Ontario", expected: "CA-ON"},
+		{country: "Ukraine", state: "Crimea", expected: "UA-43", sanctioned: true},
+		{country: "Russia", state: "Republic of Crimea", city: "Simferopol", expected: "UA-43", sanctioned: true},
+		{country: "Russia", city: "Sevastopol", expected: "UA-40", sanctioned: true},
+		{country: "Crimea", expected: "UA-43", sanctioned: true},
+		{country: "Ukraine", state: "Donetsk People's Republic", expected: "UA-14", sanctioned: true},
+		{country: "Ukraine", city: "Luhansk", expected: "UA-09", sanctioned: true},
+		{country: "Ukraine", state: "Zaporozhye", expected: "UA-23", sanctioned: true},
+		{country: "Ukraine", state: "Kharkiv", expected: "UA-63"},
+
+		// Cities are only used for sanctioned regions
+		{country: "Ukraine", city: "Zaporizhzhia"},
+		{country: "Ukraine", city: "Kyiv"},
+		{country: "United States", city: "Washington"},
+
+		// Unknown country or state
+		{state: "California"},
+		{country: "United States", state: "Atlantis"},
+	}
+	for _, tc := range cases {
+		t.Run(tc.country+"/"+tc.state+"/"+tc.city, func(t *testing.T) {
+			sub, found := norm.LookupSubdivision(tc.country, tc.state, tc.city)
+			require.Equal(t, tc.expected != "", found)
+			require.Equal(t, tc.expected, sub.Code)
+			require.Equal(t, tc.sanctioned, sub.Sanctioned)
+		})
+	}
+}
+
+func TestIsSanctionedRegion(t *testing.T) {
+	require.True(t, norm.IsSanctionedRegion("UA-43"))
+	require.True(t, norm.IsSanctionedRegion("ua-09"))
+	require.False(t, norm.IsSanctionedRegion("UA-30"))
+	require.False(t, norm.IsSanctionedRegion(""))
+}
@@ -0,0 +1,307 @@
+package payments
+
+import (
+	"cmp"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/moov-io/watchman/pkg/search"
+)
+
+// ReadISO20022 parses a pacs.008 (FI to FI customer credit transfer) or pacs.009 (financial
+// institution credit transfer) document. Documents wrapped in an envelope with a business
+// application header are read as well.
+func ReadISO20022(r io.Reader) (*Message, error) {
+	d := xml.NewDecoder(r)
+	for {
+		token, err := d.Token()
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				return nil, fmt.Errorf("no pacs.008 or pacs.009 message found")
+			}
+			return nil, fmt.Errorf("reading ISO 20022 message: %w", err)
+		}
+
+		start, ok := token.(xml.StartElement)
+		if !ok {
+			continue
+		}
+		switch start.Name.Local {
+		case "FIToFICstmrCdtTrf":
+			var doc isoPacs008
+			if err := d.DecodeElement(&doc, &start); err != nil {
+				return nil, fmt.Errorf("reading pacs.008 message: %w", err)
+			}
+			return doc.message(), nil
+
+		case "FICdtTrf":
+			var doc isoPacs009
+			if err := d.DecodeElement(&doc, &start); err != nil {
+				return nil, fmt.Errorf("reading pacs.009 message: %w", err)
+			}
+			return doc.message(), nil
+
+		case "Document", "AppHdr", "Envelope", "BizMsgEnvlp", "RequestPayload", "DataPDU", "Body":
+			continue // look inside for the message
+
+		default:
+			if start.Name.Space != "" && strings.Contains(start.Name.Space, "iso:20022") && !strings.Contains(start.Name.Space, "head.001") {
+				return nil, fmt.Errorf("unsupported ISO 20022 message: %s", start.Name.Local)
+			}
+			if err := d.Skip(); err != nil {
+				return nil, fmt.Errorf("reading ISO 20022 message: %w", err)
+			}
+		}
+	}
+}
+
+type isoGroupHeader struct {
+	MessageID        string    `xml:"MsgId"`
+	InstructingAgent *isoAgent `xml:"InstgAgt"`
+	InstructedAgent  *isoAgent `xml:"InstdAgt"`
+}
+
+type isoPacs008 struct {
+	GroupHeader  isoGroupHeader        `xml:"GrpHdr"`
+	Transactions []isoCustomerTransfer `xml:"CdtTrfTxInf"`
+}
+
+func (doc isoPacs008) message() *Message {
+	msg := &Message{
+		Format:    "pacs.008",
+		Reference: doc.GroupHeader.MessageID,
+	}
+	msg.addAgent(RoleInstructingAgent, doc.GroupHeader.InstructingAgent)
+	msg.addAgent(RoleInstructedAgent, doc.GroupHeader.InstructedAgent)
+
+	for _, tx := range doc.Transactions {
+		msg.addAgent(RoleInstructingAgent, tx.InstructingAgent)
+		msg.addAgent(RoleInstructedAgent, tx.InstructedAgent)
+		tx.addParties(msg)
+	}
+	return msg
+}
+
+type isoPacs009 struct {
+	GroupHeader  isoGroupHeader  `xml:"GrpHdr"`
+	Transactions []isoFITransfer `xml:"CdtTrfTxInf"`
+}
+
+func (doc isoPacs009) message() *Message {
+	msg := &Message{
+		Format:    "pacs.009",
+		Reference: doc.GroupHeader.MessageID,
+	}
+	msg.addAgent(RoleInstructingAgent, doc.GroupHeader.InstructingAgent)
+	msg.addAgent(RoleInstructedAgent, doc.GroupHeader.InstructedAgent)
+
+	for _, tx := range doc.Transactions {
+		msg.addAgent(RoleInstructingAgent, tx.InstructingAgent)
+		msg.addAgent(RoleInstructedAgent, tx.InstructedAgent)
+		msg.addAgent(RoleIntermediaryAgent, tx.IntermediaryAgent1)
+		msg.addAgent(RoleIntermediaryAgent, tx.IntermediaryAgent2)
+		msg.addAgent(RoleIntermediaryAgent, tx.IntermediaryAgent3)
+		msg.addAgent(RoleDebtor, tx.Debtor)
+		msg.addAgent(RoleDebtorAgent, tx.DebtorAgent)
+		msg.addAgent(RoleCreditorAgent, tx.CreditorAgent)
+		msg.addAgent(RoleCreditor, tx.Creditor)
+		msg.addRemittance(tx.RemittanceInformation)
+
+		// Cover payments include the customer transfer they settle
+		if tx.UnderlyingCustomerTransfer != nil {
+			tx.UnderlyingCustomerTransfer.addParties(msg)
+		}
+	}
+	return msg
+}
+
+// isoCustomerTransfer is a pacs.008 transaction or the underlying customer transfer of a pacs.009 cover payment.
+type isoCustomerTransfer struct {
+	InstructingAgent *isoAgent `xml:"InstgAgt"`
+	InstructedAgent  *isoAgent `xml:"InstdAgt"`
+
+	IntermediaryAgent1 *isoAgent `xml:"IntrmyAgt1"`
+	IntermediaryAgent2 *isoAgent `xml:"IntrmyAgt2"`
+	IntermediaryAgent3 *isoAgent `xml:"IntrmyAgt3"`
+
+	UltimateDebtor *isoParty   `xml:"UltmtDbtr"`
+	Debtor         *isoParty   `xml:"Dbtr"`
+	DebtorAccount  *isoAccount `xml:"DbtrAcct"`
+	DebtorAgent    *isoAgent   `xml:"DbtrAgt"`
+
+	CreditorAgent    *isoAgent   `xml:"CdtrAgt"`
+	Creditor         *isoParty   `xml:"Cdtr"`
+	CreditorAccount  *isoAccount `xml:"CdtrAcct"`
+	UltimateCreditor *isoParty   `xml:"UltmtCdtr"`
+
+	RemittanceInformation *isoRemittance `xml:"RmtInf"`
+}
+
+func (tx isoCustomerTransfer) addParties(msg *Message) {
+	msg.addAgent(RoleIntermediaryAgent, tx.IntermediaryAgent1)
+	msg.addAgent(RoleIntermediaryAgent, tx.IntermediaryAgent2)
+	msg.addAgent(RoleIntermediaryAgent, tx.IntermediaryAgent3)
+
+	msg.addParty(RoleUltimateDebtor, tx.UltimateDebtor, nil)
+	msg.addParty(RoleDebtor, tx.Debtor, tx.DebtorAccount)
+	msg.addAgent(RoleDebtorAgent, tx.DebtorAgent)
+
+	msg.addAgent(RoleCreditorAgent, tx.CreditorAgent)
+	msg.addParty(RoleCreditor, tx.Creditor, tx.CreditorAccount)
+	msg.addParty(RoleUltimateCreditor, tx.UltimateCreditor, nil)
+
+	msg.addRemittance(tx.RemittanceInformation)
+}
+
+// isoFITransfer is a pacs.009 transaction where the debtor and creditor are financial institutions.
+type isoFITransfer struct {
+	InstructingAgent *isoAgent `xml:"InstgAgt"`
+	InstructedAgent  *isoAgent `xml:"InstdAgt"`
+
+	IntermediaryAgent1 *isoAgent `xml:"IntrmyAgt1"`
+	IntermediaryAgent2 *isoAgent `xml:"IntrmyAgt2"`
+	IntermediaryAgent3 *isoAgent `xml:"IntrmyAgt3"`
+
+	Debtor        *isoAgent `xml:"Dbtr"`
+	DebtorAgent   *isoAgent `xml:"DbtrAgt"`
+	CreditorAgent *isoAgent `xml:"CdtrAgt"`
+	Creditor      *isoAgent `xml:"Cdtr"`
+
+	RemittanceInformation *isoRemittance `xml:"RmtInf"`
+
+	UnderlyingCustomerTransfer *isoCustomerTransfer `xml:"UndrlygCstmrCdtTrf"`
+}
+
+// isoParty is a PartyIdentification
+type isoParty struct {
+	Name               string            `xml:"Nm"`
+	PostalAddress      *isoPostalAddress `xml:"PstlAdr"`
+	CountryOfResidence string            `xml:"CtryOfRes"`
+	ID                 struct {
+		Organization struct {
+			AnyBIC string `xml:"AnyBIC"`
+		} `xml:"OrgId"`
+	} `xml:"Id"`
+}
+
+// isoAgent is a BranchAndFinancialInstitutionIdentification
+type isoAgent struct {
+	FinancialInstitution struct {
+		BICFI         string            `xml:"BICFI"`
+		BIC           string            `xml:"BIC"` // before pacs.008.001.07
+		Name          string            `xml:"Nm"`
+		PostalAddress *isoPostalAddress `xml:"PstlAdr"`
+	} `xml:"FinInstnId"`
+}
+
+type isoAccount struct {
+	ID struct {
+		IBAN  string `xml:"IBAN"`
+		Other struct {
+			ID string `xml:"Id"`
+		} `xml:"Othr"`
+	} `xml:"Id"`
+}
+
+type isoRemittance struct {
+	Unstructured []string `xml:"Ustrd"`
+}
+
+type isoPostalAddress struct {
+	StreetName         string   `xml:"StrtNm"`
+	BuildingNumber     string   `xml:"BldgNb"`
+	BuildingName       string   `xml:"BldgNm"`
+	Floor              string   `xml:"Flr"`
+	PostCode           string   `xml:"PstCd"`
+	TownName           string   `xml:"TwnNm"`
+	CountrySubDivision string   `xml:"CtrySubDvsn"`
+	Country            string   `xml:"Ctry"`
+	AddressLines       []string `xml:"AdrLine"`
+}
+
+func (a *isoPostalAddress) address() *search.Address {
+	if a == nil {
+		return nil
+	}
+
+	addr := search.Address{
+		Line1:      joinFields(a.BuildingNumber, a.StreetName),
+		Line2:      joinFields(a.BuildingName, a.Floor),
+		City:       strings.TrimSpace(a.TownName),
+		PostalCode: strings.TrimSpace(a.PostCode),
+		State:      strings.TrimSpace(a.CountrySubDivision),
+		Country:    strings.ToUpper(strings.TrimSpace(a.Country)),
+	}
+
+	// Unstructured addresses only have lines, where the last line is usually the town and country
+	if len(a.AddressLines) > 0 && addr.Line1 == "" && addr.City == "" {
+		addr = mergeAddress(addr, addressFromLines(a.AddressLines))
+	}
+
+	if addr == (search.Address{}) {
+		return nil
+	}
+	return &addr
+}
+
+func (msg *Message) addParty(role string, party *isoParty, account *isoAccount) {
+	if party == nil {
+		return
+	}
+
+	p := Party{
+		Role:    role,
+		Name:    strings.TrimSpace(party.Name),
+		BIC:     cleanBIC(party.ID.Organization.AnyBIC),
+		Account: account.number(),
+		Address: party.PostalAddress.address(),
+	}
+	if p.Address != nil {
+		p.Country = p.Address.Country
+	}
+	p.Country = cmp.Or(p.Country, strings.ToUpper(strings.TrimSpace(party.CountryOfResidence)))
+
+	msg.add(p)
+}
+
+func (msg *Message) addAgent(role string, agent *isoAgent) {
+	if agent == nil {
+		return
+	}
+	fi := agent.FinancialInstitution
+
+	p := Party{
+		Role:                 role,
+		Name:                 strings.TrimSpace(fi.Name),
+		BIC:                  cleanBIC(cmp.Or(fi.BICFI, fi.BIC)),
+		Address:              fi.PostalAddress.address(),
+		FinancialInstitution: true,
+	}
+	if p.Address != nil {
+		p.Country = p.Address.Country
+	}
+	p.Country = cmp.Or(p.Country, bicCountry(p.BIC))
+
+	msg.add(p)
+}
+
+func (msg *Message) addRemittance(info *isoRemittance) {
+	if info == nil {
+		return
+	}
+	for _, line := range info.Unstructured {
+		if line = strings.TrimSpace(line); line != "" {
+			msg.RemittanceInformation = append(msg.RemittanceInformation, line)
+		}
+	}
+}
+
+func (a *isoAccount) number() string {
+	if a == nil {
+		return ""
+	}
+	return strings.TrimSpace(cmp.Or(a.ID.IBAN, a.ID.Other.ID))
+}
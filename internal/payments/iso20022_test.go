@@ -0,0 +1,105 @@
+package payments
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/moov-io/watchman/pkg/search"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestReadISO20022_Pacs008(t *testing.T) {
+	msg := readTestMessage(t, "pacs008.xml")
+	require.Equal(t, "pacs.008", msg.Format)
+	require.Equal(t, "MSG-2024-0001", msg.Reference)
+	require.Len(t, msg.Parties, 8)
+
+	debtor := findParty(t, msg, RoleDebtor)
+	require.Equal(t, "Acme Trading Corp", debtor.Name)
+	require.Equal(t, "123456789", debtor.Account)
+	require.Equal(t, &search.Address{
+		Line1:      "500 Market Street",
+		City:       "San Francisco",
+		PostalCode: "94105",
+		State:      "CA",
+		Country:    "US",
+	}, debtor.Address)
+
+	require.Equal(t, "GB", findParty(t, msg, RoleUltimateDebtor).Country)
+
+	intermediary := findParty(t, msg, RoleIntermediaryAgent)
+	require.Equal(t, "KDBKKPPY", intermediary.BIC)
+	require.Equal(t, "KP", intermediary.Country)
+	require.True(t, intermediary.FinancialInstitution)
+
+	// Unstructured address lines
+	creditor := findParty(t, msg, RoleCreditor)
+	require.Equal(t, "DE89370400440532013000", creditor.Account)
+	require.Equal(t, &search.Address{Line1: "12 Ferdowsi Avenue", City: "Tehran", Country: "IR"}, creditor.Address)
+
+	require.Equal(t, []string{"Invoice 4711 industrial pumps"}, msg.RemittanceInformation)
+}
+
+func TestReadISO20022_Pacs009(t *testing.T) {
+	msg := readTestMessage(t, "pacs009.xml")
+	require.Equal(t, "pacs.009", msg.Format)
+	require.Equal(t, "COV-2024-0042", msg.Reference)
+
+	var debtors, creditors []Party
+	for _, p := range msg.Parties {
+		switch p.Role {
+		case RoleDebtor:
+			debtors = append(debtors, p)
+		case RoleCreditor:
+			creditors = append(creditors, p)
+		}
+	}
+
+	// The institutions and the customers of the underlying transfer
+	require.Len(t, debtors, 2)
+	require.True(t, debtors[0].FinancialInstitution)
+	require.Equal(t, "Jane Doe", debtors[1].Name)
+
+	require.Len(t, creditors, 2)
+	require.Equal(t, "FTBDKPPY", creditors[0].BIC)
+	require.Equal(t, "Korea Mining Development Trading Corporation", creditors[1].Name)
+	require.Equal(t, "KP", creditors[1].Country)
+}
+
+func TestReadISO20022_Errors(t *testing.T) {
+	_, err := Read(strings.NewReader(`<Document xmlns="urn:iso:std:iso:20022:tech:xsd:pain.001.001.09"><CstmrCdtTrfInitn/></Document>`))
+	require.ErrorContains(t, err, "unsupported ISO 20022 message: CstmrCdtTrfInitn")
+
+	_, err = Read(strings.NewReader(`<Document></Document>`))
+	require.ErrorContains(t, err, "no pacs.008 or pacs.009 message found")
+
+	_, err = Read(strings.NewReader(`<Document><FIToFICstmrCdtTrf><GrpHdr>`))
+	require.ErrorContains(t, err, "reading pacs.008 message")
+}
+
+func readTestMessage(t *testing.T, name string) *Message {
+	t.Helper()
+
+	fd, err := os.Open(filepath.Join("testdata", name))
+	require.NoError(t, err)
+	t.Cleanup(func() { fd.Close() })
+
+	msg, err := Read(fd)
+	require.NoError(t, err)
+	return msg
+}
+
+func findParty(t *testing.T, msg *Message, role string) Party {
+	t.Helper()
+
+	for _, p := range msg.Parties {
+		if p.Role == role {
+			return p
+		}
+	}
+	t.Fatalf("no %s party found", role)
+	return Party{}
+}
@@ -0,0 +1,234 @@
+package payments
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/moov-io/watchman/internal/norm"
+	"github.com/moov-io/watchman/pkg/search"
+)
+
+// Message is a payment message with every party involved in the transfer.
+type Message struct {
+	// Format is the message type: pacs.008, pacs.009, MT103, MT202 or MT202COV
+	Format string
+
+	// Reference is the message or transaction reference (MsgId or field 20)
+	Reference string
+
+	Parties []Party
+
+	// RemittanceInformation is unstructured text sent along with the payment
+	RemittanceInformation []string
+}
+
+// Roles of parties in a payment, named after their ISO 20022 elements.
+const (
+	RoleDebtor                 = "debtor"
+	RoleUltimateDebtor         = "ultimateDebtor"
+	RoleDebtorAgent            = "debtorAgent"
+	RoleCreditor               = "creditor"
+	RoleUltimateCreditor       = "ultimateCreditor"
+	RoleCreditorAgent          = "creditorAgent"
+	RoleIntermediaryAgent      = "intermediaryAgent"
+	RoleInstructingAgent       = "instructingAgent"
+	RoleInstructedAgent        = "instructedAgent"
+	RoleSendersCorrespondent   = "sendersCorrespondent"
+	RoleReceiversCorrespondent = "receiversCorrespondent"
+)
+
+// Party is a person, business or financial institution in a payment.
+type Party struct {
+	Role string
+
+	Name string
+
+	// BIC is the SWIFT Business Identifier Code of a financial institution
+	BIC string
+
+	// Account is the IBAN or other account number
+	Account string
+
+	Address *search.Address
+
+	// Country is the ISO 3166 alpha-2 code of the party's address or residence
+	Country string
+
+	// FinancialInstitution is true for agents and institutions identified by their BIC
+	FinancialInstitution bool
+}
+
+// Entity returns the search query for a party. Financial institutions are searched as
+// businesses with their BIC while customers are searched by name without a type.
+func (p Party) Entity() search.Entity[search.Value] {
+	entity := search.Entity[search.Value]{
+		Name:   p.Name,
+		Source: search.SourceAPIRequest,
+	}
+	if p.FinancialInstitution {
+		entity.Type = search.EntityBusiness
+		entity.Business = &search.Business{
+			Name:          p.Name,
+			GovernmentIDs: bicIdentifiers(p.BIC),
+		}
+	}
+	if p.Address != nil {
+		entity.Addresses = []search.Address{*p.Address}
+	} else if p.Country != "" {
+		entity.Addresses = []search.Address{{Country: p.Country}}
+	}
+	return entity.Normalize()
+}
+
+// bicIdentifiers returns the BIC and its 8 character form, which sanctions lists use
+// for every branch of an institution.
+func bicIdentifiers(bic string) []search.GovernmentID {
+	if bic == "" {
+		return nil
+	}
+	out := []search.GovernmentID{{Type: search.GovernmentIDSWIFT, Identifier: bic}}
+	if len(bic) == 11 {
+		out = append(out, search.GovernmentID{Type: search.GovernmentIDSWIFT, Identifier: bic[:8]})
+	}
+	return out
+}
+
+// Read parses an ISO 20022 pacs.008 or pacs.009 XML document or a SWIFT MT103 or MT202 message.
+func Read(r io.Reader) (*Message, error) {
+	br := bufio.NewReader(r)
+
+	// Skip a byte order mark and whitespace to find the first character
+	for {
+		ch, _, err := br.ReadRune()
+		if err != nil {
+			if err == io.EOF {
+				return nil, fmt.Errorf("empty payment message")
+			}
+			return nil, fmt.Errorf("reading payment message: %w", err)
+		}
+		if ch == '\uFEFF' || strings.ContainsRune(" \t\r\n", ch) {
+			continue
+		}
+		if err := br.UnreadRune(); err != nil {
+			return nil, fmt.Errorf("reading payment message: %w", err)
+		}
+		if ch == '<' {
+			return ReadISO20022(br)
+		}
+		return ReadMT(br)
+	}
+}
+
+// bicCountry returns the country code of a BIC, which is its fifth and sixth characters.
+func bicCountry(bic string) string {
+	if len(bic) != 8 && len(bic) != 11 {
+		return ""
+	}
+	return norm.CountryCode(bic[4:6])
+}
+
+func cleanBIC(bic string) string {
+	return strings.ToUpper(strings.TrimSpace(bic))
+}
+
+func (msg *Message) add(p Party) {
+	msg.Parties = appendParty(msg.Parties, p)
+}
+
+// appendParty adds a party unless there's an identical one already, which happens
+// when a batch of transactions shares agents.
+func appendParty(parties []Party, p Party) []Party {
+	if p.Name == "" && p.BIC == "" && p.Account == "" && p.Address == nil {
+		return parties
+	}
+	for _, existing := range parties {
+		if existing.Role == p.Role && existing.Name == p.Name && existing.BIC == p.BIC &&
+			existing.Account == p.Account && sameAddress(existing.Address, p.Address) {
+			return parties
+		}
+	}
+	return append(parties, p)
+}
+
+func sameAddress(a, b *search.Address) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return *a == *b
+}
+
+func joinFields(fields ...string) string {
+	var parts []string
+	for _, f := range fields {
+		if f = strings.TrimSpace(f); f != "" {
+			parts = append(parts, f)
+		}
+	}
+	return strings.Join(parts, " ")
+}
+
+// mergeAddress fills the empty fields of addr from other.
+func mergeAddress(addr, other search.Address) search.Address {
+	if addr.Line1 == "" {
+		addr.Line1 = other.Line1
+	}
+	if addr.Line2 == "" {
+		addr.Line2 = other.Line2
+	}
+	if addr.City == "" {
+		addr.City = other.City
+	}
+	if addr.Country == "" {
+		addr.Country = other.Country
+	}
+	return addr
+}
+
+// addressFromLines reads free-form address lines where the last line holds the town
+// and, optionally, the country such as "TEHRAN IR" or "HAVANA, CUBA".
+func addressFromLines(lines []string) search.Address {
+	var cleaned []string
+	for _, line := range lines {
+		if line = strings.TrimSpace(line); line != "" {
+			cleaned = append(cleaned, line)
+		}
+	}
+
+	var addr search.Address
+	switch len(cleaned) {
+	case 0:
+		return addr
+	case 1:
+		// A single line is a town and country, unless it looks like a street ("123 MAIN ST")
+		addr.City, addr.Country = splitTownCountry(cleaned[0])
+		if addr.Country == "" || strings.ContainsAny(addr.City, "0123456789") {
+			return search.Address{Line1: cleaned[0]}
+		}
+		return addr
+	}
+
+	addr.Line1 = cleaned[0]
+	if len(cleaned) > 2 {
+		addr.Line2 = strings.Join(cleaned[1:len(cleaned)-1], " ")
+	}
+	addr.City, addr.Country = splitTownCountry(cleaned[len(cleaned)-1])
+	return addr
+}
+
+// splitTownCountry separates a trailing country name or ISO 3166 code from a town.
+func splitTownCountry(line string) (string, string) {
+	words := strings.FieldsFunc(line, func(r rune) bool {
+		return r == ' ' || r == ','
+	})
+
+	// Prefer the longest country name, like "NORTH KOREA" over "KOREA"
+	for n := min(3, len(words)); n > 0; n-- {
+		candidate := strings.Join(words[len(words)-n:], " ")
+		if country := norm.CountryCode(candidate); country != "" {
+			return strings.Join(words[:len(words)-n], " "), country
+		}
+	}
+	return strings.Join(words, " "), ""
+}
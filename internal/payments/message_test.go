@@ -0,0 +1,53 @@
+package payments
+
+import (
+	"testing"
+
+	"github.com/moov-io/watchman/pkg/search"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParty_Entity(t *testing.T) {
+	bank := Party{
+		Role:                 RoleCreditorAgent,
+		Name:                 "Foreign Trade Bank",
+		BIC:                  "FTBDKPPYXXX",
+		Country:              "KP",
+		FinancialInstitution: true,
+	}
+	entity := bank.Entity()
+	require.Equal(t, search.EntityBusiness, entity.Type)
+	require.Equal(t, []search.GovernmentID{
+		{Type: search.GovernmentIDSWIFT, Identifier: "FTBDKPPYXXX"},
+		{Type: search.GovernmentIDSWIFT, Identifier: "FTBDKPPY"},
+	}, entity.Business.GovernmentIDs)
+	require.Equal(t, []search.Address{{Country: "KP"}}, entity.Addresses)
+	require.NotEmpty(t, entity.PreparedFields.Name)
+
+	customer := Party{
+		Role:    RoleDebtor,
+		Name:    "Jane Doe",
+		Address: &search.Address{City: "London", Country: "GB"},
+	}
+	entity = customer.Entity()
+	require.Empty(t, entity.Type)
+	require.Nil(t, entity.Business)
+	require.Equal(t, "London", entity.Addresses[0].City)
+}
+
+func TestAddressFromLines(t *testing.T) {
+	cases := []struct {
+		lines    []string
+		expected search.Address
+	}{
+		{[]string{"12 Ferdowsi Avenue", "Tehran IR"}, search.Address{Line1: "12 Ferdowsi Avenue", City: "Tehran", Country: "IR"}},
+		{[]string{"Kim Il Sung Square", "Central District", "Pyongyang, North Korea"}, search.Address{Line1: "Kim Il Sung Square", Line2: "Central District", City: "Pyongyang", Country: "KP"}},
+		{[]string{"Simferopol Crimea"}, search.Address{City: "Simferopol", Country: "UA"}},
+		{[]string{"123 Main St"}, search.Address{Line1: "123 Main St"}},
+		{[]string{"1 High Street", "London"}, search.Address{Line1: "1 High Street", City: "London"}},
+	}
+	for _, tc := range cases {
+		require.Equal(t, tc.expected, addressFromLines(tc.lines), tc.lines)
+	}
+}
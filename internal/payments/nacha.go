@@ -0,0 +1,360 @@
+package payments
+
+import (
+	"bufio"
+	"cmp"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/moov-io/watchman/pkg/search"
+)
+
+// Roles of parties in an ACH entry, named after their NACHA terms.
+const (
+	RoleOriginator           = "originator"
+	RoleReceiver             = "receiver"
+	RoleODFI                 = "odfi"
+	RoleRDFI                 = "rdfi"
+	RoleForeignCorrespondent = "foreignCorrespondentBank"
+)
+
+const achRecordLength = 94
+
+// ACHFile is a NACHA file with the parties of every entry.
+type ACHFile struct {
+	Entries []ACHEntry
+}
+
+// ACHEntry is an entry detail record and its addenda.
+type ACHEntry struct {
+	BatchNumber string
+	TraceNumber string
+
+	// SECCode is the Standard Entry Class of the batch, such as PPD, CCD or IAT
+	SECCode string
+
+	// Amount is in cents
+	Amount int64
+
+	Parties []Party
+}
+
+// ReadACH parses a NACHA file. Standard entries include the batch's company as the originator
+// and the entry's name as the receiver. IAT entries also include the originator and receiver
+// addresses, the ODFI and RDFI and any foreign correspondent banks from their addenda records.
+//
+// Only the fields holding parties are read and files aren't validated, so a small reader is used
+// rather than github.com/moov-io/ach, which isn't a dependency of Watchman. Field positions
+// follow the NACHA Operating Rules, the same as moov-io/ach.
+func ReadACH(r io.Reader) (*ACHFile, error) {
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 0, 64*1024), 10*1024*1024)
+
+	p := &achParser{file: &ACHFile{}}
+	var line int
+	for scanner.Scan() {
+		text := strings.TrimRight(scanner.Text(), "\r")
+
+		// Some files are written without line breaks
+		for len(text) > 0 {
+			record := text
+			if len(record) > achRecordLength {
+				record, text = text[:achRecordLength], text[achRecordLength:]
+			} else {
+				text = ""
+			}
+
+			line++
+			if err := p.read(record); err != nil {
+				return nil, fmt.Errorf("ACH record %d: %w", line, err)
+			}
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("reading ACH file: %w", err)
+	}
+	if !p.hasFileHeader {
+		return nil, fmt.Errorf("no ACH file header found")
+	}
+	p.finishEntry()
+
+	return p.file, nil
+}
+
+type achParser struct {
+	file *ACHFile
+
+	hasFileHeader   bool
+	immediateOrigin string
+	originName      string
+
+	batch *achBatch
+	entry *ACHEntry
+
+	// IAT addenda are read before the parties are assembled
+	iat *iatAddenda
+}
+
+type achBatch struct {
+	number  string
+	secCode string
+
+	companyName string
+	odfi        string
+
+	// destinationCountry is the receiving country of IAT batches
+	destinationCountry string
+}
+
+type iatAddenda struct {
+	receiverName    string
+	receiverAccount string
+	receiverAddress search.Address
+
+	originatorName    string
+	originatorAddress search.Address
+
+	odfi, rdfi     Party
+	correspondents []Party
+}
+
+func (p *achParser) read(record string) error {
+	if strings.TrimSpace(record) == "" || strings.Trim(record, "9") == "" {
+		return nil // blank and padding records
+	}
+	record = fmt.Sprintf("%-94s", record)
+
+	switch record[0] {
+	case '1':
+		p.hasFileHeader = true
+		p.immediateOrigin = strings.TrimSpace(achField(record, 14, 23))
+		p.originName = strings.TrimSpace(achField(record, 64, 86))
+
+	case '5':
+		p.finishEntry()
+		b := &achBatch{
+			secCode: strings.TrimSpace(achField(record, 51, 53)),
+			odfi:    strings.TrimSpace(achField(record, 80, 87)),
+			number:  strings.TrimLeft(strings.TrimSpace(achField(record, 88, 94)), "0"),
+		}
+		if b.secCode == "IAT" {
+			b.destinationCountry = strings.TrimSpace(achField(record, 39, 40))
+		} else {
+			b.companyName = strings.TrimSpace(achField(record, 5, 20))
+		}
+		p.batch = b
+
+	case '6':
+		if p.batch == nil {
+			return fmt.Errorf("entry detail record outside of a batch")
+		}
+		p.finishEntry()
+		p.readEntry(record)
+
+	case '7':
+		if p.entry == nil {
+			return fmt.Errorf("addenda record without an entry detail record")
+		}
+		if p.iat != nil {
+			p.readIATAddenda(record)
+		}
+
+	case '8':
+		p.finishEntry()
+		p.batch = nil
+
+	case '9':
+		p.finishEntry()
+
+	default:
+		return fmt.Errorf("unknown record type %q", record[0])
+	}
+	return nil
+}
+
+func (p *achParser) readEntry(record string) {
+	entry := &ACHEntry{
+		BatchNumber: p.batch.number,
+		TraceNumber: strings.TrimSpace(achField(record, 80, 94)),
+		SECCode:     p.batch.secCode,
+		Amount:      achAmount(achField(record, 30, 39)),
+	}
+	p.entry = entry
+
+	rdfi := strings.TrimSpace(achField(record, 4, 11))
+
+	if p.batch.secCode == "IAT" {
+		p.iat = &iatAddenda{
+			receiverAccount: strings.TrimSpace(achField(record, 40, 74)),
+			rdfi: Party{
+				Role:                 RoleRDFI,
+				Account:              rdfi,
+				FinancialInstitution: true,
+			},
+		}
+		return
+	}
+
+	// The receiver's name is in a different position for a few entry classes
+	var name string
+	switch p.batch.secCode {
+	case "CIE":
+		name = achField(record, 40, 54)
+	case "MTE":
+		name = achField(record, 40, 61)
+	case "CTX":
+		name = achField(record, 59, 74)
+	default:
+		name = achField(record, 55, 76)
+	}
+
+	entry.Parties = appendParty(entry.Parties, Party{
+		Role: RoleOriginator,
+		Name: p.batch.companyName,
+	})
+	entry.Parties = appendParty(entry.Parties, p.odfi())
+	entry.Parties = appendParty(entry.Parties, Party{
+		Role:    RoleReceiver,
+		Name:    strings.TrimSpace(name),
+		Account: strings.TrimSpace(achField(record, 13, 29)),
+	})
+}
+
+// odfi returns the batch's originating institution, which is named in the file header
+// when it's also the immediate origin.
+func (p *achParser) odfi() Party {
+	party := Party{
+		Role:                 RoleODFI,
+		Account:              p.batch.odfi,
+		FinancialInstitution: true,
+	}
+	if p.batch.odfi != "" && strings.Contains(p.immediateOrigin, p.batch.odfi) {
+		party.Name = p.originName
+	}
+	return party
+}
+
+// readIATAddenda reads the mandatory IAT addenda (types 10 through 16) and foreign correspondent banks (type 18)
+func (p *achParser) readIATAddenda(record string) {
+	a := p.iat
+
+	switch achField(record, 2, 3) {
+	case "10":
+		a.receiverName = strings.TrimSpace(achField(record, 47, 81))
+	case "11":
+		a.originatorName = strings.TrimSpace(achField(record, 4, 38))
+		a.originatorAddress.Line1 = strings.TrimSpace(achField(record, 39, 73))
+	case "12":
+		a.originatorAddress.City, a.originatorAddress.State = iatPair(achField(record, 4, 38))
+		a.originatorAddress.Country, a.originatorAddress.PostalCode = iatPair(achField(record, 39, 73))
+	case "13":
+		a.odfi = iatInstitution(RoleODFI, record)
+	case "14":
+		a.rdfi = iatInstitution(RoleRDFI, record)
+	case "15":
+		a.receiverAddress.Line1 = strings.TrimSpace(achField(record, 19, 53))
+	case "16":
+		a.receiverAddress.City, a.receiverAddress.State = iatPair(achField(record, 4, 38))
+		a.receiverAddress.Country, a.receiverAddress.PostalCode = iatPair(achField(record, 39, 73))
+	case "18":
+		a.correspondents = append(a.correspondents, iatInstitution(RoleForeignCorrespondent, record))
+	}
+}
+
+// finishEntry adds the current entry to the file once all of its addenda are read
+func (p *achParser) finishEntry() {
+	if p.entry == nil {
+		return
+	}
+	entry := p.entry
+
+	if a := p.iat; a != nil {
+		originator := Party{
+			Role:    RoleOriginator,
+			Name:    cmp.Or(a.originatorName, p.batch.companyName),
+			Address: iatAddress(a.originatorAddress),
+		}
+		receiver := Party{
+			Role:    RoleReceiver,
+			Name:    a.receiverName,
+			Account: a.receiverAccount,
+			Address: iatAddress(a.receiverAddress),
+		}
+		if receiver.Address == nil && p.batch.destinationCountry != "" {
+			receiver.Country = strings.ToUpper(p.batch.destinationCountry)
+		}
+
+		if a.odfi.Role == "" {
+			a.odfi = p.odfi()
+		}
+		if a.rdfi.Country == "" && p.batch.destinationCountry != "" {
+			a.rdfi.Country = strings.ToUpper(p.batch.destinationCountry)
+		}
+
+		for _, party := range append([]Party{originator, a.odfi, a.rdfi, receiver}, a.correspondents...) {
+			if party.Address != nil {
+				party.Country = party.Address.Country
+			}
+			entry.Parties = appendParty(entry.Parties, party)
+		}
+	}
+
+	p.file.Entries = append(p.file.Entries, *entry)
+	p.entry, p.iat = nil, nil
+}
+
+// achField returns the characters of a record between the 1-based start and end positions
+func achField(record string, start, end int) string {
+	if start > len(record) {
+		return ""
+	}
+	return record[start-1 : min(end, len(record))]
+}
+
+func achAmount(s string) int64 {
+	var n int64
+	for _, ch := range strings.TrimSpace(s) {
+		if ch < '0' || ch > '9' {
+			return 0
+		}
+		n = n*10 + int64(ch-'0')
+	}
+	return n
+}
+
+// iatPair splits IAT fields formatted as "City*State\" or "Country*Postal Code\"
+func iatPair(s string) (string, string) {
+	s = strings.TrimSpace(s)
+	s = strings.TrimSuffix(s, `\`)
+	first, second, _ := strings.Cut(s, "*")
+	return strings.TrimSpace(first), strings.TrimSpace(strings.TrimSuffix(second, `\`))
+}
+
+func iatAddress(addr search.Address) *search.Address {
+	if addr == (search.Address{}) {
+		return nil
+	}
+	addr.Country = strings.ToUpper(addr.Country)
+	return &addr
+}
+
+// iatInstitution reads a financial institution addenda, which is identified by a national
+// clearing system number (01), BIC (02) or IBAN (03) along with its branch country.
+func iatInstitution(role, record string) Party {
+	party := Party{
+		Role:                 role,
+		Name:                 strings.TrimSpace(achField(record, 4, 38)),
+		Country:              strings.ToUpper(strings.TrimSpace(achField(record, 75, 77))),
+		FinancialInstitution: true,
+	}
+
+	id := strings.TrimSpace(achField(record, 41, 74))
+	if achField(record, 39, 40) == "02" {
+		party.BIC = cleanBIC(id)
+		party.Country = cmp.Or(party.Country, bicCountry(party.BIC))
+	} else {
+		party.Account = id
+	}
+	return party
+}
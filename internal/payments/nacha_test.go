@@ -0,0 +1,124 @@
+package payments
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/moov-io/watchman/pkg/search"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestReadACH(t *testing.T) {
+	fd, err := os.Open(filepath.Join("testdata", "ach.txt"))
+	require.NoError(t, err)
+	t.Cleanup(func() { fd.Close() })
+
+	file, err := ReadACH(fd)
+	require.NoError(t, err)
+	require.Len(t, file.Entries, 3)
+
+	t.Run("PPD", func(t *testing.T) {
+		entry := file.Entries[0]
+		require.Equal(t, "1", entry.BatchNumber)
+		require.Equal(t, "121042880000001", entry.TraceNumber)
+		require.Equal(t, "PPD", entry.SECCode)
+		require.Equal(t, int64(100000), entry.Amount)
+
+		require.Equal(t, []Party{
+			{Role: RoleOriginator, Name: "ACME PAYROLL"},
+			{Role: RoleODFI, Name: "EXAMPLE BANK", Account: "12104288", FinancialInstitution: true},
+			{Role: RoleReceiver, Name: "DANIEL MORENO", Account: "123456789"},
+		}, entry.Parties)
+	})
+
+	t.Run("IAT", func(t *testing.T) {
+		entry := file.Entries[2]
+		require.Equal(t, "2", entry.BatchNumber)
+		require.Equal(t, "121042880000003", entry.TraceNumber)
+		require.Equal(t, "IAT", entry.SECCode)
+		require.Len(t, entry.Parties, 5)
+
+		originator := entry.Parties[0]
+		require.Equal(t, RoleOriginator, originator.Role)
+		require.Equal(t, "ACME TRADING CORP", originator.Name)
+		require.Equal(t, &search.Address{
+			Line1:      "500 MARKET STREET",
+			City:       "SAN FRANCISCO",
+			State:      "CA",
+			PostalCode: "94105",
+			Country:    "US",
+		}, originator.Address)
+
+		odfi := entry.Parties[1]
+		require.Equal(t, RoleODFI, odfi.Role)
+		require.Equal(t, "121042882", odfi.Account)
+
+		rdfi := entry.Parties[2]
+		require.Equal(t, RoleRDFI, rdfi.Role)
+		require.Equal(t, "BANK MELLI IRAN", rdfi.Name)
+		require.Equal(t, "MELIIRTH", rdfi.BIC)
+		require.Equal(t, "IR", rdfi.Country)
+
+		receiver := entry.Parties[3]
+		require.Equal(t, "NASSER TRADING COMPANY", receiver.Name)
+		require.Equal(t, "IR820540102680020817909002", receiver.Account)
+		require.Equal(t, &search.Address{Line1: "12 FERDOWSI AVENUE", City: "TEHRAN", PostalCode: "11369", Country: "IR"}, receiver.Address)
+
+		correspondent := entry.Parties[4]
+		require.Equal(t, RoleForeignCorrespondent, correspondent.Role)
+		require.Equal(t, "KDBKKPPY", correspondent.BIC)
+		require.Equal(t, "KP", correspondent.Country)
+	})
+}
+
+func TestReadACH_WithoutLineBreaks(t *testing.T) {
+	bs, err := os.ReadFile(filepath.Join("testdata", "ach.txt"))
+	require.NoError(t, err)
+
+	file, err := ReadACH(strings.NewReader(strings.ReplaceAll(string(bs), "\n", "")))
+	require.NoError(t, err)
+	require.Len(t, file.Entries, 3)
+}
+
+func TestReadACH_Errors(t *testing.T) {
+	_, err := ReadACH(strings.NewReader(""))
+	require.ErrorContains(t, err, "no ACH file header found")
+
+	_, err = ReadACH(strings.NewReader("622231380104123456789        0000100000EMP001         DANIEL MORENO           0121042880000001"))
+	require.ErrorContains(t, err, "ACH record 1: entry detail record outside of a batch")
+
+	_, err = ReadACH(strings.NewReader("hello world"))
+	require.ErrorContains(t, err, `unknown record type 'h'`)
+}
+
+func TestReadACH_ReceiverNames(t *testing.T) {
+	header := "101 231380104 1210428822403011200A094101FEDERAL RESERVE BANK   EXAMPLE BANK                   \n"
+	batch := func(sec string) string {
+		return "5200ACME PAYROLL                        1234567890" + sec + "PAYROLL         240301   1121042880000001\n"
+	}
+
+	cases := map[string]string{
+		// Individual Name is in positions 40-61 of MTE entries
+		"MTE": "622231380104123456789        0000100000JANE CARDHOLDER SMITH ID0000000000001  0121042880000001",
+		// Individual Name is in positions 40-54 of CIE entries
+		"CIE": "622231380104123456789        0000100000JANE SMITH     BILLPAY                 0121042880000001",
+	}
+	expected := map[string]string{
+		"MTE": "JANE CARDHOLDER SMITH",
+		"CIE": "JANE SMITH",
+	}
+	for sec, entry := range cases {
+		t.Run(sec, func(t *testing.T) {
+			file, err := ReadACH(strings.NewReader(header + batch(sec) + entry))
+			require.NoError(t, err)
+			require.Len(t, file.Entries, 1)
+
+			receiver := file.Entries[0].Parties[2]
+			require.Equal(t, RoleReceiver, receiver.Role)
+			require.Equal(t, expected[sec], receiver.Name)
+		})
+	}
+}
@@ -0,0 +1,267 @@
+package payments
+
+import (
+	"fmt"
+	"io"
+	"regexp"
+	"strings"
+
+	"github.com/moov-io/watchman/pkg/search"
+)
+
+var (
+	mtFieldRegex       = regexp.MustCompile(`^:(\d{2}[A-Z]?):(.*)$`)
+	mtMessageTypeRegex = regexp.MustCompile(`^[IO](\d{3})`)
+	mtStructuredRegex  = regexp.MustCompile(`^([1-8])/(.*)$`)
+)
+
+// maxMTSize is larger than any MT message, which SWIFT limits to 10,000 characters
+const maxMTSize = 64 * 1024
+
+// ReadMT parses a SWIFT MT103 (single customer credit transfer) or MT202 (general financial
+// institution transfer) message, including MT202 COV. The basic and application header blocks
+// are optional, without them the message is read as a list of fields.
+func ReadMT(r io.Reader) (*Message, error) {
+	data, err := io.ReadAll(io.LimitReader(r, maxMTSize))
+	if err != nil {
+		return nil, fmt.Errorf("reading MT message: %w", err)
+	}
+	text := strings.ReplaceAll(string(data), "\r\n", "\n")
+
+	basic, application, user, text := mtBlocks(text)
+
+	fields := mtFields(text)
+	if len(fields) == 0 {
+		return nil, fmt.Errorf("no fields found in MT message")
+	}
+
+	messageType := ""
+	if m := mtMessageTypeRegex.FindStringSubmatch(application); m != nil {
+		messageType = m[1]
+	} else {
+		messageType = "103"
+		for _, f := range fields {
+			if strings.HasPrefix(f.tag, "58") {
+				messageType = "202"
+			}
+		}
+	}
+	if messageType != "103" && messageType != "202" {
+		return nil, fmt.Errorf("unsupported SWIFT MT%s message", messageType)
+	}
+
+	msg := &Message{
+		Format: "MT" + messageType,
+	}
+	if messageType == "202" && strings.Contains(user, "{119:COV}") {
+		msg.Format = "MT202COV"
+	}
+
+	// Input messages are sent by the logical terminal in the basic header, output messages
+	// were received by it and carry the sender in the application header.
+	sender, receiver := mtLogicalTerminalBIC(basic, 3), mtLogicalTerminalBIC(application, 4)
+	if strings.HasPrefix(application, "O") {
+		sender, receiver = mtLogicalTerminalBIC(application, 14), mtLogicalTerminalBIC(basic, 3)
+	}
+	msg.add(bicParty(RoleInstructingAgent, sender))
+	msg.add(bicParty(RoleInstructedAgent, receiver))
+
+	var cover bool
+	for _, f := range fields {
+		number, option := f.tag[:2], f.tag[2:]
+
+		switch number {
+		case "20":
+			if msg.Reference == "" {
+				msg.Reference = strings.TrimSpace(strings.Join(f.lines, ""))
+			}
+		case "70":
+			if info := joinFields(f.lines...); info != "" {
+				msg.RemittanceInformation = append(msg.RemittanceInformation, info)
+			}
+		case "50":
+			// Sequence B of an MT202 COV starts with the ordering customer
+			cover = true
+			msg.add(mtParty(RoleDebtor, option, f.lines, false))
+		case "52":
+			if messageType == "202" && !cover {
+				msg.add(mtParty(RoleDebtor, option, f.lines, true))
+			} else {
+				msg.add(mtParty(RoleDebtorAgent, option, f.lines, true))
+			}
+		case "53":
+			msg.add(mtParty(RoleSendersCorrespondent, option, f.lines, true))
+		case "54":
+			msg.add(mtParty(RoleReceiversCorrespondent, option, f.lines, true))
+		case "56":
+			msg.add(mtParty(RoleIntermediaryAgent, option, f.lines, true))
+		case "57":
+			msg.add(mtParty(RoleCreditorAgent, option, f.lines, true))
+		case "58":
+			msg.add(mtParty(RoleCreditor, option, f.lines, true))
+		case "59":
+			msg.add(mtParty(RoleCreditor, option, f.lines, false))
+		}
+	}
+	return msg, nil
+}
+
+type mtField struct {
+	tag   string
+	lines []string
+}
+
+// mtBlocks returns the basic header, application header and user header blocks along with
+// the text block. Text without blocks is returned as the text block.
+func mtBlocks(text string) (string, string, string, string) {
+	block := func(id string) string {
+		start := strings.Index(text, "{"+id+":")
+		if start < 0 {
+			return ""
+		}
+		// The user header nests blocks, so find its matching brace
+		depth := 0
+		for i := start; i < len(text); i++ {
+			switch text[i] {
+			case '{':
+				depth++
+			case '}':
+				depth--
+				if depth == 0 {
+					return text[start+len(id)+2 : i]
+				}
+			}
+		}
+		return text[start+len(id)+2:]
+	}
+	basic, application, user := block("1"), block("2"), block("3")
+
+	if start := strings.Index(text, "{4:"); start >= 0 {
+		text = text[start+3:]
+		if end := strings.Index(text, "\n-}"); end >= 0 {
+			text = text[:end]
+		}
+	}
+	return basic, application, user, text
+}
+
+func mtFields(text string) []mtField {
+	var out []mtField
+	for _, line := range strings.Split(text, "\n") {
+		line = strings.TrimRight(line, " \t")
+		if line == "-" || strings.HasPrefix(line, "-}") {
+			break
+		}
+		if m := mtFieldRegex.FindStringSubmatch(line); m != nil {
+			out = append(out, mtField{tag: m[1]})
+			line = m[2]
+		}
+		if len(out) > 0 && line != "" {
+			out[len(out)-1].lines = append(out[len(out)-1].lines, line)
+		}
+	}
+	return out
+}
+
+// mtLogicalTerminalBIC returns the BIC of the 12 character logical terminal address starting
+// at offset, which is the BIC8, a terminal code and the branch code.
+func mtLogicalTerminalBIC(header string, offset int) string {
+	if len(header) < offset+12 {
+		return ""
+	}
+	lt := header[offset : offset+12]
+	return cleanBIC(lt[:8] + lt[9:])
+}
+
+func bicParty(role, bic string) Party {
+	return Party{
+		Role:                 role,
+		BIC:                  bic,
+		Country:              bicCountry(bic),
+		FinancialInstitution: true,
+	}
+}
+
+// mtParty reads a party field in any of its options:
+//
+//	A: [/account] BIC
+//	B: [/account] [location]
+//	C: /account
+//	D, K and no option: [/account] name and address lines
+//	F: party identifier or /account, then numbered name (1/), address (2/) and country and town (3/) lines
+func mtParty(role, option string, lines []string, institution bool) Party {
+	p := Party{
+		Role:                 role,
+		FinancialInstitution: institution,
+	}
+
+	if len(lines) > 0 && strings.HasPrefix(lines[0], "/") {
+		p.Account = mtAccount(lines[0])
+		lines = lines[1:]
+	} else if option == "F" && len(lines) > 0 && !mtStructuredRegex.MatchString(lines[0]) {
+		lines = lines[1:] // party identifier, such as a national ID
+	}
+
+	switch option {
+	case "A":
+		if len(lines) > 0 {
+			p.BIC = cleanBIC(lines[0])
+		}
+		p.FinancialInstitution = true
+		p.Country = bicCountry(p.BIC)
+
+	case "B", "C":
+		// location and account only
+
+	case "F":
+		var names, addressLines []string
+		var addr search.Address
+		for _, line := range lines {
+			m := mtStructuredRegex.FindStringSubmatch(line)
+			if m == nil {
+				continue
+			}
+			switch m[1] {
+			case "1":
+				names = append(names, m[2])
+			case "2":
+				addressLines = append(addressLines, m[2])
+			case "3":
+				country, town, _ := strings.Cut(m[2], "/")
+				addr.Country = strings.ToUpper(strings.TrimSpace(country))
+				addr.City = joinFields(addr.City, town)
+			}
+		}
+		p.Name = joinFields(names...)
+		if len(addressLines) > 0 {
+			addr.Line1 = strings.TrimSpace(addressLines[0])
+			addr.Line2 = joinFields(addressLines[1:]...)
+		}
+		if addr != (search.Address{}) {
+			p.Address = &addr
+			p.Country = addr.Country
+		}
+
+	default:
+		if len(lines) > 0 {
+			p.Name = strings.TrimSpace(lines[0])
+		}
+		if len(lines) > 1 {
+			addr := addressFromLines(lines[1:])
+			if addr != (search.Address{}) {
+				p.Address = &addr
+				p.Country = addr.Country
+			}
+		}
+	}
+	return p
+}
+
+// mtAccount removes the leading slash and any debit or credit mark from an account line
+func mtAccount(line string) string {
+	line = strings.TrimPrefix(strings.TrimSpace(line), "/")
+	if len(line) > 2 && (line[0] == 'C' || line[0] == 'D') && line[1] == '/' {
+		line = line[2:]
+	}
+	return strings.TrimPrefix(line, "/")
+}
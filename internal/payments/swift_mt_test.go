@@ -0,0 +1,88 @@
+package payments
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/moov-io/watchman/pkg/search"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestReadMT_MT103(t *testing.T) {
+	msg := readTestMessage(t, "mt103.txt")
+	require.Equal(t, "MT103", msg.Format)
+	require.Equal(t, "REF-103-0001", msg.Reference)
+
+	require.Equal(t, "BANKUS33XXX", findParty(t, msg, RoleInstructingAgent).BIC)
+	require.Equal(t, "BANKDEFFXXX", findParty(t, msg, RoleInstructedAgent).BIC)
+
+	debtor := findParty(t, msg, RoleDebtor)
+	require.Equal(t, "ACME TRADING CORP", debtor.Name)
+	require.Equal(t, "123456789", debtor.Account)
+	require.Equal(t, &search.Address{Line1: "500 MARKET STREET", City: "SAN FRANCISCO", Country: "US"}, debtor.Address)
+	require.False(t, debtor.FinancialInstitution)
+
+	require.Equal(t, "KDBKKPPY", findParty(t, msg, RoleIntermediaryAgent).BIC)
+
+	creditor := findParty(t, msg, RoleCreditor)
+	require.Equal(t, "NASSER TRADING COMPANY", creditor.Name)
+	require.Equal(t, "IR", creditor.Country)
+
+	require.Equal(t, []string{"INVOICE 4711 INDUSTRIAL PUMPS"}, msg.RemittanceInformation)
+}
+
+func TestReadMT_MT202COV(t *testing.T) {
+	msg := readTestMessage(t, "mt202.txt")
+	require.Equal(t, "MT202COV", msg.Format)
+	require.Equal(t, "COV-202-0042", msg.Reference)
+
+	// Output messages carry the sender in the application header
+	require.Equal(t, "BANKGB2LXXX", findParty(t, msg, RoleInstructingAgent).BIC)
+	require.Equal(t, "BANKUS33XXX", findParty(t, msg, RoleInstructedAgent).BIC)
+
+	// Field 52A of sequence A is the ordering institution
+	debtor := findParty(t, msg, RoleDebtor)
+	require.Equal(t, "BANKGB2L", debtor.BIC)
+	require.True(t, debtor.FinancialInstitution)
+
+	creditor := findParty(t, msg, RoleCreditor)
+	require.Equal(t, "FTBDKPPY", creditor.BIC)
+	require.Equal(t, "987654", creditor.Account)
+
+	// Structured customers of sequence B
+	var customers []Party
+	for _, p := range msg.Parties {
+		if !p.FinancialInstitution {
+			customers = append(customers, p)
+		}
+	}
+	require.Len(t, customers, 2)
+	require.Equal(t, "JANE DOE", customers[0].Name)
+	require.Equal(t, "GB29NWBK60161331926819", customers[0].Account)
+	require.Equal(t, &search.Address{Line1: "1 HIGH STREET", City: "LONDON", Country: "GB"}, customers[0].Address)
+	require.Equal(t, "KOREA MINING DEVELOPMENT TRADING CORPORATION", customers[1].Name)
+	require.Equal(t, "KP", customers[1].Country)
+}
+
+func TestReadMT_FieldsOnly(t *testing.T) {
+	msg, err := Read(strings.NewReader(":20:ABC\n:52D:/D/1234\nEXAMPLE BANK\nHAVANA, CUBA\n:58A:FTBDKPPY\n"))
+	require.NoError(t, err)
+	require.Equal(t, "MT202", msg.Format)
+
+	debtor := findParty(t, msg, RoleDebtor)
+	require.Equal(t, "EXAMPLE BANK", debtor.Name)
+	require.Equal(t, "1234", debtor.Account)
+	require.Equal(t, "CU", debtor.Country)
+}
+
+func TestReadMT_Errors(t *testing.T) {
+	_, err := Read(strings.NewReader("{1:F01BANKUS33AXXX0000000000}{2:I940BANKDEFFXXXXN}{4:\n:20:ABC\n-}"))
+	require.ErrorContains(t, err, "unsupported SWIFT MT940 message")
+
+	_, err = Read(strings.NewReader("hello world"))
+	require.ErrorContains(t, err, "no fields found")
+
+	_, err = Read(strings.NewReader("  \n"))
+	require.ErrorContains(t, err, "empty payment message")
+}
@@ -0,0 +1,280 @@
+// Copyright The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package prepare
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"strings"
+	"sync"
+	"sync/atomic"
+
+	"gopkg.in/yaml.v3"
+)
+
+// AnalysisConfig holds operator defined dictionaries which are applied to the names and addresses
+// of indexed entities and search queries.
+type AnalysisConfig struct {
+	// File is an optional path to a YAML file with Stopwords, Synonyms and Abbreviations laid out
+	// as they are in this config. The file is read again on every data refresh and changes apply
+	// along with the refreshed entities, without a restart. Its dictionaries are merged with those
+	// set in the config.
+	File string `yaml:"File"`
+
+	Stopwords StopwordsConfig `yaml:"Stopwords"`
+
+	// Synonyms are groups of equivalent terms, e.g. ["international", "intl"]. Every term is
+	// replaced by the first term of its group.
+	Synonyms [][]string `yaml:"Synonyms"`
+
+	// Abbreviations are expanded into their full form, e.g. "mfg": "manufacturing".
+	// The full form may be several words.
+	Abbreviations map[string]string `yaml:"Abbreviations"`
+}
+
+// StopwordsConfig changes the built-in stopword lists. Each map is keyed by ISO 639-1 language
+// code (e.g. "en") and the "all" key applies to every language.
+type StopwordsConfig struct {
+	Add    map[string][]string `yaml:"Add"`
+	Remove map[string][]string `yaml:"Remove"`
+}
+
+const allLanguages = "all"
+
+// analysis is the compiled form of an AnalysisConfig
+type analysis struct {
+	addStopwords    map[string]map[string]bool
+	removeStopwords map[string]map[string]bool
+
+	// replacements maps synonyms and abbreviations onto the terms replacing them
+	replacements map[string]string
+}
+
+var (
+	currentAnalysis atomic.Pointer[analysis]
+
+	analysisMu       sync.Mutex
+	analysisConfig   AnalysisConfig
+	analysisFileData []byte
+)
+
+// ConfigureAnalysis sets the dictionaries applied by ExpandTerms and RemoveStopwords.
+func ConfigureAnalysis(conf AnalysisConfig) error {
+	analysisMu.Lock()
+	defer analysisMu.Unlock()
+
+	analysisConfig = conf
+	analysisFileData = nil
+
+	pending, err := loadAnalysis(true)
+	if err != nil {
+		return err
+	}
+	pending.apply()
+	return nil
+}
+
+// Analysis is a set of dictionaries read from the analysis file which aren't used yet.
+type Analysis struct {
+	compiled *analysis
+	fileData []byte
+}
+
+// LoadAnalysis reads the configured analysis file again. Nil is returned when there's no file or
+// its dictionaries haven't changed. The dictionaries are only used once Apply is called.
+func LoadAnalysis() (*Analysis, error) {
+	analysisMu.Lock()
+	defer analysisMu.Unlock()
+
+	return loadAnalysis(false)
+}
+
+// Apply makes the dictionaries used by ExpandTerms and RemoveStopwords. Entities normalized
+// before then need to be normalized again to use them.
+func (a *Analysis) Apply() {
+	if a == nil {
+		return
+	}
+	analysisMu.Lock()
+	defer analysisMu.Unlock()
+
+	a.apply()
+}
+
+func (a *Analysis) apply() {
+	currentAnalysis.Store(a.compiled)
+	analysisFileData = a.fileData
+}
+
+// ExpandTerms is ExpandTerms with these dictionaries, or the ones in use when a is nil.
+func (a *Analysis) ExpandTerms(input string) string {
+	return expandTerms(a.dictionaries(), input)
+}
+
+// RemoveStopwords is RemoveStopwords with these dictionaries, or the ones in use when a is nil.
+func (a *Analysis) RemoveStopwords(input string) string {
+	return removeAllStopwords(a.dictionaries(), input)
+}
+
+func (a *Analysis) dictionaries() *analysis {
+	if a == nil {
+		return currentAnalysis.Load()
+	}
+	return a.compiled
+}
+
+func loadAnalysis(force bool) (*Analysis, error) {
+	conf := analysisConfig
+	if conf.File == "" && !force {
+		return nil, nil
+	}
+
+	var data []byte
+	if conf.File != "" {
+		var err error
+		data, err = os.ReadFile(conf.File)
+		if err != nil {
+			return nil, fmt.Errorf("reading analysis file: %w", err)
+		}
+		if !force && bytes.Equal(data, analysisFileData) {
+			return nil, nil
+		}
+
+		var fromFile AnalysisConfig
+		if err := yaml.Unmarshal(data, &fromFile); err != nil {
+			return nil, fmt.Errorf("parsing analysis file %s: %w", conf.File, err)
+		}
+		conf = mergeAnalysisConfigs(conf, fromFile)
+	}
+
+	compiled, err := compileAnalysis(conf)
+	if err != nil {
+		return nil, err
+	}
+	return &Analysis{compiled: compiled, fileData: data}, nil
+}
+
+func mergeAnalysisConfigs(a, b AnalysisConfig) AnalysisConfig {
+	out := AnalysisConfig{
+		File: a.File,
+		Stopwords: StopwordsConfig{
+			Add:    make(map[string][]string),
+			Remove: make(map[string][]string),
+		},
+		Abbreviations: make(map[string]string),
+	}
+	for _, conf := range []AnalysisConfig{a, b} {
+		for lang, words := range conf.Stopwords.Add {
+			out.Stopwords.Add[lang] = append(out.Stopwords.Add[lang], words...)
+		}
+		for lang, words := range conf.Stopwords.Remove {
+			out.Stopwords.Remove[lang] = append(out.Stopwords.Remove[lang], words...)
+		}
+		out.Synonyms = append(out.Synonyms, conf.Synonyms...)
+		for abbr, full := range conf.Abbreviations {
+			out.Abbreviations[abbr] = full
+		}
+	}
+	return out
+}
+
+func compileAnalysis(conf AnalysisConfig) (*analysis, error) {
+	out := &analysis{
+		addStopwords:    compileStopwords(conf.Stopwords.Add),
+		removeStopwords: compileStopwords(conf.Stopwords.Remove),
+		replacements:    make(map[string]string),
+	}
+
+	for _, group := range conf.Synonyms {
+		var terms []string
+		for _, term := range group {
+			if term = LowerAndRemovePunctuation(term); term != "" {
+				terms = append(terms, term)
+			}
+		}
+		if len(terms) < 2 {
+			return nil, fmt.Errorf("synonym group %v needs at least two terms", group)
+		}
+		for _, term := range terms[1:] {
+			if strings.Contains(term, " ") {
+				return nil, fmt.Errorf("synonym %q must be a single word", term)
+			}
+			out.replacements[term] = terms[0]
+		}
+	}
+
+	for abbr, full := range conf.Abbreviations {
+		abbr, full = LowerAndRemovePunctuation(abbr), LowerAndRemovePunctuation(full)
+		if abbr == "" || full == "" || strings.Contains(abbr, " ") {
+			return nil, fmt.Errorf("invalid abbreviation %q for %q", abbr, full)
+		}
+		out.replacements[abbr] = full
+	}
+
+	return out, nil
+}
+
+func compileStopwords(words map[string][]string) map[string]map[string]bool {
+	out := make(map[string]map[string]bool)
+	for lang, list := range words {
+		lang = strings.ToLower(strings.TrimSpace(lang))
+		if out[lang] == nil {
+			out[lang] = make(map[string]bool)
+		}
+		for _, word := range list {
+			out[lang][strings.ToLower(strings.TrimSpace(word))] = true
+		}
+	}
+	return out
+}
+
+// ExpandTerms replaces configured synonyms and abbreviations in lowercased text. A trailing period
+// is ignored when looking up a word (e.g. "st.").
+func ExpandTerms(input string) string {
+	return expandTerms(currentAnalysis.Load(), input)
+}
+
+func expandTerms(a *analysis, input string) string {
+	if a == nil || len(a.replacements) == 0 || input == "" {
+		return input
+	}
+
+	words := strings.Fields(input)
+	changed := false
+	for idx := range words {
+		if replacement, found := a.replacements[strings.TrimSuffix(words[idx], ".")]; found {
+			words[idx] = replacement
+			changed = true
+		}
+	}
+	if !changed {
+		return input
+	}
+	return strings.Join(words, " ")
+}
+
+// addedStopword returns true when word was configured as a stopword for the language or all languages.
+func (a *analysis) addedStopword(word, lang string) bool {
+	return a != nil && (a.addStopwords[lang][word] || a.addStopwords[allLanguages][word])
+}
+
+// removedStopword returns true when word was configured to no longer be a stopword.
+func (a *analysis) removedStopword(word, lang string) bool {
+	return a != nil && (a.removeStopwords[lang][word] || a.removeStopwords[allLanguages][word])
+}
+
+// anyAddedStopword returns true when word was added as a stopword for any language.
+func (a *analysis) anyAddedStopword(word string) bool {
+	if a == nil {
+		return false
+	}
+	for _, words := range a.addStopwords {
+		if words[word] {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,123 @@
+// Copyright The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package prepare
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func resetAnalysis(t *testing.T) {
+	t.Helper()
+	t.Cleanup(func() {
+		require.NoError(t, ConfigureAnalysis(AnalysisConfig{}))
+	})
+}
+
+func TestExpandTerms(t *testing.T) {
+	resetAnalysis(t)
+
+	require.Equal(t, "acme intl", ExpandTerms("acme intl"))
+
+	err := ConfigureAnalysis(AnalysisConfig{
+		Synonyms: [][]string{
+			{"International", "intl"},
+			{"company", "co"},
+		},
+		Abbreviations: map[string]string{
+			"trdg": "trading",
+			"st":   "street",
+			"mfg":  "manufacturing company",
+		},
+	})
+	require.NoError(t, err)
+
+	require.Equal(t, "acme international trading company", ExpandTerms("acme intl trdg co"))
+	require.Equal(t, "123 main street", ExpandTerms("123 main st."))
+	require.Equal(t, "acme manufacturing company", ExpandTerms("acme mfg"))
+	require.Equal(t, "costa rica", ExpandTerms("costa rica"))
+}
+
+func TestConfigureAnalysis_Errors(t *testing.T) {
+	resetAnalysis(t)
+
+	err := ConfigureAnalysis(AnalysisConfig{Synonyms: [][]string{{"intl"}}})
+	require.ErrorContains(t, err, "needs at least two terms")
+
+	err = ConfigureAnalysis(AnalysisConfig{Abbreviations: map[string]string{"a b": "c"}})
+	require.ErrorContains(t, err, "invalid abbreviation")
+
+	err = ConfigureAnalysis(AnalysisConfig{File: filepath.Join(t.TempDir(), "missing.yml")})
+	require.ErrorContains(t, err, "reading analysis file")
+}
+
+func TestRemoveStopwords_Configured(t *testing.T) {
+	resetAnalysis(t)
+
+	require.Equal(t, "kim jong", RemoveStopwords("kim jong un"))
+	require.Equal(t, "acme holdings", RemoveStopwords("acme holdings"))
+
+	err := ConfigureAnalysis(AnalysisConfig{
+		Stopwords: StopwordsConfig{
+			Add:    map[string][]string{"all": {"holdings"}},
+			Remove: map[string][]string{"all": {"un"}},
+		},
+	})
+	require.NoError(t, err)
+
+	require.Equal(t, "kim jong un", RemoveStopwords("kim jong un"))
+	require.Equal(t, "acme", RemoveStopwords("acme holdings"))
+}
+
+func TestLoadAnalysis(t *testing.T) {
+	resetAnalysis(t)
+
+	// Without a file there's nothing to load
+	require.NoError(t, ConfigureAnalysis(AnalysisConfig{Abbreviations: map[string]string{"trdg": "trading"}}))
+	pending, err := LoadAnalysis()
+	require.NoError(t, err)
+	require.Nil(t, pending)
+
+	where := filepath.Join(t.TempDir(), "analysis.yml")
+	write := func(contents string) {
+		require.NoError(t, os.WriteFile(where, []byte(contents), 0600))
+	}
+	write("Synonyms:\n  - [international, intl]\n")
+
+	err = ConfigureAnalysis(AnalysisConfig{
+		File:          where,
+		Abbreviations: map[string]string{"trdg": "trading"},
+	})
+	require.NoError(t, err)
+	require.Equal(t, "international trading", ExpandTerms("intl trdg"))
+
+	// No changes
+	pending, err = LoadAnalysis()
+	require.NoError(t, err)
+	require.Nil(t, pending)
+
+	// Changes are only used once applied
+	write("Abbreviations:\n  intl: internationale\n")
+	pending, err = LoadAnalysis()
+	require.NoError(t, err)
+	require.NotNil(t, pending)
+	require.Equal(t, "international trading", ExpandTerms("intl trdg"))
+
+	pending.Apply()
+	require.Equal(t, "internationale trading", ExpandTerms("intl trdg"))
+
+	pending, err = LoadAnalysis()
+	require.NoError(t, err)
+	require.Nil(t, pending)
+
+	// Invalid files keep the previous dictionaries
+	write("Synonyms: [")
+	_, err = LoadAnalysis()
+	require.ErrorContains(t, err, "parsing analysis file")
+	require.Equal(t, "internationale trading", ExpandTerms("intl trdg"))
+}
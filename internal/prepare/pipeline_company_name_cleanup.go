@@ -11,6 +11,8 @@ import (
 // original list: inc, incorporated, llc, llp, co, ltd, limited, sa de cv, corporation, corp, ltda,
 //                open joint stock company, pty ltd, public limited company, ag, cjsc, plc, as, aps,
 //                oy, sa, gmbh, se, pvt ltd, sp zoo, ooo, sl, pjsc, jsc, bv, pt, tbk
+//
+// RemoveLegalForms recognizes these and many more legal forms in normalized names.
 
 var (
 	companySuffixReplacer = strings.NewReplacer(
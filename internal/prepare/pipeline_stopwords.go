@@ -66,12 +66,15 @@ var wordSegmenter = regexp.MustCompile(`[\pL\p{Mc}\p{Mn}-_']+`)
 //
 // When every token is trivial the output is just the lowercased tokens rejoined,
 // identical to the detect-then-clean path for any language.
-func trivialNormalization(input string) (string, bool) {
+func trivialNormalization(configured *analysis, input string) (string, bool) {
 	words := strings.Fields(strings.ToLower(input))
 	for _, w := range words {
 		if numberRegex.MatchString(w) {
 			continue
 		}
+		if configured.anyAddedStopword(w) {
+			return "", false
+		}
 		if _, isStopword := stopwordUnion[w]; isStopword {
 			return "", false
 		}
@@ -86,25 +89,29 @@ func trivialNormalization(input string) (string, bool) {
 }
 
 func RemoveStopwords(input string) string {
+	return removeAllStopwords(currentAnalysis.Load(), input)
+}
+
+func removeAllStopwords(configured *analysis, input string) string {
 	if keepStopwords {
 		return input
 	}
-	if out, ok := trivialNormalization(input); ok {
+	if out, ok := trivialNormalization(configured, input); ok {
 		return out
 	}
 
 	info := whatlanggo.Detect(input)
 
-	return removeStopwords(input, info.Lang)
+	return removeStopwords(configured, input, info.Lang)
 }
 
 func RemoveStopwordsCountry(input string, countryName string) string {
 	lang := detectLanguage(input, countryName)
 
-	return removeStopwords(input, lang)
+	return removeStopwords(currentAnalysis.Load(), input, lang)
 }
 
-func removeStopwords(input string, lang whatlanggo.Lang) string {
+func removeStopwords(configured *analysis, input string, lang whatlanggo.Lang) string {
 	if keepStopwords {
 		return input
 	}
@@ -114,6 +121,15 @@ func removeStopwords(input string, lang whatlanggo.Lang) string {
 	for i := range words {
 		cleaned := strings.TrimSpace(words[i])
 
+		// Configured stopwords are dropped and removed stopwords are kept as written
+		switch {
+		case configured.addedStopword(cleaned, lang.Iso6391()):
+			continue
+		case configured.removedStopword(cleaned, lang.Iso6391()):
+			out = append(out, cleaned)
+			continue
+		}
+
 		// When the word is a number leave it alone
 		if !numberRegex.MatchString(cleaned) {
 			cleaned = strings.TrimSpace(stopwords.CleanString(cleaned, lang.Iso6391(), false))
@@ -6,6 +6,10 @@ func RemoveStopwords(input string) string {
 	return input
 }
 
+func removeAllStopwords(_ *analysis, input string) string {
+	return input
+}
+
 func RemoveStopwordsCountry(input string, _ string) string {
 	return input
 }
@@ -60,7 +60,7 @@ func TestStopwords__clean(t *testing.T) {
 	}
 
 	for i := range cases {
-		result := removeStopwords(cases[i].in, cases[i].lang)
+		result := removeStopwords(currentAnalysis.Load(), cases[i].in, cases[i].lang)
 		require.Equal(t, cases[i].expected, result)
 	}
 }
@@ -0,0 +1,315 @@
+// Copyright The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package prepare
+
+import (
+	"slices"
+	"strings"
+	"unicode"
+)
+
+// TokenizeCJK splits terms written in Chinese characters or Hangul into one term per character,
+// since names in these scripts are written without spaces. Runs of kana and other letters are
+// kept together but split where the script changes.
+func TokenizeCJK(fields []string) []string {
+	if !slices.ContainsFunc(fields, hasCJKOrKana) {
+		return fields
+	}
+
+	out := make([]string, 0, len(fields))
+	for _, field := range fields {
+		if !hasCJKOrKana(field) {
+			out = append(out, field)
+			continue
+		}
+
+		start, kana := 0, false
+		for i, r := range field {
+			switch {
+			case isCJK(r):
+				if i > start {
+					out = append(out, field[start:i])
+				}
+				out = append(out, string(r))
+				start = i + len(string(r))
+
+			case i > start && isKana(r) != kana:
+				out = append(out, field[start:i])
+				start = i
+			}
+			kana = isKana(r)
+		}
+		if start < len(field) {
+			out = append(out, field[start:])
+		}
+	}
+	return out
+}
+
+func hasCJKOrKana(s string) bool {
+	return strings.IndexFunc(s, func(r rune) bool {
+		return isCJK(r) || isKana(r)
+	}) >= 0
+}
+
+func isKana(r rune) bool {
+	return unicode.Is(unicode.Hiragana, r) || unicode.Is(unicode.Katakana, r) || r == 'ー'
+}
+
+func isHan(r rune) bool {
+	return unicode.Is(unicode.Han, r)
+}
+
+func isHangulSyllable(r rune) bool {
+	return r >= 0xAC00 && r <= 0xD7A3
+}
+
+// RomanizeCJK returns romanized spellings of a name written in Chinese characters, Hangul or kana.
+//
+// Chinese names are read in Mandarin (Pinyin and Wade-Giles) and Cantonese (Hong Kong government
+// spellings), Korean names in Revised Romanization along with the customary family name spelling,
+// and kana in Hepburn. A variant is only returned when every character has a known reading.
+// Japanese names written in kanji are read as Chinese.
+//
+// Variants are normalized and written family name first.
+func RomanizeCJK(name string) []string {
+	var runes []rune
+	for _, r := range name {
+		if unicode.IsLetter(r) {
+			runes = append(runes, r)
+		}
+	}
+	if len(runes) < 2 {
+		return nil
+	}
+
+	var variants []string
+	switch {
+	case allRunes(runes, isHan):
+		variants = romanizeHan(runes)
+	case allRunes(runes, isHangulSyllable):
+		variants = romanizeHangul(runes)
+	case allRunes(runes, isKana):
+		variants = romanizeKana(name)
+	}
+
+	out := make([]string, 0, len(variants))
+	for _, v := range variants {
+		if v != "" && !slices.Contains(out, v) {
+			out = append(out, v)
+		}
+	}
+	return out
+}
+
+func allRunes(runes []rune, f func(rune) bool) bool {
+	for _, r := range runes {
+		if !f(r) {
+			return false
+		}
+	}
+	return true
+}
+
+// nameForms returns "family givengiven" and "family given given" spellings
+func nameForms(family string, given []string) []string {
+	out := []string{family + " " + strings.Join(given, "")}
+	if len(given) > 1 {
+		out = append(out, family+" "+strings.Join(given, " "))
+	}
+	return out
+}
+
+func romanizeHan(runes []rune) []string {
+	familySize := 1
+	if len(runes) > 2 && slices.Contains(compoundFamilyNames, string(runes[:2])) {
+		familySize = 2
+	}
+
+	pinyin, found := readAll(hanPinyin, runes)
+	if !found {
+		return nil
+	}
+	wadeGiles := make([]string, len(pinyin))
+	for i := range pinyin {
+		wadeGiles[i] = pinyinToWadeGiles(pinyin[i])
+	}
+	readings := [][]string{pinyin, wadeGiles}
+	if cantonese, found := readAll(hanCantonese, runes); found {
+		readings = append(readings, cantonese)
+	}
+
+	var out []string
+	for _, terms := range readings {
+		family := strings.Join(terms[:familySize], "")
+		out = append(out, nameForms(family, terms[familySize:])...)
+	}
+	return out
+}
+
+func readAll(table map[rune]string, runes []rune) ([]string, bool) {
+	out := make([]string, len(runes))
+	for i, r := range runes {
+		reading, found := table[r]
+		if !found {
+			return nil, false
+		}
+		out[i] = reading
+	}
+	return out, true
+}
+
+var (
+	// wadeGilesSyllables are Pinyin syllables which are spelled differently as a whole in Wade-Giles
+	wadeGilesSyllables = map[string]string{
+		"zi": "tzu", "ci": "tzu", "si": "ssu", "zhi": "chih", "chi": "chih", "shi": "shih", "ri": "jih",
+		"er": "erh", "ye": "yeh", "yan": "yen", "yue": "yueh", "you": "yu", "yong": "yung", "yi": "i",
+	}
+
+	// wadeGilesInitials maps Pinyin initials onto Wade-Giles, ignoring aspiration marks
+	wadeGilesInitials = map[string]string{
+		"b": "p", "d": "t", "g": "k", "zh": "ch", "z": "ts", "c": "ts", "j": "ch", "q": "ch", "x": "hs", "r": "j",
+	}
+)
+
+// pinyinToWadeGiles converts a Pinyin syllable into Wade-Giles without apostrophes or umlauts.
+func pinyinToWadeGiles(syllable string) string {
+	if wg, found := wadeGilesSyllables[syllable]; found {
+		return wg
+	}
+
+	initial := ""
+	for _, prefix := range []string{"zh", "ch", "sh", "b", "p", "m", "f", "d", "t", "n", "l", "g", "k", "h", "j", "q", "x", "r", "z", "c", "s", "y", "w"} {
+		if strings.HasPrefix(syllable, prefix) {
+			initial = prefix
+			break
+		}
+	}
+	final := syllable[len(initial):]
+
+	switch {
+	case strings.HasSuffix(final, "ong"):
+		final = strings.TrimSuffix(final, "ong") + "ung"
+	case final == "ian":
+		final = "ien"
+	case final == "ie":
+		final = "ieh"
+	case final == "ue":
+		final = "ueh"
+	case final == "uo" && !slices.Contains([]string{"g", "k", "h", "sh"}, initial):
+		final = "o"
+	case final == "e" && slices.Contains([]string{"g", "k", "h"}, initial):
+		final = "o"
+	case final == "ui" && (initial == "g" || initial == "k"):
+		final = "uei"
+	}
+
+	if wg, found := wadeGilesInitials[initial]; found {
+		initial = wg
+	}
+	return initial + final
+}
+
+var (
+	// Revised Romanization of the initial, medial and final jamo of a Hangul syllable
+	hangulInitials = []string{"g", "kk", "n", "d", "tt", "r", "m", "b", "pp", "s", "ss", "", "j", "jj", "ch", "k", "t", "p", "h"}
+	hangulMedials  = []string{"a", "ae", "ya", "yae", "eo", "e", "yeo", "ye", "o", "wa", "wae", "oe", "yo", "u", "wo", "we", "wi", "yu", "eu", "ui", "i"}
+	hangulFinals   = []string{"", "k", "k", "k", "n", "n", "n", "t", "l", "k", "m", "l", "l", "l", "p", "l", "m", "p", "p", "t", "t", "ng", "t", "t", "k", "t", "p", "t"}
+
+	// simplifiedVowels are Revised Romanization vowels commonly written without their second
+	// letter in given names (e.g. "Jong Un" rather than "Jeong Eun").
+	simplifiedVowels = strings.NewReplacer("eo", "o", "eu", "u")
+)
+
+func romanizeHangul(runes []rune) []string {
+	syllables := make([]string, len(runes))
+	for i, r := range runes {
+		s := int(r - 0xAC00)
+		syllables[i] = hangulInitials[s/588] + hangulMedials[(s%588)/28] + hangulFinals[s%28]
+	}
+	given := syllables[1:]
+
+	simplified := make([]string, len(given))
+	for i := range given {
+		simplified[i] = simplifiedVowels.Replace(given[i])
+	}
+
+	out := nameForms(syllables[0], given)
+	if family, found := koreanFamilyNames[runes[0]]; found {
+		out = append(out, nameForms(family, given)...)
+		out = append(out, nameForms(family, simplified)...)
+	}
+	return out
+}
+
+// romanizeKana reads each word of kana in Hepburn, where "・" also separates words. A second
+// variant drops the long vowel in "ou" and "uu" as is common in passports (e.g. "Taro").
+func romanizeKana(name string) []string {
+	words := strings.FieldsFunc(name, func(r rune) bool {
+		return unicode.IsSpace(r) || r == '・' || r == '=' || r == '＝'
+	})
+
+	terms := make([]string, 0, len(words))
+	for _, word := range words {
+		term, found := kanaToHepburn(word)
+		if !found {
+			return nil
+		}
+		terms = append(terms, term)
+	}
+	if len(terms) == 0 {
+		return nil
+	}
+
+	full := strings.Join(terms, " ")
+	return []string{full, strings.NewReplacer("ou", "o", "uu", "u").Replace(full)}
+}
+
+func kanaToHepburn(word string) (string, bool) {
+	var out string
+	double := false
+
+	for _, r := range word {
+		// Katakana share the layout of hiragana
+		if r >= 'ァ' && r <= 'ヶ' {
+			r -= 0x60
+		}
+		switch r {
+		case 'ー':
+			continue
+		case 'っ':
+			double = true
+			continue
+		}
+
+		reading, found := hiraganaHepburn[r]
+		if !found {
+			return "", false
+		}
+
+		// Contracted sounds: き+ょ is "kyo", し+ょ is "sho"
+		if (r == 'ゃ' || r == 'ゅ' || r == 'ょ') && len(out) > 1 && strings.HasSuffix(out, "i") {
+			stem := strings.TrimSuffix(out, "i")
+			if strings.HasSuffix(stem, "sh") || strings.HasSuffix(stem, "ch") || strings.HasSuffix(stem, "j") {
+				reading = reading[1:]
+			}
+			out = stem + reading
+			continue
+		}
+
+		// A small tsu doubles the following consonant
+		if double {
+			switch {
+			case strings.HasPrefix(reading, "ch"):
+				out += "t"
+			case !strings.ContainsRune("aiueo", rune(reading[0])):
+				out += reading[:1]
+			}
+			double = false
+		}
+		out += reading
+	}
+	return out, out != ""
+}
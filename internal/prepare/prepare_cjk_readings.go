@@ -0,0 +1,101 @@
+// Copyright The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package prepare
+
+import (
+	"strings"
+	"unicode/utf8"
+)
+
+// parseReadings reads "字reading" pairs separated by whitespace.
+func parseReadings(input string) map[rune]string {
+	out := make(map[rune]string)
+	for _, field := range strings.Fields(input) {
+		r, size := utf8.DecodeRuneInString(field)
+		if reading := field[size:]; reading != "" {
+			out[r] = reading
+		}
+	}
+	return out
+}
+
+var (
+	// hanPinyin holds the Mandarin (Pinyin, without tones) reading of common Chinese family and
+	// given name characters in both simplified and traditional forms.
+	hanPinyin = parseReadings(`
+王wang 李li 张zhang 張zhang 刘liu 劉liu 陈chen 陳chen 杨yang 楊yang 黄huang 黃huang 赵zhao 趙zhao
+吴wu 吳wu 周zhou 徐xu 孙sun 孫sun 马ma 馬ma 朱zhu 胡hu 郭guo 何he 高gao 林lin 罗luo 羅luo
+郑zheng 鄭zheng 梁liang 谢xie 謝xie 宋song 唐tang 许xu 許xu 韩han 韓han 冯feng 馮feng 邓deng 鄧deng
+曹cao 彭peng 曾zeng 肖xiao 蕭xiao 萧xiao 田tian 董dong 袁yuan 潘pan 于yu 蒋jiang 蔣jiang 蔡cai 余yu
+杜du 叶ye 葉ye 程cheng 苏su 蘇su 魏wei 吕lu 呂lu 丁ding 任ren 沈shen 姚yao 卢lu 盧lu 姜jiang
+崔cui 钟zhong 鍾zhong 谭tan 譚tan 陆lu 陸lu 汪wang 范fan 金jin 石shi 廖liao 贾jia 賈jia 夏xia
+韦wei 韋wei 付fu 方fang 白bai 邹zou 鄒zou 孟meng 熊xiong 秦qin 邱qiu 江jiang 尹yin 薛xue 闫yan
+段duan 雷lei 侯hou 龙long 龍long 史shi 陶tao 黎li 贺he 賀he 顾gu 顧gu 毛mao 郝hao 龚gong 龔gong
+邵shao 万wan 萬wan 钱qian 錢qian 严yan 嚴yan 覃qin 武wu 戴dai 莫mo 孔kong 向xiang 汤tang 湯tang
+习xi 習xi 温wen 溫wen 欧ou 歐ou 阳yang 陽yang 司si 麦mai 麥mai 关guan 關guan 霍huo 梅mei 鲍bao
+鮑bao 柯ke 甘gan 施shi 洪hong 邢xing 康kang 齐qi 齊qi 易yi 乔qiao 喬qiao 赖lai 賴lai 庄zhuang
+莊zhuang 游you 岳yue 常chang 牛niu 诸zhu 諸zhu 葛ge 上shang 官guan 徒tu 侯hou 皇huang 甫fu
+
+近jin 平ping 泽ze 澤ze 东dong 東dong 小xiao 强qiang 強qiang 伟wei 偉wei 明ming 华hua 華hua
+国guo 國guo 建jian 军jun 軍jun 民min 文wen 志zhi 勇yong 杰jie 傑jie 涛tao 濤tao 斌bin 超chao
+波bo 辉hui 輝hui 刚gang 剛gang 健jian 峰feng 磊lei 鹏peng 鵬peng 飞fei 飛fei 宇yu 浩hao 俊jun
+海hai 红hong 紅hong 丽li 麗li 芳fang 娟juan 敏min 静jing 靜jing 燕yan 玲ling 秀xiu 英ying
+兰lan 蘭lan 霞xia 云yun 雲yun 婷ting 雪xue 慧hui 琳lin 晶jing 欣xin 怡yi 佳jia 嘉jia 子zi
+天tian 新xin 安an 成cheng 永yong 光guang 春chun 德de 庆qing 慶qing 清qing 荣rong 榮rong
+祥xiang 福fu 贵gui 貴gui 兴xing 興xing 宏hong 鸿hong 鴻hong 振zhen 玉yu 山shan 水shui 中zhong
+大da 胜sheng 勝sheng 利li 长chang 長chang 家jia 瑞rui 凯kai 凱kai 亮liang 和he 扁bian 九jiu
+登deng 克ke 锦jin 錦jin 基ji 岐qi 镕rong 鎔rong 宝bao 寶bao 力li 立li 信xin 义yi 義yi 仁ren
+智zhi 勤qin 恩en 思si 雅ya 晓xiao 曉xiao 梓zi 晴qing 颖ying 穎ying 婉wan 莹ying 瑩ying 倩qian
+琴qin 菲fei 凤feng 鳳feng 淑shu 珍zhen 桂gui 娜na 月yue 萍ping 翠cui 维wei 維wei 卫wei 衛wei
+博bo 良liang 彬bin 辰chen 晨chen 旭xu 昊hao 然ran 轩xuan 軒xuan 睿rui 哲zhe 航hang 铭ming
+銘ming 宁ning 寧ning 达da 達da 正zheng 为wei 為wei 仲zhong 耀yao 祖zu 汉han 漢han 卓zhuo 豪hao
+乐le 樂le 君jun 日ri 一yi 二er 三san 四si 五wu 永yong 恒heng 恆heng 坚jian 堅jian 世shi 友you
+发fa 發fa 财cai 財cai 富fu 贤xian 賢xian 宗zong 忠zhong 孝xiao 廷ting 庭ting 源yuan 金jin
+`)
+
+	// hanCantonese holds the Hong Kong government romanization of common Cantonese family and
+	// given name characters in both simplified and traditional forms.
+	hanCantonese = parseReadings(`
+陈chan 陳chan 黄wong 黃wong 李lee 张cheung 張cheung 梁leung 刘lau 劉lau 林lam 吴ng 吳ng 何ho
+王wong 杨yeung 楊yeung 郑cheng 鄭cheng 周chow 朱chu 徐tsui 马ma 馬ma 胡wu 郭kwok 罗law 羅law
+曾tsang 谢tse 謝tse 蔡choi 邓tang 鄧tang 许hui 許hui 叶ip 葉ip 袁yuen 赵chiu 趙chiu 谭tam 譚tam
+冯fung 馮fung 余yu 苏so 蘇so 卢lo 盧lo 麦mak 麥mak 莫mok 梅mui 潘poon 廖liu 钟chung 鍾chung
+黎lai 严yim 嚴yim 彭pang 温wan 溫wan 杜to 方fong 江kong 宋sung 唐tong 孙suen 孫suen 高ko 霍fok
+曹tso 关kwan 關kwan 姚yiu 邱yau 邵shiu 施sze 洪hung 汤tong 湯tong 萧siu 蕭siu 魏ngai 韩hon 韓hon
+
+伟wai 偉wai 志chi 明ming 国kwok 國kwok 华wah 華wah 文man 家ka 俊chun 建kin 荣wing 榮wing
+强keung 強keung 辉fai 輝fai 嘉ka 美mei 玲ling 婷ting 芳fong 慧wai 敏man 英ying 德tak 成shing
+达tat 達tat 民man 光kwong 兴hing 興hing 子tsz 天tin 海hoi 安on 丽lai 麗lai 秀sau 珍chun 欣yan
+怡yee 小siu 永wing 金kam 锦kam 錦kam 宝po 寶po 中chung 平ping 新san 大tai 东tung 東tung
+龙lung 龍lung 凤fung 鳳fung 思sze 雅nga 卓cheuk 健kin 杰kit 傑kit 豪ho 浩ho 君kwan 乐lok 樂lok
+晓hiu 曉hiu 振chun 维wai 維wai 耀yiu 祖cho 汉hon 漢hon 泽chak 澤chak 铭ming 銘ming 鸿hung 鴻hung
+恩yan 佩pui 庆hing 慶hing 梓tsz 晴ching 颖wing 穎wing 一yat 世sai 富fu 贤yin 賢yin 宗chung
+`)
+
+	// compoundFamilyNames are two character Chinese family names
+	compoundFamilyNames = []string{
+		"欧阳", "歐陽", "司马", "司馬", "诸葛", "諸葛", "上官", "皇甫", "司徒", "夏侯", "东方", "東方",
+	}
+
+	// koreanFamilyNames holds the customary spellings of common Korean family names, which
+	// differ from Revised Romanization (e.g. 김 is "gim" but written "kim").
+	koreanFamilyNames = parseReadings(`
+김kim 이lee 박park 최choi 정jung 강kang 조cho 윤yoon 장jang 임lim 한han 오oh 서seo 신shin
+권kwon 황hwang 안ahn 송song 류ryu 홍hong 전jeon 고ko 문moon 양yang 손son 배bae 백baek 허heo
+노roh 심shim 하ha 곽kwak 성sung 차cha 주joo 우woo 구koo 민min 유yoo 나na 진jin 지ji 엄uhm
+`)
+)
+
+var (
+	// hiraganaHepburn holds the Hepburn romanization of hiragana. Katakana is mapped onto
+	// hiragana before lookup.
+	hiraganaHepburn = parseReadings(`
+あa いi うu えe おo かka きki くku けke こko さsa しshi すsu せse そso たta ちchi つtsu てte とto
+なna にni ぬnu ねne のno はha ひhi ふfu へhe ほho まma みmi むmu めme もmo やya ゆyu よyo
+らra りri るru れre ろro わwa ゐi ゑe をo んn がga ぎgi ぐgu げge ごgo ざza じji ずzu ぜze ぞzo
+だda ぢji づzu でde どdo ばba びbi ぶbu べbe ぼbo ぱpa ぴpi ぷpu ぺpe ぽpo ゔvu
+ぁa ぃi ぅu ぇe ぉo ゃya ゅyu ょyo ゎwa
+`)
+)
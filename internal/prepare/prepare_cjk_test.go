@@ -0,0 +1,80 @@
+package prepare_test
+
+import (
+	"testing"
+
+	"github.com/moov-io/watchman/internal/prepare"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestTokenizeCJK(t *testing.T) {
+	cases := []struct {
+		input    []string
+		expected []string
+	}{
+		{[]string{"john", "smith"}, []string{"john", "smith"}},
+		{[]string{"习近平"}, []string{"习", "近", "平"}},
+		{[]string{"김정은"}, []string{"김", "정", "은"}},
+		{[]string{"やまだ", "たろう"}, []string{"やまだ", "たろう"}},
+		{[]string{"abc有限公司"}, []string{"abc", "有", "限", "公", "司"}},
+		{[]string{"トヨタ自動車"}, []string{"トヨタ", "自", "動", "車"}},
+		{[]string{"abcトヨタ"}, []string{"abc", "トヨタ"}},
+	}
+	for _, tc := range cases {
+		require.Equal(t, tc.expected, prepare.TokenizeCJK(tc.input))
+	}
+}
+
+func TestRomanizeCJK(t *testing.T) {
+	cases := []struct {
+		input    string
+		expected []string
+	}{
+		{
+			input:    "习近平",
+			expected: []string{"xi jinping", "xi jin ping", "hsi chinping", "hsi chin ping"},
+		},
+		{
+			input: "陳大文",
+			expected: []string{
+				"chen dawen", "chen da wen", "chen tawen", "chen ta wen", "chan taiman", "chan tai man",
+			},
+		},
+		{
+			input:    "欧阳明",
+			expected: []string{"ouyang ming"},
+		},
+		{
+			input: "김정은",
+			expected: []string{
+				"gim jeongeun", "gim jeong eun", "kim jeongeun", "kim jeong eun", "kim jongun", "kim jong un",
+			},
+		},
+		{
+			input:    "やまだ たろう",
+			expected: []string{"yamada tarou", "yamada taro"},
+		},
+		{
+			input:    "キョウコ・ハットリ",
+			expected: []string{"kyouko hattori", "kyoko hattori"},
+		},
+		{
+			input:    "ジョン",
+			expected: []string{"jon"},
+		},
+		{input: "John Smith"},
+		{input: "习"},
+		{input: "习龘"}, // unknown reading
+	}
+	for _, tc := range cases {
+		t.Run(tc.input, func(t *testing.T) {
+			got := prepare.RomanizeCJK(tc.input)
+			if len(tc.expected) == 0 {
+				require.Empty(t, got)
+			} else {
+				require.Equal(t, tc.expected, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,260 @@
+// Copyright The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package prepare
+
+import (
+	"cmp"
+	"slices"
+	"strings"
+	"unicode/utf8"
+)
+
+// LegalFormCategory groups legal forms which are interchangeable when comparing names.
+type LegalFormCategory string
+
+const (
+	LegalFormCompany     LegalFormCategory = "company" // limited liability, joint-stock and public companies
+	LegalFormPartnership LegalFormCategory = "partnership"
+	LegalFormCooperative LegalFormCategory = "cooperative"
+	LegalFormNonProfit   LegalFormCategory = "non-profit"
+	LegalFormState       LegalFormCategory = "state-enterprise"
+	LegalFormSole        LegalFormCategory = "sole-proprietorship"
+)
+
+// LegalForm is the legal form of a business, e.g. "llc" or "gmbh".
+type LegalForm struct {
+	// Abbreviation is the common local abbreviation of the form, e.g. "ooo" for
+	// "obshchestvo s ogranichennoy otvetstvennostyu" and "общество с ограниченной ответственностью".
+	Abbreviation string
+	Category     LegalFormCategory
+}
+
+// Empty returns true when no legal form was found.
+func (f LegalForm) Empty() bool {
+	return f.Abbreviation == ""
+}
+
+// legalForms are modeled on the local abbreviations and names in the ISO 20275 Entity Legal Forms
+// code list. Each entry lists the abbreviation, its category and other spellings of it, which
+// include transliterated and native script forms.
+var legalForms = []struct {
+	abbreviation string
+	category     LegalFormCategory
+	spellings    []string
+}{
+	// English
+	{"llc", LegalFormCompany, []string{"l.l.c.", "limited liability company"}},
+	{"ltd", LegalFormCompany, []string{"limited", "co ltd", "co. limited", "company limited"}},
+	{"inc", LegalFormCompany, []string{"incorporated", "co inc"}},
+	{"corp", LegalFormCompany, []string{"corporation"}},
+	{"co", LegalFormCompany, nil},
+	{"plc", LegalFormCompany, []string{"public limited company"}},
+	{"pvt ltd", LegalFormCompany, []string{"private limited", "pvt. ltd.", "private ltd"}},
+	{"pte ltd", LegalFormCompany, []string{"pte. ltd.", "pte limited"}},
+	{"pty ltd", LegalFormCompany, []string{"pty. ltd.", "pty limited", "proprietary limited"}},
+	{"sdn bhd", LegalFormCompany, []string{"sendirian berhad"}},
+	{"bhd", LegalFormCompany, []string{"berhad"}},
+	{"lp", LegalFormPartnership, []string{"limited partnership"}},
+	{"llp", LegalFormPartnership, []string{"limited liability partnership"}},
+	{"fze", LegalFormCompany, []string{"free zone establishment"}},
+	{"fzco", LegalFormCompany, []string{"free zone company"}},
+	{"fz llc", LegalFormCompany, []string{"fz-llc"}},
+
+	// German, Dutch and Nordic
+	{"gmbh", LegalFormCompany, []string{"gesellschaft mit beschränkter haftung"}},
+	{"ag", LegalFormCompany, []string{"aktiengesellschaft"}},
+	{"gmbh co kg", LegalFormPartnership, []string{"gmbh & co. kg"}},
+	{"kg", LegalFormPartnership, []string{"kommanditgesellschaft"}},
+	{"ohg", LegalFormPartnership, []string{"offene handelsgesellschaft"}},
+	{"ev", LegalFormNonProfit, []string{"e.v.", "eingetragener verein"}},
+	{"bv", LegalFormCompany, []string{"b.v.", "besloten vennootschap"}},
+	{"nv", LegalFormCompany, []string{"n.v.", "naamloze vennootschap"}},
+	{"as", LegalFormCompany, []string{"a.s.", "a/s", "aksjeselskap", "aktieselskab"}},
+	{"asa", LegalFormCompany, []string{"allmennaksjeselskap"}},
+	{"aps", LegalFormCompany, []string{"anpartsselskab"}},
+	{"ab", LegalFormCompany, []string{"aktiebolag"}},
+	{"oy", LegalFormCompany, []string{"osakeyhtiö"}},
+	{"oyj", LegalFormCompany, []string{"julkinen osakeyhtiö"}},
+
+	// Romance languages
+	{"sa", LegalFormCompany, []string{"s.a.", "sociedad anonima", "société anonyme", "sociedade anonima"}},
+	{"sa de cv", LegalFormCompany, []string{"s.a. de c.v.", "sociedad anonima de capital variable"}},
+	{"sas", LegalFormCompany, []string{"s.a.s.", "société par actions simplifiée"}},
+	{"sarl", LegalFormCompany, []string{"s.a.r.l.", "société à responsabilité limitée"}},
+	{"srl", LegalFormCompany, []string{"s.r.l.", "società a responsabilità limitata", "sociedad de responsabilidad limitada"}},
+	{"spa", LegalFormCompany, []string{"s.p.a.", "società per azioni"}},
+	{"sl", LegalFormCompany, []string{"s.l.", "sociedad limitada"}},
+	{"ltda", LegalFormCompany, []string{"limitada"}},
+	{"snc", LegalFormPartnership, []string{"s.n.c.", "société en nom collectif"}},
+	{"coop", LegalFormCooperative, []string{"cooperative", "cooperativa", "coopérative"}},
+
+	// Central and Eastern Europe, Turkey
+	{"sp zoo", LegalFormCompany, []string{"sp. z o.o.", "spółka z ograniczoną odpowiedzialnością"}},
+	{"doo", LegalFormCompany, []string{"d.o.o.", "društvo s ograničenom odgovornošću"}},
+	{"sro", LegalFormCompany, []string{"s.r.o.", "společnost s ručením omezeným"}},
+	{"kft", LegalFormCompany, []string{"korlátolt felelősségű társaság"}},
+	{"zrt", LegalFormCompany, []string{"zártkörűen működő részvénytársaság"}},
+	{"as", LegalFormCompany, []string{"anonim şirketi"}},
+	{"ltd sti", LegalFormCompany, []string{"ltd. şti.", "limited şirketi"}},
+
+	// Russia and the former Soviet Union
+	{"ooo", LegalFormCompany, []string{"ооо", "obshchestvo s ogranichennoy otvetstvennostyu", "общество с ограниченной ответственностью"}},
+	{"ao", LegalFormCompany, []string{"ао", "aktsionernoye obshchestvo", "акционерное общество"}},
+	{"jsc", LegalFormCompany, []string{"joint stock company", "joint-stock company"}},
+	{"ojsc", LegalFormCompany, []string{"oao", "оао", "open joint stock company", "открытое акционерное общество"}},
+	{"cjsc", LegalFormCompany, []string{"zao", "зао", "closed joint stock company", "закрытое акционерное общество"}},
+	{"pjsc", LegalFormCompany, []string{"pao", "пао", "public joint stock company", "публичное акционерное общество"}},
+	{"tov", LegalFormCompany, []string{"тов", "товариство з обмеженою відповідальністю"}},
+	{"too", LegalFormPartnership, []string{"тоо", "товарищество с ограниченной ответственностью"}},
+	{"fgup", LegalFormState, []string{"фгуп", "federal state unitary enterprise", "федеральное государственное унитарное предприятие"}},
+	{"gup", LegalFormState, []string{"гуп", "state unitary enterprise", "государственное унитарное предприятие"}},
+	{"ano", LegalFormNonProfit, []string{"ано", "автономная некоммерческая организация"}},
+	{"ip", LegalFormSole, []string{"ип", "индивидуальный предприниматель"}},
+
+	// Asia
+	{"pt", LegalFormCompany, []string{"p.t.", "perseroan terbatas"}},
+	{"tbk", LegalFormCompany, []string{"terbuka"}},
+	{"kk", LegalFormCompany, []string{"k.k.", "kabushiki kaisha", "株式会社"}},
+	{"yk", LegalFormCompany, []string{"yugen kaisha", "有限会社"}},
+	{"gk", LegalFormCompany, []string{"godo kaisha", "合同会社"}},
+	{"youxian gongsi", LegalFormCompany, []string{"有限公司", "有限責任公司", "有限责任公司"}},
+	{"gufen youxian gongsi", LegalFormCompany, []string{"股份有限公司"}},
+	{"jusik hoesa", LegalFormCompany, []string{"주식회사"}},
+	{"yuhan hoesa", LegalFormCompany, []string{"유한회사"}},
+}
+
+var (
+	// legalFormSpellings maps each normalized spelling onto its legal form
+	legalFormSpellings = func() map[string]LegalForm {
+		out := make(map[string]LegalForm)
+		for _, entry := range legalForms {
+			form := LegalForm{Abbreviation: entry.abbreviation, Category: entry.category}
+			for _, spelling := range append([]string{entry.abbreviation}, entry.spellings...) {
+				out[LowerAndRemovePunctuation(spelling)] = form
+			}
+		}
+		return out
+	}()
+
+	// cjkLegalForms are spellings written without spaces, longest first
+	cjkLegalForms = func() []string {
+		var out []string
+		for spelling := range legalFormSpellings {
+			if r, _ := utf8.DecodeRuneInString(spelling); isCJK(r) || isKana(r) {
+				out = append(out, spelling)
+			}
+		}
+		slices.SortFunc(out, func(a, b string) int {
+			return cmp.Or(len(b)-len(a), strings.Compare(a, b))
+		})
+		return out
+	}()
+
+	maxLegalFormTerms = func() int {
+		var longest int
+		for spelling := range legalFormSpellings {
+			longest = max(longest, len(strings.Fields(spelling)))
+		}
+		return longest
+	}()
+
+	// leadingLegalForms are short forms which are also written before the name (e.g. "PT Bank Mandiri",
+	// "OOO Romashka")
+	leadingLegalForms = []string{
+		"pt", "jsc", "ooo", "ao", "oao", "zao", "pao", "tov", "gup", "fgup",
+		"ооо", "ао", "оао", "зао", "пао", "тов", "тоо", "гуп", "фгуп", "ано", "ип",
+	}
+)
+
+// legalFormSeparators are written between a name and its legal form
+const legalFormSeparators = " ,;:-/&"
+
+// RemoveLegalForms removes legal forms (e.g. "LLC", "OOO", "有限公司") from the end of a normalized
+// business name, along with forms commonly written before the name (e.g. "OOO Romashka" or
+// "Public Joint Stock Company Rosneft"). Forms elsewhere are kept as they're often part of the
+// name itself. The legal form closest to the end of the name is returned. At least one term of
+// the name is always kept, without the punctuation separating it from the removed forms.
+func RemoveLegalForms(name string) (string, LegalForm) {
+	var found LegalForm
+	record := func(form LegalForm) {
+		if found.Empty() {
+			found = form
+		}
+	}
+
+	terms := strings.Fields(name)
+	for len(terms) > 0 {
+		if form, size := trailingLegalForm(terms); size > 0 {
+			record(form)
+			terms = terms[:len(terms)-size]
+			continue
+		}
+
+		// Chinese, Japanese and Korean forms are written without spaces
+		last := len(terms) - 1
+		if form, rest := cutCJKLegalForm(terms[last], strings.CutSuffix); rest != "" {
+			record(form)
+			terms[last] = rest
+			continue
+		}
+		break
+	}
+
+	for len(terms) > 0 {
+		if form, size := leadingLegalForm(terms); size > 0 {
+			record(form)
+			terms = terms[size:]
+			continue
+		}
+		if form, rest := cutCJKLegalForm(terms[0], strings.CutPrefix); rest != "" {
+			record(form)
+			terms[0] = rest
+			continue
+		}
+		break
+	}
+
+	out := strings.Join(terms, " ")
+	if !found.Empty() {
+		// Drop separators left behind by the form, e.g. the comma of "SUEX OTC, S.R.O."
+		out = strings.Trim(out, legalFormSeparators)
+	}
+	return out, found
+}
+
+// trailingLegalForm returns the longest legal form the terms end with and how many terms it spans.
+// Terms may be written with punctuation.
+func trailingLegalForm(terms []string) (LegalForm, int) {
+	for size := min(maxLegalFormTerms, len(terms)-1); size > 0; size-- {
+		if form, exists := legalFormSpellings[LowerAndRemovePunctuation(strings.Join(terms[len(terms)-size:], " "))]; exists {
+			return form, size
+		}
+	}
+	return LegalForm{}, 0
+}
+
+// leadingLegalForm returns the longest legal form the terms start with and how many terms it spans.
+// Only forms commonly written first and forms of several words are recognized.
+func leadingLegalForm(terms []string) (LegalForm, int) {
+	for size := min(maxLegalFormTerms, len(terms)-1); size > 0; size-- {
+		spelling := LowerAndRemovePunctuation(strings.Join(terms[:size], " "))
+		form, exists := legalFormSpellings[spelling]
+		if exists && (strings.Contains(spelling, " ") || slices.Contains(leadingLegalForms, spelling)) {
+			return form, size
+		}
+	}
+	return LegalForm{}, 0
+}
+
+// cutCJKLegalForm removes a Chinese, Japanese or Korean legal form from a term with cut
+// (strings.CutPrefix or strings.CutSuffix). An empty string is returned when no form was removed.
+func cutCJKLegalForm(term string, cut func(s, affix string) (string, bool)) (LegalForm, string) {
+	for _, spelling := range cjkLegalForms {
+		if rest, found := cut(term, spelling); found && rest != "" {
+			return legalFormSpellings[spelling], rest
+		}
+	}
+	return LegalForm{}, ""
+}
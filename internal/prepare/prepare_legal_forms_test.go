@@ -0,0 +1,89 @@
+// Copyright The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package prepare
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestRemoveLegalForms(t *testing.T) {
+	cases := []struct {
+		input        string
+		expectedName string
+		expectedForm string
+	}{
+		{"Acme LLC", "acme", "llc"},
+		{"MKS INTERNATIONAL CO. LTD.", "mks international", "ltd"},
+		{"COBALT REFINERY CO. INC.", "cobalt refinery", "inc"},
+		{"MC OVERSEAS TRADING COMPANY SA DE CV", "mc overseas trading company", "sa de cv"},
+		{"Siemens Aktiengesellschaft", "siemens", "ag"},
+		{"Deutsche Bahn AG", "deutsche bahn", "ag"},
+		{"ACME GmbH & Co. KG", "acme", "gmbh co kg"},
+		{"Orlen Sp. z o.o.", "orlen", "sp zoo"},
+		{"SIS D.O.O.", "sis", "doo"},
+		{"OOO Gazprom Export", "gazprom export", "ooo"},
+		{"ООО «Газпром экспорт»", "газпром экспорт", "ooo"},
+		{"ПАО Сбербанк", "сбербанк", "pjsc"},
+		{"Sberbank PJSC", "sberbank", "pjsc"},
+		{"Public Joint Stock Company Rosneft Oil Company", "rosneft oil company", "pjsc"},
+		{"PT Bank Mandiri Tbk", "bank mandiri", "tbk"},
+		{"华为技术有限公司", "华为技术", "youxian gongsi"},
+		{"中国石油天然气股份有限公司", "中国石油天然气", "gufen youxian gongsi"},
+		{"株式会社東芝", "東芝", "kk"},
+		{"삼성전자 주식회사", "삼성전자", "jusik hoesa"},
+
+		// Short forms are part of the name unless they're at the end
+		{"As Salam Trading", "as salam trading", ""},
+		{"Banco do Brasil SA", "banco do brasil", "sa"},
+		{"Too Good Trading", "too good trading", ""},
+
+		// Forms within the name are kept
+		{"AMD CO. LTD AGENCY", "amd co ltd agency", ""},
+		{"Corporation Bank Holdings", "corporation bank holdings", ""},
+		{"Cooperative Bank of Kenya Limited", "cooperative bank of kenya", "ltd"},
+		{"Limited Liability Company Acme Trading", "acme trading", "llc"},
+
+		// Controls
+		{"LLC", "llc", ""},
+		{"Tadbir Economic Development Group", "tadbir economic development group", ""},
+		{"11420 Corp.", "11420", "corp"},
+	}
+	for _, tc := range cases {
+		t.Run(tc.input, func(t *testing.T) {
+			name, form := RemoveLegalForms(LowerAndRemovePunctuation(tc.input))
+			require.Equal(t, tc.expectedName, name)
+			require.Equal(t, tc.expectedForm, form.Abbreviation)
+		})
+	}
+}
+
+func TestRemoveLegalForms_Separators(t *testing.T) {
+	cases := []struct {
+		input, expected string
+	}{
+		{"SUEX OTC, S.R.O.", "SUEX OTC"},
+		{"YAKIMA OIL TRADING, LLP", "YAKIMA OIL TRADING"},
+		{"RUNNING BROOK (USA), LLC", "RUNNING BROOK (USA)"},
+		{"OOO - Romashka", "Romashka"},
+		{"TADBIR ECONOMIC DEVELOPMENT GROUP,", "TADBIR ECONOMIC DEVELOPMENT GROUP,"},
+	}
+	for _, tc := range cases {
+		name, _ := RemoveLegalForms(tc.input)
+		require.Equal(t, tc.expected, name, tc.input)
+	}
+}
+
+func TestLegalForm_Category(t *testing.T) {
+	_, form := RemoveLegalForms("acme limited liability partnership")
+	require.Equal(t, LegalFormPartnership, form.Category)
+
+	_, form = RemoveLegalForms("фгуп acme")
+	require.Equal(t, LegalFormState, form.Category)
+
+	_, form = RemoveLegalForms("acme")
+	require.True(t, form.Empty())
+}
@@ -0,0 +1,202 @@
+// Copyright The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package prepare
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"os"
+	"slices"
+	"strings"
+	"sync"
+)
+
+// nameVariantGroups are sets of given names which refer to the same person. Each group contains
+// nicknames, diminutives, transliterations and close equivalents in other languages. Names which
+// are commonly given on their own (e.g. "Ivan" or "Sean") are kept in their own group so unrelated
+// people aren't matched.
+//
+// Every term in a group is a variant of every other term in the group. Terms can appear in multiple groups.
+var nameVariantGroups = [][]string{
+	// English given names and their cross-language equivalents
+	{"abraham", "abe", "ibrahim", "avraham"},
+	{"alexander", "alex", "alexandr", "aleksandr", "aleksander", "alexandre", "alejandro", "alessandro", "sasha", "sashka", "shura", "sandro", "iskander", "iskandar"},
+	{"andrew", "andy", "drew", "andrei", "andrey", "andriy", "andres", "andre", "andreas"},
+	{"anthony", "tony", "antonio", "anton", "antoine"},
+	{"benjamin", "ben", "benny"},
+	{"charles", "charlie", "chuck", "carlos", "carl", "karl", "carlo"},
+	{"christopher", "chris", "kit", "cristobal", "kristof"},
+	{"daniel", "dan", "danny"},
+	{"david", "dave", "davy", "dawood", "daoud", "dawud"},
+	{"edward", "ed", "eddie", "ted", "teddy", "eduardo", "eduard"},
+	{"francis", "frank", "frankie", "francisco", "paco", "francesco", "franz", "francois"},
+	{"george", "georgy", "georgi", "georgiy", "jorge", "giorgio", "georg"},
+	{"yuri", "yury", "yuriy", "yura"},
+	{"gregory", "greg", "grigory", "grigoriy", "grisha", "gregorio"},
+	{"henry", "hank", "harry", "enrique", "heinrich", "henri", "enrico"},
+	{"jacob", "jake", "yakov", "yaakov", "jakob", "yakub", "yaqub"},
+	{"james", "jim", "jimmy", "jamie", "diego", "jaime"},
+	{"john", "jack", "johnny", "jon", "juan", "johann", "johannes", "giovanni", "ioannis"},
+	{"ivan", "vanya"},
+	{"jonathan", "jon"},
+	{"nathan", "nate"},
+	{"joseph", "joe", "joey", "jose", "giuseppe", "josef", "osip", "yusuf", "youssef", "yousef"},
+	{"lawrence", "larry", "lorenzo", "laurent"},
+	{"matthew", "matt", "matvey", "mateo", "matteo", "mathieu"},
+	{"michael", "mike", "mikey", "mick", "mikhail", "misha", "miguel", "michel", "mikael"},
+	{"moses", "musa", "moshe"},
+	{"nicholas", "nick", "nicky", "nikolai", "nikolay", "kolya", "nicolas", "nicola", "nikola"},
+	{"patrick", "pat", "paddy", "patricio"},
+	{"paul", "pablo", "pavel", "pasha", "paolo", "paulo"},
+	{"peter", "pete", "pyotr", "petr", "petya", "pedro", "pierre", "pietro"},
+	{"richard", "dick", "rick", "ricky", "rich", "ricardo"},
+	{"robert", "bob", "bobby", "rob", "robbie", "bert", "roberto"},
+	{"samuel", "sam", "sammy"},
+	{"solomon", "sol", "suleiman", "sulayman", "shlomo"},
+	{"stephen", "steven", "steve", "stefan", "stepan", "esteban", "stephane"},
+	{"thomas", "tom", "tommy", "tomas", "foma"},
+	{"timothy", "tim", "timofey"},
+	{"william", "bill", "billy", "will", "willy", "wilhelm", "guillermo", "guillaume", "vilhelm"},
+
+	{"ann", "anne", "anna", "annie", "nancy", "anya"},
+	{"elizabeth", "liz", "lizzie", "beth", "betty", "eliza", "elisabeth", "isabel", "elisabetta", "yelizaveta", "liza"},
+	{"helen", "ellen", "elena", "yelena", "lena", "helena"},
+	{"katherine", "catherine", "kate", "katie", "kathy", "cathy", "ekaterina", "yekaterina", "katya", "katarina", "caterina"},
+	{"margaret", "maggie", "meg", "peggy", "marge", "margarita", "margherita"},
+	{"mary", "maria", "marie", "molly", "polly", "masha", "mariya", "maryam", "miriam"},
+
+	// Slavic given names and diminutives
+	{"anatoly", "anatoliy", "tolya"},
+	{"boris", "borya"},
+	{"dmitry", "dmitri", "dmitriy", "dima", "dimitri", "dimitrios"},
+	{"konstantin", "kostya", "constantine"},
+	{"leonid", "lyonya"},
+	{"lyudmila", "ludmila", "lyuda", "mila"},
+	{"natalia", "natalya", "natasha", "natalie"},
+	{"olga", "olya"},
+	{"sergei", "sergey", "sergiy", "serezha", "sergio", "serge"},
+	{"svetlana", "sveta"},
+	{"tatiana", "tatyana", "tanya"},
+	{"valentin", "valya"},
+	{"viktor", "victor", "vitya"},
+	{"vladimir", "vova", "volodya", "volodymyr", "wladimir"},
+	{"vyacheslav", "slava"},
+	{"yevgeny", "yevgeniy", "evgeny", "evgeni", "zhenya", "eugene", "eugenio"},
+
+	// Arabic given names commonly transliterated several ways
+	{"abdullah", "abdallah", "abdulla"},
+	{"ahmed", "ahmad", "ahmet"},
+	{"hassan", "hasan"},
+	{"hussein", "husayn", "hussain", "husain", "huseyin"},
+	{"khalid", "khaled"},
+	{"mohammed", "muhammad", "mohamed", "mohammad", "muhammed", "mohamad", "mehmet"},
+	{"mustafa", "mostafa", "mustapha"},
+	{"omar", "umar"},
+	{"osama", "usama"},
+}
+
+type nameVariants struct {
+	mu       sync.RWMutex
+	variants map[string][]string
+}
+
+// add merges groups of variants in one update so readers never see part of them.
+func (nv *nameVariants) add(groups [][]string) {
+	nv.mu.Lock()
+	defer nv.mu.Unlock()
+
+	if nv.variants == nil {
+		nv.variants = make(map[string][]string)
+	}
+	for _, group := range groups {
+		for _, term := range group {
+			for _, other := range group {
+				if term == other || slices.Contains(nv.variants[term], other) {
+					continue
+				}
+				nv.variants[term] = append(nv.variants[term], other)
+			}
+		}
+	}
+}
+
+func (nv *nameVariants) get(term string) []string {
+	nv.mu.RLock()
+	defer nv.mu.RUnlock()
+
+	return nv.variants[term]
+}
+
+var (
+	defaultNameVariants = func() *nameVariants {
+		nv := &nameVariants{}
+		nv.add(nameVariantGroups)
+		return nv
+	}()
+)
+
+// LoadNameVariantsFile reads a file of custom name variants (see LoadNameVariants).
+func LoadNameVariantsFile(path string) error {
+	fd, err := os.Open(path)
+	if err != nil {
+		return fmt.Errorf("opening name variants file: %w", err)
+	}
+	defer fd.Close()
+
+	if err := LoadNameVariants(fd); err != nil {
+		return fmt.Errorf("loading %s: %w", path, err)
+	}
+	return nil
+}
+
+// NameVariants returns the known nicknames, diminutives and other language equivalents of a given name term.
+//
+// The term is expected to already be normalized (see LowerAndRemovePunctuation).
+func NameVariants(term string) []string {
+	return defaultNameVariants.get(term)
+}
+
+// LoadNameVariants reads custom groups of name variants and adds them to the known variants.
+// Nothing is added unless every line is valid. Set NAME_VARIANTS_FILE to load a file of variants on startup.
+//
+// Each line contains a comma separated group of names which are variants of each other.
+// Blank lines and lines starting with # are ignored.
+//
+//	# nicknames
+//	william,bill,billy
+//	aleksandr,sasha
+func LoadNameVariants(r io.Reader) error {
+	scanner := bufio.NewScanner(r)
+
+	var groups [][]string
+	var line int
+	for scanner.Scan() {
+		line++
+
+		text := strings.TrimSpace(scanner.Text())
+		if text == "" || strings.HasPrefix(text, "#") {
+			continue
+		}
+
+		var group []string
+		for _, term := range strings.Split(text, ",") {
+			if term = LowerAndRemovePunctuation(term); term != "" {
+				group = append(group, term)
+			}
+		}
+		if len(group) < 2 {
+			return fmt.Errorf("line %d: name variant group needs at least two names", line)
+		}
+		groups = append(groups, group)
+	}
+	if err := scanner.Err(); err != nil {
+		return fmt.Errorf("reading name variants: %w", err)
+	}
+
+	defaultNameVariants.add(groups)
+
+	return nil
+}
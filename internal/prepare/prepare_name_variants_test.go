@@ -0,0 +1,68 @@
+package prepare_test
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/moov-io/watchman/internal/prepare"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestPrepare_NameVariants(t *testing.T) {
+	require.Contains(t, prepare.NameVariants("bill"), "william")
+	require.Contains(t, prepare.NameVariants("william"), "bill")
+	require.Contains(t, prepare.NameVariants("sasha"), "aleksandr")
+	require.Contains(t, prepare.NameVariants("alex"), "aleksandr")
+
+	// "jon" is in two groups
+	require.Contains(t, prepare.NameVariants("jon"), "john")
+	require.Contains(t, prepare.NameVariants("jon"), "jonathan")
+
+	require.Empty(t, prepare.NameVariants("zzyzx"))
+
+	// distinct names aren't merged
+	require.NotContains(t, prepare.NameVariants("john"), "ivan")
+	require.NotContains(t, prepare.NameVariants("john"), "sean")
+	require.NotContains(t, prepare.NameVariants("jonathan"), "nathan")
+	require.NotContains(t, prepare.NameVariants("george"), "yuri")
+	require.NotContains(t, prepare.NameVariants("nicholas"), "klaus")
+	require.NotContains(t, prepare.NameVariants("margaret"), "rita")
+	require.NotContains(t, prepare.NameVariants("yevgeny"), "gene")
+}
+
+func TestPrepare_LoadNameVariants(t *testing.T) {
+	input := strings.NewReader(`# custom variants
+Tolik, Anatolii
+
+qwerty,ASDF, zxcv
+`)
+	err := prepare.LoadNameVariants(input)
+	require.NoError(t, err)
+
+	require.ElementsMatch(t, []string{"anatolii"}, prepare.NameVariants("tolik"))
+	require.ElementsMatch(t, []string{"asdf", "zxcv"}, prepare.NameVariants("qwerty"))
+
+	t.Run("invalid", func(t *testing.T) {
+		err := prepare.LoadNameVariants(strings.NewReader("foo\n"))
+		require.ErrorContains(t, err, "line 1: name variant group needs at least two names")
+
+		// earlier groups aren't added when a later line is invalid
+		err = prepare.LoadNameVariants(strings.NewReader("poiuy,lkjhg\nfoo\n"))
+		require.ErrorContains(t, err, "line 2: name variant group needs at least two names")
+		require.Empty(t, prepare.NameVariants("poiuy"))
+	})
+
+	t.Run("file", func(t *testing.T) {
+		path := filepath.Join(t.TempDir(), "variants.txt")
+		require.NoError(t, os.WriteFile(path, []byte("mnbvc,trewq\n"), 0600))
+
+		require.NoError(t, prepare.LoadNameVariantsFile(path))
+		require.ElementsMatch(t, []string{"trewq"}, prepare.NameVariants("mnbvc"))
+
+		err := prepare.LoadNameVariantsFile(filepath.Join(t.TempDir(), "missing.txt"))
+		require.ErrorContains(t, err, "opening name variants file")
+	})
+}
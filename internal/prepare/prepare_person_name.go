@@ -0,0 +1,266 @@
+// Copyright The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package prepare
+
+import (
+	"slices"
+	"strings"
+	"unicode"
+)
+
+// PersonName is a person's name split into its parts. Each part holds normalized terms.
+//
+// Family always holds the family name(s) regardless of the order they were written in.
+type PersonName struct {
+	Honorifics []string // e.g. "dr", "sheikh"
+	Given      []string
+	Middle     []string
+	Patronymic []string // e.g. "vladimirovich", or the name following "bin" / "ibn"
+	Particles  []string // family name particles, e.g. "van", "der", "al"
+	Family     []string
+	Suffixes   []string // e.g. "jr", "iii"
+}
+
+// Empty returns true when no name parts were found.
+func (n PersonName) Empty() bool {
+	return len(n.Given) == 0 && len(n.Family) == 0
+}
+
+// Forenames returns the given and middle names in order.
+func (n PersonName) Forenames() []string {
+	out := make([]string, 0, len(n.Given)+len(n.Middle))
+	out = append(out, n.Given...)
+	return append(out, n.Middle...)
+}
+
+var (
+	nameHonorifics = []string{
+		"mr", "mrs", "ms", "miss", "mx", "dr", "prof", "sir", "dame", "lord", "lady", "rev", "hon",
+		"sheikh", "shaikh", "sheik", "haji", "hajji", "hadji", "mullah", "mufti", "imam", "ayatollah",
+		"general", "gen", "colonel", "col", "major", "maj", "captain", "capt", "lieutenant", "lt",
+		"admiral", "adm", "commander", "cmdr", "president", "minister", "senator", "judge",
+	}
+	nameSuffixes = []string{"jr", "sr", "ii", "iii", "iv", "phd", "md", "esq"}
+
+	// nameParticles are prefixes of family names
+	nameParticles = []string{
+		"al", "el", "bin", "ibn", "bint", "ould", "abu",
+		"van", "von", "der", "den", "ter", "ten", "zu",
+		"de", "del", "della", "dei", "di", "da", "das", "dos", "du", "la", "le",
+	}
+
+	// patronymicMarkers are followed by the father's name
+	patronymicMarkers = []string{"bin", "ibn", "bint", "binti", "ould"}
+
+	// patronymicSuffixes are endings of East Slavic patronymics (e.g. Vladimirovich, Ivanovna)
+	patronymicSuffixes = []string{"ovich", "evich", "ovych", "evych", "ovna", "evna", "ivna", "ichna", "inichna"}
+
+	// cjkFamilyNames are common romanized Chinese and Korean family names. These names are
+	// usually written family name first.
+	cjkFamilyNames = []string{
+		"wang", "li", "zhang", "liu", "chen", "yang", "huang", "zhao", "wu", "zhou", "xu",
+		"zhu", "hu", "guo", "gao", "lin", "luo", "zheng", "liang", "xie", "han",
+		"feng", "deng", "cao", "peng", "zeng", "xiao", "tian", "yuan", "cai", "jiang",
+		"cheng", "wei", "lu", "ren", "shen", "yao", "xi", "jin",
+		"kim", "yi", "pak", "choi", "choe", "jeong", "chung", "kang", "cho",
+		"yoon", "yun", "jang", "lim", "ryu", "yoo", "hwang", "ahn", "ri",
+	}
+
+	// ambiguousCJKFamilyNames are romanized Chinese and Korean family names which are also common
+	// names or words elsewhere (e.g. "Lee", "Park", "Song"). They're only read first for names with a
+	// CJK signal (see ParsePersonName), which keeps names like "Park Rosa" and "Song Anne Miller"
+	// in given name first order.
+	ambiguousCJKFamilyNames = []string{
+		"lee", "an", "oh", "he", "ma", "su", "jo", "im", "yu",
+		"park", "song", "sun", "tang", "pan", "dong", "ding", "shin", "sin", "jung", "du", "ye",
+	}
+
+	// hispanicSurnames are common Spanish and Portuguese family names. Two of them in a row are
+	// treated as a double surname (e.g. "Garcia Marquez").
+	hispanicSurnames = []string{
+		"garcia", "rodriguez", "martinez", "hernandez", "lopez", "gonzalez", "perez", "sanchez", "ramirez",
+		"torres", "flores", "rivera", "gomez", "diaz", "reyes", "morales", "cruz", "ortiz", "gutierrez",
+		"chavez", "ramos", "ruiz", "alvarez", "mendoza", "castillo", "jimenez", "moreno", "romero",
+		"herrera", "medina", "aguilar", "vargas", "castro", "guzman", "fernandez", "munoz", "rojas",
+		"suarez", "vasquez", "vazquez", "marquez", "dominguez", "navarro", "blanco", "silva", "santos",
+		"pereira", "ferreira", "oliveira", "costa", "carvalho", "maduro", "moros", "cabello", "rondon",
+	}
+)
+
+// ParsePersonName splits a person's name into given, middle, patronymic and family names along
+// with any honorifics, particles and suffixes.
+//
+// Names are expected in given name first order unless they contain a comma ("Family, Given").
+// Chinese and Korean names with a known family name first, and East Slavic names ending in a
+// patronymic (e.g. "Ivanov Ivan Ivanovich") are read family name first. Set cjk when the name is
+// known to be Chinese or Korean (see HasCJKScript) so less distinctive family names such as "Lee"
+// or "Park" are also read first.
+func ParsePersonName(name string, cjk bool) PersonName {
+	var out PersonName
+
+	// "Family, Given" order
+	if family, given, found := strings.Cut(name, ","); found {
+		familyTokens, givenTokens := nameTokens(family), nameTokens(given)
+		if len(familyTokens) > 0 && len(givenTokens) > 0 {
+			givenTokens = out.takeHonorifics(givenTokens)
+			givenTokens = out.takeSuffixes(givenTokens)
+
+			out.setFamily(familyTokens)
+			out.setForenames(givenTokens)
+			return out
+		}
+	}
+
+	tokens := nameTokens(name)
+	tokens = out.takeHonorifics(tokens)
+	tokens = out.takeSuffixes(tokens)
+
+	switch {
+	case len(tokens) == 0:
+		return out
+
+	case len(tokens) == 1:
+		// Names written in Han or Hangul script start with the family name
+		if runes := []rune(tokens[0]); len(runes) >= 2 && isCJK(runes[0]) {
+			out.Family = []string{string(runes[0])}
+			out.Given = []string{string(runes[1:])}
+			return out
+		}
+		out.Given = tokens
+		return out
+
+	case familyNameFirst(tokens, cjk):
+		out.setFamily(tokens[:1])
+		out.setForenames(tokens[1:])
+		return out
+	}
+
+	start := familyNameStart(tokens)
+	out.setFamily(tokens[start:])
+	out.setForenames(tokens[:start])
+
+	return out
+}
+
+func nameTokens(s string) []string {
+	return strings.Fields(LowerAndRemovePunctuation(s))
+}
+
+func (n *PersonName) takeHonorifics(tokens []string) []string {
+	for len(tokens) > 1 && slices.Contains(nameHonorifics, tokens[0]) {
+		n.Honorifics = append(n.Honorifics, tokens[0])
+		tokens = tokens[1:]
+	}
+	return tokens
+}
+
+func (n *PersonName) takeSuffixes(tokens []string) []string {
+	for len(tokens) > 1 && slices.Contains(nameSuffixes, tokens[len(tokens)-1]) {
+		n.Suffixes = append([]string{tokens[len(tokens)-1]}, n.Suffixes...)
+		tokens = tokens[:len(tokens)-1]
+	}
+	return tokens
+}
+
+func (n *PersonName) setFamily(tokens []string) {
+	for _, t := range tokens {
+		if slices.Contains(nameParticles, t) || t == "y" {
+			n.Particles = append(n.Particles, t)
+		} else {
+			n.Family = append(n.Family, t)
+		}
+	}
+
+	// A family name made only of particles (e.g. "Le") is kept as the family name
+	if len(n.Family) == 0 {
+		n.Family, n.Particles = n.Particles, nil
+	}
+}
+
+func (n *PersonName) setForenames(tokens []string) {
+	for i := 0; i < len(tokens); i++ {
+		switch {
+		case i == 0:
+			n.Given = append(n.Given, tokens[i])
+
+		case slices.Contains(patronymicMarkers, tokens[i]) && i+1 < len(tokens):
+			n.Patronymic = append(n.Patronymic, tokens[i+1])
+			i++
+
+		case isPatronymic(tokens[i]):
+			n.Patronymic = append(n.Patronymic, tokens[i])
+
+		default:
+			n.Middle = append(n.Middle, tokens[i])
+		}
+	}
+}
+
+// familyNameFirst returns true for names which are commonly written family name first.
+func familyNameFirst(tokens []string, cjk bool) bool {
+	last := tokens[len(tokens)-1]
+
+	// East Slavic: "Ivanov Ivan Ivanovich"
+	if len(tokens) == 3 && isPatronymic(last) && !isPatronymic(tokens[1]) {
+		return true
+	}
+
+	// Chinese and Korean: "Kim Jong Un", "Xi Jinping"
+	if len(tokens) <= 3 && isCJKFamilyName(tokens[0], cjk) && !isCJKFamilyName(last, cjk) {
+		return true
+	}
+	return false
+}
+
+// familyNameStart returns the index of the first family name term for names written given name first.
+// At least one term is always left for the given name.
+func familyNameStart(tokens []string) int {
+	start := len(tokens) - 1
+	start = includeParticles(tokens, start)
+
+	// Spanish and Portuguese double surnames: "Garcia y Lopez", "Garcia Marquez"
+	if prev := start - 1; prev >= 1 {
+		switch {
+		case tokens[prev] == "y" && prev >= 2:
+			start = includeParticles(tokens, prev-1)
+
+		case slices.Contains(hispanicSurnames, tokens[prev]) && slices.Contains(hispanicSurnames, tokens[len(tokens)-1]):
+			start = includeParticles(tokens, prev)
+		}
+	}
+	return start
+}
+
+func includeParticles(tokens []string, start int) int {
+	for start > 1 && slices.Contains(nameParticles, tokens[start-1]) {
+		start--
+	}
+	return start
+}
+
+func isPatronymic(term string) bool {
+	if len(term) < 6 {
+		return false
+	}
+	for _, suffix := range patronymicSuffixes {
+		if strings.HasSuffix(term, suffix) {
+			return true
+		}
+	}
+	return false
+}
+
+func isCJKFamilyName(term string, cjk bool) bool {
+	return slices.Contains(cjkFamilyNames, term) || (cjk && slices.Contains(ambiguousCJKFamilyNames, term))
+}
+
+// HasCJKScript returns true when s contains Han or Hangul characters.
+func HasCJKScript(s string) bool {
+	return strings.IndexFunc(s, isCJK) >= 0
+}
+
+func isCJK(r rune) bool {
+	return unicode.Is(unicode.Han, r) || unicode.Is(unicode.Hangul, r)
+}
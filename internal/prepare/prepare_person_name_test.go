@@ -0,0 +1,177 @@
+package prepare_test
+
+import (
+	"testing"
+
+	"github.com/moov-io/watchman/internal/prepare"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParsePersonName(t *testing.T) {
+	cases := []struct {
+		input    string
+		expected prepare.PersonName
+	}{
+		{
+			input: "John Michael Smith",
+			expected: prepare.PersonName{
+				Given: []string{"john"}, Middle: []string{"michael"}, Family: []string{"smith"},
+			},
+		},
+		{
+			input: "Smith, John Michael",
+			expected: prepare.PersonName{
+				Given: []string{"john"}, Middle: []string{"michael"}, Family: []string{"smith"},
+			},
+		},
+		{
+			input: "Dr. Martin Luther King Jr.",
+			expected: prepare.PersonName{
+				Honorifics: []string{"dr"}, Given: []string{"martin"}, Middle: []string{"luther"}, Family: []string{"king"}, Suffixes: []string{"jr"},
+			},
+		},
+		{
+			input: "Ludwig van Beethoven",
+			expected: prepare.PersonName{
+				Given: []string{"ludwig"}, Particles: []string{"van"}, Family: []string{"beethoven"},
+			},
+		},
+		{
+			input: "Vincent van der Berg",
+			expected: prepare.PersonName{
+				Given: []string{"vincent"}, Particles: []string{"van", "der"}, Family: []string{"berg"},
+			},
+		},
+		{
+			input: "Maria de la Cruz",
+			expected: prepare.PersonName{
+				Given: []string{"maria"}, Particles: []string{"de", "la"}, Family: []string{"cruz"},
+			},
+		},
+		{
+			input: "Nicolas Maduro Moros",
+			expected: prepare.PersonName{
+				Given: []string{"nicolas"}, Family: []string{"maduro", "moros"},
+			},
+		},
+		{
+			input: "MADURO MOROS, Nicolas",
+			expected: prepare.PersonName{
+				Given: []string{"nicolas"}, Family: []string{"maduro", "moros"},
+			},
+		},
+		{
+			input: "Jose Garcia y Lopez",
+			expected: prepare.PersonName{
+				Given: []string{"jose"}, Particles: []string{"y"}, Family: []string{"garcia", "lopez"},
+			},
+		},
+		{
+			input: "Vladimir Vladimirovich Putin",
+			expected: prepare.PersonName{
+				Given: []string{"vladimir"}, Patronymic: []string{"vladimirovich"}, Family: []string{"putin"},
+			},
+		},
+		{
+			input: "Ivanov Ivan Ivanovich",
+			expected: prepare.PersonName{
+				Given: []string{"ivan"}, Patronymic: []string{"ivanovich"}, Family: []string{"ivanov"},
+			},
+		},
+		{
+			input: "Osama bin Mohammed bin Awad bin Laden",
+			expected: prepare.PersonName{
+				Given: []string{"osama"}, Patronymic: []string{"mohammed", "awad"}, Particles: []string{"bin"}, Family: []string{"laden"},
+			},
+		},
+		{
+			input: "Sheikh Ayman al-Zawahiri",
+			expected: prepare.PersonName{
+				Honorifics: []string{"sheikh"}, Given: []string{"ayman"}, Particles: []string{"al"}, Family: []string{"zawahiri"},
+			},
+		},
+		{
+			input: "Kim Jong Un",
+			expected: prepare.PersonName{
+				Given: []string{"jong"}, Middle: []string{"un"}, Family: []string{"kim"},
+			},
+		},
+		{
+			input: "Xi Jinping",
+			expected: prepare.PersonName{
+				Given: []string{"jinping"}, Family: []string{"xi"},
+			},
+		},
+		{
+			input: "习近平",
+			expected: prepare.PersonName{
+				Given: []string{"近平"}, Family: []string{"习"},
+			},
+		},
+		{
+			input: "Madonna",
+			expected: prepare.PersonName{
+				Given: []string{"madonna"},
+			},
+		},
+		{
+			input:    "",
+			expected: prepare.PersonName{},
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.input, func(t *testing.T) {
+			got := prepare.ParsePersonName(tc.input, false)
+			require.Equal(t, tc.expected, got)
+		})
+	}
+}
+
+func TestPersonName_Forenames(t *testing.T) {
+	name := prepare.ParsePersonName("Vladimir Vladimirovich Putin", false)
+	require.Equal(t, []string{"vladimir"}, name.Forenames())
+
+	name = prepare.ParsePersonName("John Michael Smith", false)
+	require.Equal(t, []string{"john", "michael"}, name.Forenames())
+	require.False(t, name.Empty())
+
+	require.True(t, prepare.ParsePersonName("", false).Empty())
+}
+
+func TestParsePersonName_AmbiguousCJK(t *testing.T) {
+	// "Lee" is only read as a family name first with a CJK signal
+	name := prepare.ParsePersonName("Lee Harvey Oswald", false)
+	require.Equal(t, []string{"lee"}, name.Given)
+	require.Equal(t, []string{"oswald"}, name.Family)
+
+	name = prepare.ParsePersonName("Lee Myung Bak", true)
+	require.Equal(t, []string{"myung"}, name.Given)
+	require.Equal(t, []string{"lee"}, name.Family)
+
+	name = prepare.ParsePersonName("An Thi Nguyen", false)
+	require.Equal(t, []string{"nguyen"}, name.Family)
+
+	// Family names which are also English names or words
+	for _, tc := range []struct {
+		name          string
+		given, family string
+	}{
+		{"Rosa Park", "rosa", "park"},
+		{"Park Rosa", "park", "rosa"},
+		{"Song Anne Miller", "song", "miller"},
+		{"Sun Young Lee", "sun", "lee"},
+	} {
+		name = prepare.ParsePersonName(tc.name, false)
+		require.Equal(t, []string{tc.given}, name.Given, tc.name)
+		require.Equal(t, []string{tc.family}, name.Family, tc.name)
+	}
+
+	name = prepare.ParsePersonName("Park Geun Hye", true)
+	require.Equal(t, []string{"park"}, name.Family)
+	require.Equal(t, []string{"geun"}, name.Given)
+
+	require.True(t, prepare.HasCJKScript("李明博"))
+	require.True(t, prepare.HasCJKScript("이명박"))
+	require.False(t, prepare.HasCJKScript("Lee Myung Bak"))
+}
@@ -63,13 +63,13 @@ func TestFastPathIsLanguageIndependent(t *testing.T) {
 
 	triggered := 0
 	for _, in := range inputs {
-		out, ok := trivialNormalization(in)
+		out, ok := trivialNormalization(currentAnalysis.Load(), in)
 		if !ok {
 			continue
 		}
 		triggered++
 		for _, lang := range languageSpread {
-			if got := removeStopwords(in, lang); got != out {
+			if got := removeStopwords(currentAnalysis.Load(), in, lang); got != out {
 				t.Errorf("fast path %q = %q, but cleaning with %s = %q", in, out, lang.Iso6391(), got)
 			}
 		}
@@ -97,7 +97,7 @@ func TestTrivialNormalizationGuards(t *testing.T) {
 		{"8th street", false, ""},      // "8th" is not a single clean segment
 	}
 	for _, tc := range cases {
-		got, ok := trivialNormalization(tc.in)
+		got, ok := trivialNormalization(currentAnalysis.Load(), tc.in)
 		if ok != tc.wantOK {
 			t.Errorf("trivialNormalization(%q) ok = %v, want %v", tc.in, ok, tc.wantOK)
 			continue
@@ -0,0 +1,104 @@
+package search
+
+import (
+	"fmt"
+	"net/http"
+	"runtime"
+
+	"github.com/moov-io/base/telemetry"
+	"github.com/moov-io/watchman/internal/api"
+	"github.com/moov-io/watchman/internal/payments"
+	"github.com/moov-io/watchman/pkg/search"
+
+	"go.opentelemetry.io/otel/attribute"
+	"golang.org/x/sync/errgroup"
+)
+
+var (
+	// maxACHFileSize is roughly 100k records
+	maxACHFileSize int64 = 10 * 1024 * 1024
+)
+
+func (c *controller) screenACH(w http.ResponseWriter, r *http.Request) {
+	ctx, span := telemetry.StartSpan(r.Context(), "api-screen-ach")
+	defer span.End()
+
+	screener, err := c.newPartyScreener(api.NewQueryParams(r.URL))
+	if err != nil {
+		err = c.logger.Error().LogErrorf("problem reading ACH screening request: %w", err).Err()
+		api.ErrorResponse(w, err)
+		return
+	}
+
+	if r.Body == nil {
+		api.ErrorResponse(w, fmt.Errorf("missing ACH file"))
+		return
+	}
+	defer r.Body.Close()
+
+	file, err := payments.ReadACH(http.MaxBytesReader(w, r.Body, maxACHFileSize))
+	if err != nil {
+		err = c.logger.Error().LogErrorf("problem reading ACH file: %w", err).Err()
+		api.ErrorResponse(w, err)
+		return
+	}
+
+	span.SetAttributes(
+		attribute.String("request_id", screener.opts.RequestID),
+		attribute.Int("entries", len(file.Entries)),
+	)
+
+	// Search each distinct party concurrently, the responses are read back from the screener below
+	g, gctx := errgroup.WithContext(ctx)
+	g.SetLimit(runtime.GOMAXPROCS(0))
+	seen := make(map[partyKey]bool)
+	for _, entry := range file.Entries {
+		for _, party := range entry.Parties {
+			if key := newPartyKey(party); !seen[key] {
+				seen[key] = true
+				g.Go(func() error {
+					if _, err := screener.screen(gctx, party); err != nil {
+						return fmt.Errorf("problem screening %s of ACH entry %s: %w", party.Role, entry.TraceNumber, err)
+					}
+					return nil
+				})
+			}
+		}
+	}
+	if err := g.Wait(); err != nil {
+		err = c.logger.Error().LogError(err).Err()
+		api.ErrorResponse(w, err)
+		return
+	}
+
+	resp := search.ACHScreeningResponse{
+		Decision: search.ScreeningClear,
+	}
+	for _, entry := range file.Entries {
+		screened := search.ScreenedACHEntry{
+			BatchNumber: entry.BatchNumber,
+			TraceNumber: entry.TraceNumber,
+			SECCode:     entry.SECCode,
+			Amount:      entry.Amount,
+			Decision:    search.ScreeningClear,
+		}
+		for _, party := range entry.Parties {
+			sp, err := screener.screen(ctx, party)
+			if err != nil {
+				err = c.logger.Error().LogErrorf("problem screening %s of ACH entry %s: %w", party.Role, entry.TraceNumber, err).Err()
+				api.ErrorResponse(w, err)
+				return
+			}
+			screened.Parties = append(screened.Parties, sp)
+			screened.Decision = mostSevereDecision(screened.Decision, sp.Decision)
+		}
+
+		resp.Entries = append(resp.Entries, screened)
+		resp.Decision = mostSevereDecision(resp.Decision, screened.Decision)
+	}
+
+	err = api.JsonResponse(w, resp)
+	if err != nil {
+		span.RecordError(err)
+	}
+}
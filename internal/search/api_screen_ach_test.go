@@ -0,0 +1,63 @@
+package search
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/moov-io/watchman/pkg/search"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestAPI_ScreenACH(t *testing.T) {
+	env := testAPI(t)
+
+	bs, err := os.ReadFile(filepath.Join("..", "payments", "testdata", "ach.txt"))
+	require.NoError(t, err)
+
+	req := httptest.NewRequest("POST", "/v2/screen/ach", strings.NewReader(string(bs)))
+	w := httptest.NewRecorder()
+	env.router.ServeHTTP(w, req)
+	require.Equal(t, http.StatusOK, w.Code)
+
+	var resp search.ACHScreeningResponse
+	require.NoError(t, json.NewDecoder(w.Body).Decode(&resp))
+	require.Equal(t, search.ScreeningReview, resp.Decision)
+	require.Len(t, resp.Entries, 3)
+
+	// Receiver is on the SDN list
+	entry := resp.Entries[0]
+	require.Equal(t, "1", entry.BatchNumber)
+	require.Equal(t, "121042880000001", entry.TraceNumber)
+	require.Equal(t, search.ScreeningReview, entry.Decision)
+	require.Equal(t, "receiver", entry.Parties[2].Role)
+	require.Equal(t, "15102", entry.Parties[2].Entities[0].SourceID)
+
+	require.Equal(t, search.ScreeningClear, resp.Entries[1].Decision)
+
+	// IAT parties in Iran and North Korea
+	entry = resp.Entries[2]
+	require.Equal(t, "IAT", entry.SECCode)
+	require.Equal(t, search.ScreeningReview, entry.Decision)
+	for _, party := range entry.Parties {
+		switch party.Role {
+		case "originator", "odfi":
+			require.Equal(t, search.ScreeningClear, party.Decision, party.Role)
+		default:
+			require.Equal(t, search.ScreeningReview, party.Decision, party.Role)
+			require.NotEmpty(t, party.Jurisdictions, party.Role)
+		}
+	}
+
+	t.Run("invalid", func(t *testing.T) {
+		req := httptest.NewRequest("POST", "/v2/screen/ach", strings.NewReader("not an ACH file"))
+		w := httptest.NewRecorder()
+		env.router.ServeHTTP(w, req)
+		require.Equal(t, http.StatusBadRequest, w.Code)
+	})
+}
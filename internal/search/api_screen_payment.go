@@ -0,0 +1,219 @@
+package search
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/moov-io/base/telemetry"
+	"github.com/moov-io/watchman/internal/api"
+	"github.com/moov-io/watchman/internal/payments"
+	"github.com/moov-io/watchman/pkg/search"
+
+	"go.opentelemetry.io/otel/attribute"
+)
+
+var (
+	// Parties with entities scoring at or above reviewMatch are reviewed, at or above blockMatch they are blocked.
+	// Most parties only have a name, which limits how high they can score.
+	defaultReviewMatch, defaultBlockMatch = 0.70, 0.95
+
+	maxPaymentMessageSize int64 = 1024 * 1024
+)
+
+func (c *controller) screenPayment(w http.ResponseWriter, r *http.Request) {
+	ctx, span := telemetry.StartSpan(r.Context(), "api-screen-payment")
+	defer span.End()
+
+	screener, err := c.newPartyScreener(api.NewQueryParams(r.URL))
+	if err != nil {
+		err = c.logger.Error().LogErrorf("problem reading payment screening request: %w", err).Err()
+		api.ErrorResponse(w, err)
+		return
+	}
+
+	if r.Body == nil {
+		api.ErrorResponse(w, fmt.Errorf("missing payment message"))
+		return
+	}
+	defer r.Body.Close()
+
+	msg, err := payments.Read(http.MaxBytesReader(w, r.Body, maxPaymentMessageSize))
+	if err != nil {
+		err = c.logger.Error().LogErrorf("problem reading payment message: %w", err).Err()
+		api.ErrorResponse(w, err)
+		return
+	}
+
+	span.SetAttributes(
+		attribute.String("request_id", screener.opts.RequestID),
+		attribute.String("format", msg.Format),
+		attribute.Int("parties", len(msg.Parties)),
+	)
+
+	resp := search.PaymentScreeningResponse{
+		Format:                msg.Format,
+		Reference:             msg.Reference,
+		Decision:              search.ScreeningClear,
+		RemittanceInformation: msg.RemittanceInformation,
+	}
+	for _, party := range msg.Parties {
+		screened, err := screener.screen(ctx, party)
+		if err != nil {
+			err = c.logger.Error().LogErrorf("problem screening %s of payment message: %w", party.Role, err).Err()
+			api.ErrorResponse(w, err)
+			return
+		}
+
+		resp.Parties = append(resp.Parties, screened)
+		resp.Decision = mostSevereDecision(resp.Decision, screened.Decision)
+	}
+
+	err = api.JsonResponse(w, resp)
+	if err != nil {
+		span.RecordError(err)
+	}
+}
+
+// partyScreener searches parties of a payment or ACH file. Parties repeated across
+// transactions or entries, like an ACH originator, are only searched once.
+// It's safe to screen parties from multiple goroutines.
+type partyScreener struct {
+	service    Service
+	opts       SearchOpts
+	blockMatch float64
+
+	mu       sync.Mutex
+	screened map[partyKey]search.ScreenedParty
+}
+
+type partyKey struct {
+	name, bic, country   string
+	address              search.Address
+	financialInstitution bool
+}
+
+func (c *controller) newPartyScreener(q *api.QueryParams) (*partyScreener, error) {
+	reviewMatch, err := extractScreeningMatch(q, "minMatch", defaultReviewMatch)
+	if err != nil {
+		return nil, err
+	}
+	blockMatch, err := extractScreeningMatch(q, "blockMatch", defaultBlockMatch)
+	if err != nil {
+		return nil, err
+	}
+
+	screener := &partyScreener{
+		service: c.service,
+		opts: SearchOpts{
+			Limit:     extractSearchLimit(q),
+			MinMatch:  reviewMatch,
+			RequestID: q.Get("requestID"),
+		},
+		blockMatch: blockMatch,
+		screened:   make(map[partyKey]search.ScreenedParty),
+	}
+
+	// Check we don't have extra query params
+	if extra := q.UnusedQueryParams(); len(extra) > 0 {
+		return nil, fmt.Errorf("extra/unused query parameters in request: %v", strings.Join(extra, ","))
+	}
+	return screener, nil
+}
+
+func newPartyKey(party payments.Party) partyKey {
+	key := partyKey{
+		name:                 party.Name,
+		bic:                  party.BIC,
+		country:              party.Country,
+		financialInstitution: party.FinancialInstitution,
+	}
+	if party.Address != nil {
+		key.address = *party.Address
+	}
+	return key
+}
+
+func (s *partyScreener) screen(ctx context.Context, party payments.Party) (search.ScreenedParty, error) {
+	key := newPartyKey(party)
+
+	s.mu.Lock()
+	out, found := s.screened[key]
+	s.mu.Unlock()
+
+	if !found {
+		var err error
+		out, err = s.search(ctx, party)
+		if err != nil {
+			return out, err
+		}
+		s.mu.Lock()
+		s.screened[key] = out
+		s.mu.Unlock()
+	}
+
+	out.Role = party.Role
+	out.Account = party.Account
+	return out, nil
+}
+
+func (s *partyScreener) search(ctx context.Context, party payments.Party) (search.ScreenedParty, error) {
+	out := search.ScreenedParty{
+		Name:                 party.Name,
+		BIC:                  party.BIC,
+		Address:              party.Address,
+		Country:              party.Country,
+		FinancialInstitution: party.FinancialInstitution,
+		Decision:             search.ScreeningClear,
+	}
+
+	query := party.Entity()
+
+	// Parties identified only by an account or location are only screened for their jurisdiction
+	if party.Name != "" || party.BIC != "" {
+		entities, err := s.service.Search(ctx, query, s.opts)
+		if err != nil {
+			return out, err
+		}
+		out.Entities = entities
+	}
+	out.Jurisdictions = s.service.ScreenJurisdictions(ctx, query)
+
+	if len(out.Entities) > 0 || len(out.Jurisdictions) > 0 {
+		out.Decision = search.ScreeningReview
+	}
+	for _, entity := range out.Entities {
+		if entity.Match >= s.blockMatch {
+			out.Decision = search.ScreeningBlock
+		}
+	}
+	return out, nil
+}
+
+func extractScreeningMatch(q *api.QueryParams, name string, fallback float64) (float64, error) {
+	v := q.Get(name)
+	if v == "" {
+		return fallback, nil
+	}
+	n, err := strconv.ParseFloat(v, 64)
+	if err != nil || n < 0 || n > 1 {
+		return 0, fmt.Errorf("invalid %s: %q", name, v)
+	}
+	return n, nil
+}
+
+var screeningDecisionSeverity = map[search.ScreeningDecision]int{
+	search.ScreeningClear:  0,
+	search.ScreeningReview: 1,
+	search.ScreeningBlock:  2,
+}
+
+func mostSevereDecision(a, b search.ScreeningDecision) search.ScreeningDecision {
+	if screeningDecisionSeverity[b] > screeningDecisionSeverity[a] {
+		return b
+	}
+	return a
+}
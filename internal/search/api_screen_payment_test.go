@@ -0,0 +1,113 @@
+package search
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/moov-io/watchman/pkg/search"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestAPI_ScreenPayment(t *testing.T) {
+	env := testAPI(t)
+
+	screen := func(t *testing.T, url, body string) (int, search.PaymentScreeningResponse) {
+		t.Helper()
+
+		req := httptest.NewRequest("POST", url, strings.NewReader(body))
+		w := httptest.NewRecorder()
+		env.router.ServeHTTP(w, req)
+
+		var resp search.PaymentScreeningResponse
+		if w.Code == http.StatusOK {
+			require.NoError(t, json.NewDecoder(w.Body).Decode(&resp))
+		}
+		return w.Code, resp
+	}
+
+	t.Run("MT103", func(t *testing.T) {
+		body := strings.Join([]string{
+			"{1:F01BANKUS33AXXX0000000000}{2:I103BANKDEFFXXXXN}{4:",
+			":20:REF-1",
+			":50K:/123456789",
+			"ACME TRADING CORP",
+			"500 MARKET STREET",
+			"SAN FRANCISCO US",
+			":57A:BANKDEFF",
+			":59:/DE89370400440532013000",
+			"TNK TRADING INTERNATIONAL S.A.",
+			":70:INVOICE 4711",
+			"-}",
+		}, "\r\n")
+
+		code, resp := screen(t, "/v2/screen/payment?blockMatch=0.80", body)
+		require.Equal(t, http.StatusOK, code)
+		require.Equal(t, "MT103", resp.Format)
+		require.Equal(t, "REF-1", resp.Reference)
+		require.Equal(t, search.ScreeningBlock, resp.Decision)
+
+		parties := make(map[string]search.ScreenedParty)
+		for _, p := range resp.Parties {
+			parties[p.Role] = p
+		}
+		require.Equal(t, search.ScreeningClear, parties["debtor"].Decision)
+		require.Equal(t, search.ScreeningClear, parties["creditorAgent"].Decision)
+
+		creditor := parties["creditor"]
+		require.Equal(t, search.ScreeningBlock, creditor.Decision)
+		require.NotEmpty(t, creditor.Entities)
+		require.Equal(t, "28603", creditor.Entities[0].SourceID)
+	})
+
+	t.Run("pacs.008 review", func(t *testing.T) {
+		bs, err := os.ReadFile(filepath.Join("..", "payments", "testdata", "pacs008.xml"))
+		require.NoError(t, err)
+
+		code, resp := screen(t, "/v2/screen/payment", string(bs))
+		require.Equal(t, http.StatusOK, code)
+		require.Equal(t, "pacs.008", resp.Format)
+		require.Equal(t, search.ScreeningReview, resp.Decision)
+
+		var creditor, intermediary search.ScreenedParty
+		for _, p := range resp.Parties {
+			switch p.Role {
+			case "creditor":
+				creditor = p
+			case "intermediaryAgent":
+				intermediary = p
+			}
+		}
+		require.Equal(t, search.ScreeningReview, creditor.Decision)
+		require.Equal(t, "IR", creditor.Jurisdictions[0].Country)
+		require.Equal(t, search.ScreeningReview, intermediary.Decision)
+		require.Equal(t, "KP", intermediary.Jurisdictions[0].Country)
+	})
+
+	t.Run("thresholds", func(t *testing.T) {
+		body := ":20:REF-2\n:59:TNK TRADING INTERNATIONAL S.A.\n"
+
+		// Name only matches are reviewed by default
+		_, resp := screen(t, "/v2/screen/payment", body)
+		require.Equal(t, search.ScreeningReview, resp.Decision)
+
+		_, resp = screen(t, "/v2/screen/payment?minMatch=0.9", body)
+		require.Equal(t, search.ScreeningClear, resp.Decision)
+
+		code, _ := screen(t, "/v2/screen/payment?blockMatch=2", body)
+		require.Equal(t, http.StatusBadRequest, code)
+
+		code, _ = screen(t, "/v2/screen/payment?name=foo", body)
+		require.Equal(t, http.StatusBadRequest, code)
+	})
+
+	t.Run("invalid message", func(t *testing.T) {
+		code, _ := screen(t, "/v2/screen/payment", "not a payment")
+		require.Equal(t, http.StatusBadRequest, code)
+	})
+}
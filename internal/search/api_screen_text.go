@@ -0,0 +1,78 @@
+package search
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/moov-io/base/telemetry"
+	"github.com/moov-io/watchman/internal/api"
+	"github.com/moov-io/watchman/pkg/search"
+
+	"go.opentelemetry.io/otel/attribute"
+)
+
+var (
+	// Spans of text are only compared by name, so matches need to be close to avoid
+	// flagging ordinary words which resemble a listed name.
+	defaultTextMinMatch = 0.90
+
+	maxScreenedTextSize int64 = 256 * 1024
+)
+
+func (c *controller) screenText(w http.ResponseWriter, r *http.Request) {
+	ctx, span := telemetry.StartSpan(r.Context(), "api-screen-text")
+	defer span.End()
+
+	q := api.NewQueryParams(r.URL)
+
+	minMatch, err := extractScreeningMatch(q, "minMatch", defaultTextMinMatch)
+	if err != nil {
+		api.ErrorResponse(w, err)
+		return
+	}
+	opts := SearchOpts{
+		Limit:     extractSearchLimit(q),
+		MinMatch:  minMatch,
+		RequestID: q.Get("requestID"),
+	}
+
+	// Check we don't have extra query params
+	if extra := q.UnusedQueryParams(); len(extra) > 0 {
+		api.ErrorResponse(w, fmt.Errorf("extra/unused query parameters in request: %v", strings.Join(extra, ",")))
+		return
+	}
+
+	if r.Body == nil {
+		api.ErrorResponse(w, fmt.Errorf("missing text"))
+		return
+	}
+	defer r.Body.Close()
+
+	bs, err := io.ReadAll(http.MaxBytesReader(w, r.Body, maxScreenedTextSize))
+	if err != nil {
+		err = c.logger.Error().LogErrorf("problem reading text: %w", err).Err()
+		api.ErrorResponse(w, err)
+		return
+	}
+
+	span.SetAttributes(
+		attribute.String("request_id", opts.RequestID),
+		attribute.Int("text.length", len(bs)),
+	)
+
+	matches, err := c.service.ScreenText(ctx, string(bs), opts)
+	if err != nil {
+		err = c.logger.Error().LogErrorf("problem screening text: %w", err).Err()
+		api.ErrorResponse(w, err)
+		return
+	}
+
+	err = api.JsonResponse(w, search.TextScreeningResponse{
+		Matches: matches,
+	})
+	if err != nil {
+		span.RecordError(err)
+	}
+}
@@ -0,0 +1,76 @@
+package search
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/moov-io/watchman/pkg/search"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestAPI_ScreenText(t *testing.T) {
+	env := testAPI(t)
+
+	screen := func(t *testing.T, url, body string) (int, search.TextScreeningResponse) {
+		t.Helper()
+
+		req := httptest.NewRequest("POST", url, strings.NewReader(body))
+		w := httptest.NewRecorder()
+		env.router.ServeHTTP(w, req)
+
+		var resp search.TextScreeningResponse
+		if w.Code == http.StatusOK {
+			require.NoError(t, json.NewDecoder(w.Body).Decode(&resp))
+		}
+		return w.Code, resp
+	}
+
+	t.Run("names", func(t *testing.T) {
+		text := "Payment to TNK Trading International S.A. for Daniel Moreno invoice 4711"
+
+		code, resp := screen(t, "/v2/screen/text", text)
+		require.Equal(t, http.StatusOK, code)
+		require.Len(t, resp.Matches, 2)
+
+		first := resp.Matches[0]
+		require.Equal(t, "TNK Trading International S.A.", first.Text)
+		require.Equal(t, 11, first.Start)
+		require.Equal(t, 41, first.End)
+		require.Equal(t, "28603", first.Entities[0].SourceID)
+		require.InDelta(t, 1.0, first.Entities[0].Match, 0.001)
+
+		second := resp.Matches[1]
+		require.Equal(t, "Daniel Moreno", second.Text)
+		require.Equal(t, second.Text, string([]rune(text)[second.Start:second.End]))
+		require.Equal(t, "15102", second.Entities[0].SourceID)
+	})
+
+	t.Run("offsets count characters", func(t *testing.T) {
+		code, resp := screen(t, "/v2/screen/text", "Zahlung über 500€ an MORENO, Daniel")
+		require.Equal(t, http.StatusOK, code)
+		require.Len(t, resp.Matches, 1)
+		require.Equal(t, "MORENO, Daniel", resp.Matches[0].Text)
+		require.Equal(t, 21, resp.Matches[0].Start)
+		require.Equal(t, 35, resp.Matches[0].End)
+	})
+
+	t.Run("ordinary words", func(t *testing.T) {
+		code, resp := screen(t, "/v2/screen/text", "Daniel sent the trading international charity invoice to the leader of the foundation")
+		require.Equal(t, http.StatusOK, code)
+		require.Empty(t, resp.Matches)
+	})
+
+	t.Run("minMatch", func(t *testing.T) {
+		code, _ := screen(t, "/v2/screen/text?minMatch=2", "TNK Trading")
+		require.Equal(t, http.StatusBadRequest, code)
+	})
+
+	t.Run("extra params", func(t *testing.T) {
+		code, _ := screen(t, "/v2/screen/text?foo=bar", "TNK Trading")
+		require.Equal(t, http.StatusBadRequest, code)
+	})
+}
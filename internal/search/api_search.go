@@ -5,6 +5,7 @@ import (
 	"context"
 	"fmt"
 	"net/http"
+	"slices"
 	"strconv"
 	"strings"
 	"time"
@@ -18,6 +19,7 @@ import (
 	"github.com/moov-io/watchman/internal/prepare"
 	"github.com/moov-io/watchman/pkg/address"
 	"github.com/moov-io/watchman/pkg/search"
+	"github.com/moov-io/watchman/pkg/sources/ftm"
 	"github.com/moov-io/watchman/pkg/sources/senzing"
 
 	"github.com/gorilla/mux"
@@ -28,11 +30,18 @@ type Controller interface {
 	AppendRoutes(router *mux.Router) *mux.Router
 }
 
-func NewController(logger log.Logger, service Service, addressParsingPool *postalpool.Service) Controller {
+// Geocoder adds coordinates to query addresses. This is used to decouple the search
+// package from the geocoding implementation.
+type Geocoder interface {
+	GeocodeQueryAddresses(ctx context.Context, addresses []search.Address) []search.Address
+}
+
+func NewController(logger log.Logger, service Service, addressParsingPool *postalpool.Service, geocoder Geocoder) Controller {
 	return &controller{
 		logger:             logger,
 		service:            service,
 		addressParsingPool: addressParsingPool,
+		geocoder:           geocoder,
 	}
 }
 
@@ -40,6 +49,7 @@ type controller struct {
 	logger             log.Logger
 	service            Service
 	addressParsingPool *postalpool.Service
+	geocoder           Geocoder
 }
 
 func (c *controller) AppendRoutes(router *mux.Router) *mux.Router {
@@ -55,6 +65,24 @@ func (c *controller) AppendRoutes(router *mux.Router) *mux.Router {
 		Path("/v2/listinfo").
 		HandlerFunc(c.listinfo)
 
+	router.
+		Name("ScreenPayment.v2").
+		Methods("POST").
+		Path("/v2/screen/payment").
+		HandlerFunc(c.screenPayment)
+
+	router.
+		Name("ScreenACH.v2").
+		Methods("POST").
+		Path("/v2/screen/ach").
+		HandlerFunc(c.screenACH)
+
+	router.
+		Name("ScreenText.v2").
+		Methods("POST").
+		Path("/v2/screen/text").
+		HandlerFunc(c.screenText)
+
 	return router
 }
 
@@ -71,16 +99,42 @@ func (c *controller) search(w http.ResponseWriter, r *http.Request) {
 	queryParams := api.NewQueryParams(r.URL)
 	debug := strx.Yes(queryParams.Get("debug"))
 
-	req, err := readSearchRequest(ctx, c.addressParsingPool, queryParams)
+	req, err := readSearchRequest(ctx, c.addressParsingPool, c.geocoder, queryParams)
 	if err != nil {
 		err = c.logger.Error().LogErrorf("problem reading v2 search request: %w", err).Err()
 		api.ErrorResponse(w, err)
 		return
 	}
 
+	near, err := readGeoQuery(queryParams, req)
+	if err != nil {
+		err = c.logger.Error().LogErrorf("problem reading v2 radius search: %w", err).Err()
+		api.ErrorResponse(w, err)
+		return
+	}
+
+	categories, err := readListCategories(queryParams)
+	if err != nil {
+		err = c.logger.Error().LogErrorf("problem reading v2 search categories: %w", err).Err()
+		api.ErrorResponse(w, err)
+		return
+	}
+
+	pepTenureYears, err := readPEPTenureYears(queryParams)
+	if err != nil {
+		err = c.logger.Error().LogErrorf("problem reading v2 search pepTenureYears: %w", err).Err()
+		api.ErrorResponse(w, err)
+		return
+	}
+
 	opts := SearchOpts{
 		Limit:          extractSearchLimit(queryParams),
 		MinMatch:       extractSearchMinMatch(queryParams),
+		Near:           near,
+		ExcludeExpired: strx.Yes(queryParams.Get("excludeExpired")),
+		Categories:     categories,
+		PEPTenureYears: pepTenureYears,
+		SubLists:       readSubLists(queryParams),
 		RequestID:      queryParams.Get("requestID"),
 		Debug:          debug,
 		DebugSourceIDs: strings.Split(queryParams.Get("debugSourceIDs"), ","),
@@ -111,11 +165,15 @@ func (c *controller) search(w http.ResponseWriter, r *http.Request) {
 	switch outputFormat {
 	case api.EntityWatchman:
 		err = api.JsonResponse(w, search.SearchResponse{
-			Query:    req,
-			Entities: entities,
+			Query:         req,
+			Entities:      entities,
+			Jurisdictions: c.service.ScreenJurisdictions(ctx, req),
 		})
 
 	case api.EntitySenzing:
+		// Senzing and FtM records have nowhere to put jurisdiction hits, so they're only
+		// returned in the Watchman format.
+
 		// TODO(adam): api.JsonResponse sets these headers
 		w.Header().Set("Access-Control-Allow-Origin", "*")
 		w.Header().Set("Content-Type", "application/x-ndjson")
@@ -124,6 +182,15 @@ func (c *controller) search(w http.ResponseWriter, r *http.Request) {
 			Format: subformat,
 		}
 		err = senzing.WriteSearchedEntities(w, entities, opts)
+
+	case api.EntityFTM:
+		w.Header().Set("Access-Control-Allow-Origin", "*")
+		w.Header().Set("Content-Type", ftm.ContentType(subformat))
+
+		opts := ftm.ExportOptions{
+			Format: subformat,
+		}
+		err = ftm.WriteSearchedEntities(w, entities, opts)
 	}
 	if err != nil {
 		err = c.logger.Error().LogErrorf("problem rendering search response into %v", outputFormat).Err()
@@ -163,7 +230,53 @@ func extractSearchMinMatch(q *api.QueryParams) float64 {
 	return 0.00
 }
 
-func readSearchRequest(ctx context.Context, addressParsingPool *postalpool.Service, q *api.QueryParams) (search.Entity[search.Value], error) {
+// readListCategories reads each category, which can be repeated or comma separated
+func readListCategories(q *api.QueryParams) ([]search.ListCategory, error) {
+	var out []search.ListCategory
+	for _, value := range q.GetAll("category") {
+		for _, v := range strings.Split(value, ",") {
+			category := search.ListCategory(strings.TrimSpace(strings.ToLower(v)))
+			switch category {
+			case "":
+				continue
+			case search.CategorySanctions, search.CategoryPEP, search.CategoryDebarment, search.CategoryExportControl:
+				if !slices.Contains(out, category) {
+					out = append(out, category)
+				}
+			default:
+				return nil, fmt.Errorf("unknown category %q", v)
+			}
+		}
+	}
+	return out, nil
+}
+
+// readSubLists reads each subList, which can be repeated or comma separated
+func readSubLists(q *api.QueryParams) []string {
+	var out []string
+	for _, value := range q.GetAll("subList") {
+		for _, v := range strings.Split(value, ",") {
+			if v = strings.TrimSpace(v); v != "" && !slices.Contains(out, v) {
+				out = append(out, v)
+			}
+		}
+	}
+	return out
+}
+
+func readPEPTenureYears(q *api.QueryParams) (int, error) {
+	v := q.Get("pepTenureYears")
+	if v == "" {
+		return 0, nil
+	}
+	n, err := strconv.Atoi(v)
+	if err != nil || n < 0 {
+		return 0, fmt.Errorf("invalid pepTenureYears %q", v)
+	}
+	return n, nil
+}
+
+func readSearchRequest(ctx context.Context, addressParsingPool *postalpool.Service, geocoder Geocoder, q *api.QueryParams) (search.Entity[search.Value], error) {
 	var err error
 	var req search.Entity[search.Value]
 
@@ -246,9 +359,13 @@ func readSearchRequest(ctx context.Context, addressParsingPool *postalpool.Servi
 	req.Contact.PhoneNumbers = readStrings(q.GetAll("phone"), q.GetAll("phoneNumber"), q.GetAll("phoneNumbers"))
 	req.Contact.FaxNumbers = readStrings(q.GetAll("fax"), q.GetAll("faxNumber"), q.GetAll("faxNumbers"))
 	req.Contact.Websites = readStrings(q.GetAll("website"), q.GetAll("websites"))
+	req.Contact.Country = strings.TrimSpace(q.Get("country"))
 
 	addresses := readStrings(q.GetAll("address"), q.GetAll("addresses"))
 	req.Addresses = readAddresses(ctx, addressParsingPool, addresses)
+	if geocoder != nil && len(req.Addresses) > 0 {
+		req.Addresses = geocoder.GeocodeQueryAddresses(ctx, req.Addresses)
+	}
 
 	cryptoAddresses := readStrings(q.GetAll("cryptoAddress"), q.GetAll("cryptoAddresses"))
 	req.CryptoAddresses = readCryptoCurrencyAddresses(cryptoAddresses)
@@ -261,6 +378,55 @@ func readSearchRequest(ctx context.Context, addressParsingPool *postalpool.Servi
 	return req.Normalize(), nil
 }
 
+// readGeoQuery reads the location of a radius search. The location is either set with lat and lon
+// or is the first geocoded address in the query. Nil is returned when no radius is given.
+func readGeoQuery(q *api.QueryParams, req search.Entity[search.Value]) (*GeoQuery, error) {
+	lat, lon := strings.TrimSpace(q.Get("lat")), strings.TrimSpace(q.Get("lon"))
+
+	radius := strings.TrimSpace(q.Get("radius"))
+	if radius == "" {
+		if lat != "" || lon != "" {
+			return nil, fmt.Errorf("radius is required with lat and lon")
+		}
+		return nil, nil
+	}
+
+	var err error
+	var out GeoQuery
+
+	out.RadiusKm, err = strconv.ParseFloat(radius, 64)
+	if err != nil || out.RadiusKm <= 0 {
+		return nil, fmt.Errorf("invalid radius %q", radius)
+	}
+
+	switch {
+	case lat != "" && lon != "":
+		out.Latitude, err = strconv.ParseFloat(lat, 64)
+		if err != nil || out.Latitude < -90 || out.Latitude > 90 {
+			return nil, fmt.Errorf("invalid lat %q", lat)
+		}
+		out.Longitude, err = strconv.ParseFloat(lon, 64)
+		if err != nil || out.Longitude < -180 || out.Longitude > 180 {
+			return nil, fmt.Errorf("invalid lon %q", lon)
+		}
+
+	case lat != "" || lon != "":
+		return nil, fmt.Errorf("both lat and lon are required")
+
+	default:
+		idx := slices.IndexFunc(req.Addresses, func(addr search.Address) bool {
+			return addr.Latitude != 0 || addr.Longitude != 0
+		})
+		if idx < 0 {
+			return nil, fmt.Errorf("radius search needs lat and lon or an address which can be geocoded")
+		}
+		out.Latitude = req.Addresses[idx].Latitude
+		out.Longitude = req.Addresses[idx].Longitude
+	}
+
+	return &out, nil
+}
+
 var (
 	allowedDateFormats = []string{"2006-01-02", "2006-01", "2006"}
 )
@@ -52,6 +52,7 @@ func testAPI(tb testing.TB) testSetup {
 	indexedLists := index.NewLists(nil) // only in-mem
 
 	searchConfig := DefaultConfig()
+	searchConfig.Jurisdictions.Enabled = true
 	service, err := NewService(logger, searchConfig, nil, indexedLists)
 	require.NoError(tb, err)
 
@@ -61,7 +62,7 @@ func testAPI(tb testing.TB) testSetup {
 
 	indexedLists.Update(stats)
 
-	controller := NewController(logger, service, nil)
+	controller := NewController(logger, service, nil, nil)
 
 	router := mux.NewRouter()
 	controller.AppendRoutes(router)
@@ -81,7 +82,7 @@ func TestAPI_readSearchRequest(t *testing.T) {
 		req := httptest.NewRequest("GET", "/v2/search?name=adam&type=person&birthDate=2025-01-02", nil)
 		q := &api.QueryParams{Values: req.URL.Query()}
 
-		query, err := readSearchRequest(ctx, nil, q)
+		query, err := readSearchRequest(ctx, nil, nil, q)
 		require.NoError(t, err)
 
 		require.Equal(t, "adam", query.Name)
@@ -96,7 +97,7 @@ func TestAPI_readSearchRequest(t *testing.T) {
 		req := httptest.NewRequest("GET", "/v2/search?name=adam", nil)
 		q := &api.QueryParams{Values: req.URL.Query()}
 
-		query, err := readSearchRequest(ctx, nil, q)
+		query, err := readSearchRequest(ctx, nil, nil, q)
 		require.NoError(t, err)
 
 		require.Equal(t, "adam", query.Name)
@@ -111,7 +112,7 @@ func TestAPI_readSearchRequest(t *testing.T) {
 		req := httptest.NewRequest("GET", address, nil)
 		q := &api.QueryParams{Values: req.URL.Query()}
 
-		query, err := readSearchRequest(ctx, nil, q)
+		query, err := readSearchRequest(ctx, nil, nil, q)
 		require.NoError(t, err)
 		require.Empty(t, query.Name)
 
@@ -127,11 +128,24 @@ func TestAPI_readSearchRequest(t *testing.T) {
 		require.ElementsMatch(t, expected.Websites, query.Contact.Websites)
 	})
 
+	t.Run("phone country", func(t *testing.T) {
+		req := httptest.NewRequest("GET", "/v2/search?type=business&phone=020+7946+0958&country=GB", nil)
+		q := &api.QueryParams{Values: req.URL.Query()}
+
+		query, err := readSearchRequest(ctx, nil, nil, q)
+		require.NoError(t, err)
+
+		require.Equal(t, "GB", query.Contact.Country)
+		require.Len(t, query.PreparedFields.Phones, 1)
+		require.Equal(t, "+442079460958", query.PreparedFields.Phones[0].E164)
+		require.Equal(t, "2079460958", query.PreparedFields.Phones[0].National)
+	})
+
 	t.Run("crypto addresses", func(t *testing.T) {
 		req := httptest.NewRequest("GET", "/v2/search?type=person&cryptoAddress=xbt:12345&cryptoAddress=eth:54321", nil)
 		q := &api.QueryParams{Values: req.URL.Query()}
 
-		query, err := readSearchRequest(ctx, nil, q)
+		query, err := readSearchRequest(ctx, nil, nil, q)
 		require.NoError(t, err)
 		require.Empty(t, query.Name)
 
@@ -148,7 +162,7 @@ func TestAPI_readSearchRequest(t *testing.T) {
 		req := httptest.NewRequest("GET", "/v2/search?type=person&name=Jane&address=123+Acme+St+Acmetown+KY+54321+US", nil)
 		q := &api.QueryParams{Values: req.URL.Query()}
 
-		query, err := readSearchRequest(ctx, nil, q)
+		query, err := readSearchRequest(ctx, nil, nil, q)
 		require.NoError(t, err)
 
 		require.Equal(t, "Jane", query.Name)
@@ -165,11 +179,24 @@ func TestAPI_readSearchRequest(t *testing.T) {
 		require.Equal(t, expected, query.Addresses[0])
 	})
 
+	t.Run("geocoded address", func(t *testing.T) {
+		req := httptest.NewRequest("GET", "/v2/search?type=business&name=Acme&address=123+Acme+St+Acmetown+KY+54321+US", nil)
+		q := &api.QueryParams{Values: req.URL.Query()}
+
+		geocoder := &mockGeocoder{latitude: 37.8, longitude: -84.3}
+		query, err := readSearchRequest(ctx, nil, geocoder, q)
+		require.NoError(t, err)
+
+		require.Len(t, query.Addresses, 1)
+		require.InDelta(t, 37.8, query.Addresses[0].Latitude, 0.001)
+		require.InDelta(t, -84.3, query.PreparedFields.Addresses[0].Longitude, 0.001)
+	})
+
 	t.Run("government id (US Passport)", func(t *testing.T) {
 		req := httptest.NewRequest("GET", "/v2/search?type=person&gov_passport=US:123456789", nil)
 		q := &api.QueryParams{Values: req.URL.Query()}
 
-		query, err := readSearchRequest(ctx, nil, q)
+		query, err := readSearchRequest(ctx, nil, nil, q)
 		require.NoError(t, err)
 		require.NotNil(t, query.Person)
 
@@ -185,6 +212,90 @@ func TestAPI_readSearchRequest(t *testing.T) {
 	})
 }
 
+func TestAPI_readGeoQuery(t *testing.T) {
+	read := func(rawQuery string, req search.Entity[search.Value]) (*GeoQuery, error) {
+		r := httptest.NewRequest("GET", "/v2/search?"+rawQuery, nil)
+		return readGeoQuery(&api.QueryParams{Values: r.URL.Query()}, req)
+	}
+
+	near, err := read("name=acme", search.Entity[search.Value]{})
+	require.NoError(t, err)
+	require.Nil(t, near)
+
+	near, err = read("lat=51.95&lon=4.1&radius=25", search.Entity[search.Value]{})
+	require.NoError(t, err)
+	require.Equal(t, &GeoQuery{Latitude: 51.95, Longitude: 4.1, RadiusKm: 25}, near)
+
+	// Geocoded address
+	req := search.Entity[search.Value]{
+		Addresses: []search.Address{
+			{Line1: "unknown"},
+			{City: "Rotterdam", Latitude: 51.92, Longitude: 4.48},
+		},
+	}
+	near, err = read("address=Rotterdam&radius=10", req)
+	require.NoError(t, err)
+	require.Equal(t, &GeoQuery{Latitude: 51.92, Longitude: 4.48, RadiusKm: 10}, near)
+
+	_, err = read("address=Rotterdam&radius=10", search.Entity[search.Value]{})
+	require.ErrorContains(t, err, "needs lat and lon or an address")
+
+	_, err = read("lat=51.95&lon=4.1", search.Entity[search.Value]{})
+	require.ErrorContains(t, err, "radius is required")
+
+	_, err = read("lat=51.95&radius=25", search.Entity[search.Value]{})
+	require.ErrorContains(t, err, "both lat and lon are required")
+
+	_, err = read("lat=95&lon=4.1&radius=25", search.Entity[search.Value]{})
+	require.ErrorContains(t, err, "invalid lat")
+
+	_, err = read("lat=51.95&lon=4.1&radius=-1", search.Entity[search.Value]{})
+	require.ErrorContains(t, err, "invalid radius")
+}
+
+func TestAPI_readListCategories(t *testing.T) {
+	read := func(rawQuery string) *api.QueryParams {
+		r := httptest.NewRequest("GET", "/v2/search?"+rawQuery, nil)
+		return &api.QueryParams{Values: r.URL.Query()}
+	}
+
+	categories, err := readListCategories(read("name=acme"))
+	require.NoError(t, err)
+	require.Empty(t, categories)
+
+	categories, err = readListCategories(read("category=PEP,sanctions&category=pep"))
+	require.NoError(t, err)
+	require.Equal(t, []search.ListCategory{search.CategoryPEP, search.CategorySanctions}, categories)
+
+	_, err = readListCategories(read("category=watchlist"))
+	require.ErrorContains(t, err, `unknown category "watchlist"`)
+
+	years, err := readPEPTenureYears(read("pepTenureYears=5"))
+	require.NoError(t, err)
+	require.Equal(t, 5, years)
+
+	_, err = readPEPTenureYears(read("pepTenureYears=-2"))
+	require.ErrorContains(t, err, "invalid pepTenureYears")
+}
+
+func TestAPI_readSubLists(t *testing.T) {
+	r := httptest.NewRequest("GET", "/v2/search?subList=EL,DPL&subList=UVL&subList=EL", nil)
+	subLists := readSubLists(&api.QueryParams{Values: r.URL.Query()})
+	require.Equal(t, []string{"EL", "DPL", "UVL"}, subLists)
+}
+
+type mockGeocoder struct {
+	latitude, longitude float64
+}
+
+func (g *mockGeocoder) GeocodeQueryAddresses(ctx context.Context, addresses []search.Address) []search.Address {
+	for idx := range addresses {
+		addresses[idx].Latitude = g.latitude
+		addresses[idx].Longitude = g.longitude
+	}
+	return addresses
+}
+
 func TestAPI_Search(t *testing.T) {
 	env := testAPI(t)
 
@@ -237,6 +348,29 @@ func TestAPI_Search(t *testing.T) {
 			fmt.Println(string(raw))
 		}
 	})
+
+	t.Run("jurisdictions", func(t *testing.T) {
+		req := httptest.NewRequest("GET", "/v2/search?name=Mohammad&type=person&limit=2&gov_passport=IR:A1234&phone=%2B53+7+832+1234", nil)
+
+		w := httptest.NewRecorder()
+		env.router.ServeHTTP(w, req)
+
+		require.Equal(t, http.StatusOK, w.Code)
+
+		var response search.SearchResponse
+		err := json.Unmarshal(w.Body.Bytes(), &response)
+		require.NoError(t, err)
+
+		var fields, countries []string
+		for _, hit := range response.Jurisdictions {
+			fields = append(fields, hit.Field)
+			countries = append(countries, hit.Country)
+		}
+		require.Contains(t, fields, "nationality")
+		require.Contains(t, fields, "phone")
+		require.Contains(t, countries, "IR")
+		require.Contains(t, countries, "CU")
+	})
 }
 
 func TestAPI_Senzing(t *testing.T) {
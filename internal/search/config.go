@@ -7,11 +7,13 @@ import (
 	"strconv"
 
 	"github.com/moov-io/watchman/internal/embeddings"
+	"github.com/moov-io/watchman/internal/jurisdictions"
 )
 
 type Config struct {
-	Goroutines Goroutines
-	Embeddings embeddings.Config
+	Goroutines    Goroutines
+	Embeddings    embeddings.Config
+	Jurisdictions jurisdictions.Config
 }
 
 type Goroutines struct {
@@ -34,6 +36,7 @@ func DefaultConfig() Config {
 			Min:     cpus,
 			Max:     cpus * 4,
 		},
-		Embeddings: embeddings.DefaultConfig(),
+		Embeddings:    embeddings.DefaultConfig(),
+		Jurisdictions: jurisdictions.DefaultConfig(),
 	}
 }
@@ -9,14 +9,17 @@ import (
 	"slices"
 	"strconv"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/moov-io/watchman/internal/concurrencychamp"
 	"github.com/moov-io/watchman/internal/db"
 	"github.com/moov-io/watchman/internal/download"
 	"github.com/moov-io/watchman/internal/embeddings"
+	"github.com/moov-io/watchman/internal/geocoding"
 	"github.com/moov-io/watchman/internal/index"
 	"github.com/moov-io/watchman/internal/indices"
+	"github.com/moov-io/watchman/internal/jurisdictions"
 	"github.com/moov-io/watchman/internal/largest"
 	"github.com/moov-io/watchman/internal/minmaxmed"
 	"github.com/moov-io/watchman/pkg/search"
@@ -36,6 +39,12 @@ type Service interface {
 
 	Search(ctx context.Context, query search.Entity[search.Value], opts SearchOpts) ([]search.SearchedEntity[search.Value], error)
 
+	// ScreenJurisdictions returns the query fields located in embargoed or sanctioned jurisdictions.
+	ScreenJurisdictions(ctx context.Context, query search.Entity[search.Value]) []search.JurisdictionHit
+
+	// ScreenText returns the spans of free text which match the names of entities.
+	ScreenText(ctx context.Context, text string, opts SearchOpts) ([]search.TextMatch, error)
+
 	// RebuildEmbeddingIndex rebuilds the embedding index from current entities.
 	// This should be called after the entity list has been updated.
 	RebuildEmbeddingIndex(ctx context.Context) error
@@ -56,12 +65,18 @@ func NewService(logger log.Logger, config Config, database db.DB, indexedLists i
 		}
 	}
 
+	screener, err := jurisdictions.NewScreener(config.Jurisdictions)
+	if err != nil {
+		return nil, fmt.Errorf("creating jurisdiction screener: %w", err)
+	}
+
 	return &service{
-		logger:       logger,
-		config:       config,
-		indexedLists: indexedLists,
-		cm:           cm,
-		embeddings:   embeddingsSvc,
+		logger:        logger,
+		config:        config,
+		indexedLists:  indexedLists,
+		cm:            cm,
+		embeddings:    embeddingsSvc,
+		jurisdictions: screener,
 	}, nil
 }
 
@@ -69,8 +84,13 @@ type service struct {
 	logger log.Logger
 	config Config
 
-	indexedLists index.Lists
-	embeddings   embeddings.Service
+	indexedLists  index.Lists
+	embeddings    embeddings.Service
+	jurisdictions *jurisdictions.Screener
+
+	// textIndex is built on the first text screening after each data refresh
+	textIndexMu sync.Mutex
+	textIndex   *textIndex
 
 	cm *concurrencychamp.ConcurrencyManager
 }
@@ -79,6 +99,23 @@ func (s *service) LatestStats() download.Stats {
 	return s.indexedLists.LatestStats()
 }
 
+func (s *service) ScreenJurisdictions(ctx context.Context, query search.Entity[search.Value]) []search.JurisdictionHit {
+	if s.jurisdictions == nil {
+		return nil
+	}
+
+	// Pick up FinCEN 311 jurisdictions after each data refresh
+	if refreshedAt := s.indexedLists.LatestStats().EndedAt; s.jurisdictions.NeedsFinCEN311(refreshedAt) {
+		entities, err := s.indexedLists.GetEntities(ctx, search.SourceUSFinCEN311)
+		if err != nil {
+			s.logger.Error().Logf("getting FinCEN 311 jurisdictions failed: %v", err)
+		}
+		s.jurisdictions.UpdateFinCEN311(refreshedAt, entities)
+	}
+
+	return s.jurisdictions.Screen(query)
+}
+
 func (s *service) Search(ctx context.Context, query search.Entity[search.Value], opts SearchOpts) ([]search.SearchedEntity[search.Value], error) {
 	ctx, span := telemetry.StartSpan(ctx, "search", trace.WithAttributes(
 		attribute.String("query.type", string(query.Type)),
@@ -91,7 +128,7 @@ func (s *service) Search(ctx context.Context, query search.Entity[search.Value],
 	defer span.End()
 
 	// Check if we should use embedding-based search for cross-script queries
-	if s.shouldUseEmbeddings(query.Name) {
+	if opts.Near == nil && s.shouldUseEmbeddings(query.Name) {
 		span.SetAttributes(attribute.Bool("search.use_embeddings", true))
 		out, err := s.performEmbeddingSearch(ctx, query, opts)
 		if err != nil {
@@ -102,6 +139,16 @@ func (s *service) Search(ctx context.Context, query search.Entity[search.Value],
 		}
 	}
 
+	// Radius searches look up entities from the spatial index
+	if opts.Near != nil {
+		out, err := s.performGeoSearch(ctx, query, opts)
+		if err != nil {
+			s.logger.Error().Logf("v2 geo search failed: %v", err)
+			return nil, fmt.Errorf("v2 geo search: %w", err)
+		}
+		return out, nil
+	}
+
 	span.SetAttributes(attribute.Bool("search.use_embeddings", false))
 	out, err := s.performSearch(ctx, query, opts)
 	if err != nil {
@@ -157,6 +204,7 @@ func (s *service) performEmbeddingSearch(ctx context.Context, query search.Entit
 	}
 
 	// Convert results to SearchedEntity
+	now := time.Now()
 	var out []search.SearchedEntity[search.Value]
 	for _, result := range results {
 		// Should we debug any specific entities
@@ -179,6 +227,9 @@ func (s *service) performEmbeddingSearch(ctx context.Context, query search.Entit
 		if query.Type != "" && query.Type != entity.Type {
 			continue
 		}
+		if opts.excluded(entity, now) {
+			continue
+		}
 
 		out = append(out, search.SearchedEntity[search.Value]{
 			Entity: entity,
@@ -199,11 +250,131 @@ type SearchOpts struct {
 	Limit    int
 	MinMatch float64
 
+	// Near limits results to entities with an address within the radius
+	Near *GeoQuery
+
+	// ExcludeExpired drops debarments which have ended from the results
+	ExcludeExpired bool
+
+	// Categories limits results to entities in any of the categories, e.g. PEPs or sanctions
+	Categories []search.ListCategory
+
+	// PEPTenureYears drops PEPs who left their last position more than this many years ago
+	PEPTenureYears int
+
+	// SubLists limits results to entities from these lists of a consolidated source, e.g. "EL" or "SSI"
+	SubLists []string
+
 	RequestID      string
 	Debug          bool
 	DebugSourceIDs []string
 }
 
+// excluded returns true when the options filter out an indexed entity
+func (opts SearchOpts) excluded(entity search.Entity[search.Value], now time.Time) bool {
+	if opts.ExcludeExpired && entity.Debarment.Expired(now) {
+		return true
+	}
+	if len(opts.Categories) > 0 && !entity.InCategory(opts.Categories...) {
+		return true
+	}
+	if len(opts.SubLists) > 0 && !slices.ContainsFunc(opts.SubLists, func(subList string) bool {
+		return strings.EqualFold(subList, entity.SubList)
+	}) {
+		return true
+	}
+	if opts.PEPTenureYears > 0 && entity.InCategory(search.CategoryPEP) {
+		ended := entity.Person.TenureEnded()
+		if ended != nil && ended.Before(now.AddDate(-opts.PEPTenureYears, 0, 0)) {
+			return true
+		}
+	}
+	return false
+}
+
+// GeoQuery is a location and the radius around it to search.
+type GeoQuery struct {
+	Latitude  float64
+	Longitude float64
+	RadiusKm  float64
+}
+
+// performGeoSearch returns entities with an address within opts.Near, closest first.
+//
+// Ingested entities aren't in the spatial index of downloaded lists, so searches of an ingested
+// source index its entities when searched. Ingested addresses need their own coordinates as
+// they aren't geocoded.
+//
+// When the query has a name each entity is also scored against the query and entities below
+// opts.MinMatch are dropped. Otherwise Match is how close the entity is, from 1.0 at the
+// location to 0.0 at the edge of the radius.
+func (s *service) performGeoSearch(ctx context.Context, query search.Entity[search.Value], opts SearchOpts) ([]search.SearchedEntity[search.Value], error) {
+	_, span := telemetry.StartSpan(ctx, "perform-geo-search", trace.WithAttributes(
+		attribute.Int("opts.limit", opts.Limit),
+		attribute.Float64("opts.radius_km", opts.Near.RadiusKm),
+	))
+	defer span.End()
+
+	geoIndex := s.indexedLists.GetGeoIndex()
+	if source := query.Source; source != "" && !source.IsRequestType() {
+		if _, downloaded := s.indexedLists.LatestStats().Lists[string(source)]; !downloaded {
+			entities, err := s.indexedLists.GetEntities(ctx, source)
+			if err != nil {
+				return nil, fmt.Errorf("getting %s entities: %w", source, err)
+			}
+			geoIndex = geocoding.NewIndex(entities)
+		}
+	}
+	if geoIndex == nil {
+		return nil, fmt.Errorf("geo index is not built")
+	}
+	tfidfIndex := s.indexedLists.GetTFIDFIndex()
+
+	nearby := geoIndex.Within(opts.Near.Latitude, opts.Near.Longitude, opts.Near.RadiusKm)
+
+	now := time.Now()
+	var out []search.SearchedEntity[search.Value]
+	for _, found := range nearby {
+		entity := found.Entity
+
+		if query.Type != "" && query.Type != entity.Type {
+			continue
+		}
+		if query.Source != "" && !query.Source.IsRequestType() && query.Source != entity.Source {
+			continue
+		}
+		if opts.excluded(entity, now) {
+			continue
+		}
+
+		match := 1.0 - found.DistanceKm/opts.Near.RadiusKm
+		if query.Name != "" {
+			match = search.SimilarityWithTFIDF(query, entity, tfidfIndex)
+			if match < opts.MinMatch {
+				continue
+			}
+		}
+
+		distance := found.DistanceKm
+		out = append(out, search.SearchedEntity[search.Value]{
+			Entity:     entity,
+			Match:      match,
+			DistanceKm: &distance,
+		})
+
+		if len(out) >= opts.Limit {
+			break
+		}
+	}
+
+	span.SetAttributes(
+		attribute.Int("index.nearby_entities", len(nearby)),
+		attribute.Int("results_count", len(out)),
+	)
+
+	return out, nil
+}
+
 type debugRespone struct {
 	scores search.SimilarityScore
 	buffer *bytes.Buffer
@@ -241,7 +412,12 @@ func (s *service) performSearch(ctx context.Context, query search.Entity[search.
 	// Get TF-IDF index for weighted name matching
 	tfidfIndex := s.indexedLists.GetTFIDFIndex()
 
+	now := time.Now()
 	indices.ProcessSliceFn(searchEntities, goroutineCount, func(index search.Entity[search.Value]) {
+		if opts.excluded(index, now) {
+			return
+		}
+
 		start := time.Now()
 
 		debugSourceID := slices.Contains(opts.DebugSourceIDs, index.SourceID)
@@ -7,10 +7,13 @@ import (
 	"os"
 	"path/filepath"
 	"testing"
+	"time"
 
 	"github.com/moov-io/watchman/internal/download"
 	"github.com/moov-io/watchman/internal/fshelp"
+	"github.com/moov-io/watchman/internal/geocoding"
 	"github.com/moov-io/watchman/internal/index"
+	"github.com/moov-io/watchman/internal/ingest"
 	"github.com/moov-io/watchman/pkg/search"
 	"github.com/moov-io/watchman/pkg/sources/ofac"
 
@@ -66,6 +69,250 @@ func TestService_Search(t *testing.T) {
 	})
 }
 
+func TestService_SearchNearby(t *testing.T) {
+	ctx := context.Background()
+
+	entities := []search.Entity[search.Value]{
+		{
+			Name: "Maasvlakte Shipping", Type: search.EntityBusiness, Source: search.SourceUSOFAC, SourceID: "1",
+			Addresses: []search.Address{{City: "Rotterdam", Latitude: 51.9490, Longitude: 4.1450}},
+		},
+		{
+			Name: "Rotterdam Trading", Type: search.EntityBusiness, Source: search.SourceEUCSL, SourceID: "2",
+			Addresses: []search.Address{{City: "Rotterdam", Latitude: 51.9225, Longitude: 4.4792}},
+		},
+		{
+			Name: "Jan de Vries", Type: search.EntityPerson, Source: search.SourceUSOFAC, SourceID: "3",
+			Addresses: []search.Address{{City: "Rotterdam", Latitude: 51.9200, Longitude: 4.4800}},
+		},
+		{
+			Name: "Antwerp Shipping", Type: search.EntityBusiness, Source: search.SourceUSOFAC, SourceID: "4",
+			Addresses: []search.Address{{City: "Antwerp", Latitude: 51.2194, Longitude: 4.4025}},
+		},
+	}
+	for idx := range entities {
+		entities[idx] = entities[idx].Normalize()
+	}
+
+	// Ingested entities are only in the repository
+	repo := &ingest.MockRepository{}
+	err := repo.Upsert(ctx, "vendors", []search.Entity[search.Value]{
+		{
+			Name: "Waalhaven Logistics", Type: search.EntityBusiness, Source: "vendors", SourceID: "5",
+			Addresses: []search.Address{{City: "Rotterdam", Latitude: 51.9000, Longitude: 4.4400}},
+		},
+	})
+	require.NoError(t, err)
+
+	indexedLists := index.NewLists(repo)
+	indexedLists.Update(download.Stats{
+		Lists:    map[string]int{"us_ofac": 3, "eu_csl": 1},
+		Entities: entities,
+		GeoIndex: geocoding.NewIndex(entities),
+	})
+	svc, err := NewService(log.NewTestLogger(), DefaultConfig(), nil, indexedLists)
+	require.NoError(t, err)
+
+	// Port of Rotterdam
+	near := &GeoQuery{Latitude: 51.9500, Longitude: 4.1000, RadiusKm: 30}
+
+	t.Run("all", func(t *testing.T) {
+		results, err := svc.Search(ctx, search.Entity[search.Value]{}, SearchOpts{Limit: 10, Near: near})
+		require.NoError(t, err)
+		require.Len(t, results, 3)
+
+		require.Equal(t, "1", results[0].SourceID)
+		require.InDelta(t, 3.09, *results[0].DistanceKm, 0.01)
+		require.InDelta(t, 0.897, results[0].Match, 0.001)
+		require.Equal(t, "2", results[1].SourceID)
+		require.Equal(t, "3", results[2].SourceID)
+	})
+
+	t.Run("filtered", func(t *testing.T) {
+		query := search.Entity[search.Value]{Type: search.EntityBusiness, Source: search.SourceEUCSL}
+		results, err := svc.Search(ctx, query, SearchOpts{Limit: 10, Near: near})
+		require.NoError(t, err)
+		require.Len(t, results, 1)
+		require.Equal(t, "2", results[0].SourceID)
+	})
+
+	t.Run("ingested", func(t *testing.T) {
+		query := search.Entity[search.Value]{Source: "vendors"}
+		results, err := svc.Search(ctx, query, SearchOpts{Limit: 10, Near: near})
+		require.NoError(t, err)
+		require.Len(t, results, 1)
+		require.Equal(t, "5", results[0].SourceID)
+	})
+
+	t.Run("name", func(t *testing.T) {
+		query := search.Entity[search.Value]{Name: "Maasvlakte Shipping", Type: search.EntityBusiness}
+		results, err := svc.Search(ctx, query.Normalize(), SearchOpts{Limit: 10, MinMatch: 0.75, Near: near})
+		require.NoError(t, err)
+		require.Len(t, results, 1)
+		require.Equal(t, "1", results[0].SourceID)
+		require.Greater(t, results[0].Match, 0.75)
+	})
+
+	t.Run("limit", func(t *testing.T) {
+		results, err := svc.Search(ctx, search.Entity[search.Value]{}, SearchOpts{Limit: 1, Near: near})
+		require.NoError(t, err)
+		require.Len(t, results, 1)
+		require.Equal(t, "1", results[0].SourceID)
+	})
+}
+
+func TestService_SearchExcludeExpired(t *testing.T) {
+	ctx := context.Background()
+
+	ended := time.Date(2020, time.March, 1, 0, 0, 0, 0, time.UTC)
+	entities := []search.Entity[search.Value]{
+		{
+			Name: "Acme Construction", Type: search.EntityBusiness, Source: search.SourceWorldBankDebarred, SourceID: "1",
+			Debarment: &search.Debarment{Institution: "World Bank", To: &ended},
+		},
+		{
+			Name: "Acme Construction Group", Type: search.EntityBusiness, Source: search.SourceWorldBankDebarred, SourceID: "2",
+			Debarment: &search.Debarment{Institution: "World Bank"},
+		},
+		{
+			Name: "Acme Construction Trading", Type: search.EntityBusiness, Source: search.SourceUSOFAC, SourceID: "3",
+		},
+	}
+	for idx := range entities {
+		entities[idx] = entities[idx].Normalize()
+	}
+
+	indexedLists := index.NewLists(nil)
+	indexedLists.Update(download.Stats{
+		Entities: entities,
+	})
+	svc, err := NewService(log.NewTestLogger(), DefaultConfig(), nil, indexedLists)
+	require.NoError(t, err)
+
+	query := search.Entity[search.Value]{Name: "Acme Construction", Type: search.EntityBusiness}.Normalize()
+
+	results, err := svc.Search(ctx, query, SearchOpts{Limit: 10, MinMatch: 0.5})
+	require.NoError(t, err)
+	require.Len(t, results, 3)
+
+	results, err = svc.Search(ctx, query, SearchOpts{Limit: 10, MinMatch: 0.5, ExcludeExpired: true})
+	require.NoError(t, err)
+	require.Len(t, results, 2)
+	for _, res := range results {
+		require.NotEqual(t, "1", res.SourceID)
+	}
+}
+
+func TestService_SearchCategories(t *testing.T) {
+	ctx := context.Background()
+
+	now := time.Now()
+	retired := now.AddDate(-8, 0, 0)
+	recent := now.AddDate(-1, 0, 0)
+	entities := []search.Entity[search.Value]{
+		{
+			Name: "John Albert Smith", Type: search.EntityPerson, Source: search.SourceUSOFAC, SourceID: "1",
+			Person:        &search.Person{Name: "John Albert Smith"},
+			SanctionsInfo: &search.SanctionsInfo{Programs: []string{"SDGT"}},
+		},
+		{
+			Name: "John Albert Smith", Type: search.EntityPerson, Source: "opensanctions_peps", SourceID: "2",
+			Person: &search.Person{
+				Name:      "John Albert Smith",
+				Positions: []search.Position{{Title: "Minister of Finance", End: &retired}},
+			},
+			Topics: []string{search.TopicPEP},
+		},
+		{
+			Name: "John Albert Smith", Type: search.EntityPerson, Source: "opensanctions_peps", SourceID: "3",
+			Person: &search.Person{
+				Name:      "John Albert Smith",
+				Positions: []search.Position{{Title: "Member of Parliament", End: &recent}},
+			},
+			Topics: []string{search.TopicPEP},
+		},
+	}
+	for idx := range entities {
+		entities[idx] = entities[idx].Normalize()
+	}
+
+	indexedLists := index.NewLists(nil)
+	indexedLists.Update(download.Stats{
+		Entities: entities,
+	})
+	svc, err := NewService(log.NewTestLogger(), DefaultConfig(), nil, indexedLists)
+	require.NoError(t, err)
+
+	query := search.Entity[search.Value]{
+		Name:   "John Albert Smith",
+		Type:   search.EntityPerson,
+		Person: &search.Person{Name: "John Albert Smith"},
+	}.Normalize()
+
+	sourceIDs := func(results []search.SearchedEntity[search.Value]) []string {
+		var out []string
+		for _, res := range results {
+			out = append(out, res.SourceID)
+		}
+		return out
+	}
+
+	results, err := svc.Search(ctx, query, SearchOpts{Limit: 10, MinMatch: 0.5})
+	require.NoError(t, err)
+	require.ElementsMatch(t, []string{"1", "2", "3"}, sourceIDs(results))
+
+	results, err = svc.Search(ctx, query, SearchOpts{Limit: 10, MinMatch: 0.5, Categories: []search.ListCategory{search.CategorySanctions}})
+	require.NoError(t, err)
+	require.Equal(t, []string{"1"}, sourceIDs(results))
+
+	results, err = svc.Search(ctx, query, SearchOpts{Limit: 10, MinMatch: 0.5, Categories: []search.ListCategory{search.CategoryPEP}})
+	require.NoError(t, err)
+	require.ElementsMatch(t, []string{"2", "3"}, sourceIDs(results))
+
+	results, err = svc.Search(ctx, query, SearchOpts{Limit: 10, MinMatch: 0.5, PEPTenureYears: 5})
+	require.NoError(t, err)
+	require.ElementsMatch(t, []string{"1", "3"}, sourceIDs(results))
+}
+
+func TestService_SearchSubLists(t *testing.T) {
+	ctx := context.Background()
+
+	entities := []search.Entity[search.Value]{
+		{Name: "Acme Electronics", Type: search.EntityBusiness, Source: search.SourceUSCSL, SubList: "EL", SourceID: "1"},
+		{Name: "Acme Electronics", Type: search.EntityBusiness, Source: search.SourceUSCSL, SubList: "MEU", SourceID: "2"},
+		{Name: "Acme Electronics", Type: search.EntityBusiness, Source: search.SourceUSNonSDN, SubList: "SSI", SourceID: "3"},
+		{Name: "Acme Electronics", Type: search.EntityBusiness, Source: search.SourceUSOFAC, SourceID: "4"},
+	}
+	for idx := range entities {
+		entities[idx] = entities[idx].Normalize()
+	}
+
+	indexedLists := index.NewLists(nil)
+	indexedLists.Update(download.Stats{
+		Entities: entities,
+	})
+	svc, err := NewService(log.NewTestLogger(), DefaultConfig(), nil, indexedLists)
+	require.NoError(t, err)
+
+	query := search.Entity[search.Value]{Name: "Acme Electronics", Type: search.EntityBusiness}.Normalize()
+
+	results, err := svc.Search(ctx, query, SearchOpts{Limit: 10, MinMatch: 0.5})
+	require.NoError(t, err)
+	require.Len(t, results, 4)
+
+	results, err = svc.Search(ctx, query, SearchOpts{Limit: 10, MinMatch: 0.5, SubLists: []string{"el", "MEU"}})
+	require.NoError(t, err)
+	require.Len(t, results, 2)
+	for _, res := range results {
+		require.Equal(t, search.SourceUSCSL, res.Source)
+	}
+
+	results, err = svc.Search(ctx, query, SearchOpts{Limit: 10, MinMatch: 0.5, SubLists: []string{"SSI"}})
+	require.NoError(t, err)
+	require.Len(t, results, 1)
+	require.Equal(t, "3", results[0].SourceID)
+}
+
 func testService(tb testing.TB) Service {
 	tb.Helper()
 
@@ -0,0 +1,387 @@
+package search
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+	"time"
+	"unicode"
+
+	"github.com/moov-io/watchman/internal/prepare"
+	"github.com/moov-io/watchman/pkg/search"
+
+	"github.com/moov-io/base/telemetry"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const (
+	// maxTextWindowWords is the longest run of words checked as a name
+	maxTextWindowWords = 6
+
+	// textPrefixLength is how many characters of each name term are indexed to find candidate entities
+	textPrefixLength = 3
+)
+
+// ScreenText finds names of indexed entities in free text. Every run of up to maxTextWindowWords words is
+// compared against the names of entities which share enough terms with it. Each entity is reported once,
+// on the span of text which matched it best.
+func (s *service) ScreenText(ctx context.Context, text string, opts SearchOpts) ([]search.TextMatch, error) {
+	ctx, span := telemetry.StartSpan(ctx, "screen-text", trace.WithAttributes(
+		attribute.String("request_id", opts.RequestID),
+		attribute.Int("text.length", len(text)),
+	))
+	defer span.End()
+
+	index, err := s.getTextIndex(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("building text index: %w", err)
+	}
+	tfidfIndex := s.indexedLists.GetTFIDFIndex()
+
+	tokens := tokenizeText(text)
+	windows := textWindows([]rune(text), tokens)
+
+	// Keep the best span of each entity
+	type hit struct {
+		window textWindow
+		terms  int
+		match  float64
+	}
+	best := make(map[int]hit)
+
+	for _, window := range windows {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+
+		candidate := search.Entity[search.Value]{Name: window.text}.Normalize()
+		terms := candidate.PreparedFields.NameFields
+		if len(terms) == 0 {
+			continue
+		}
+
+		// Words dropped from either end of the name, such as "for" in "for Daniel Moreno", aren't part of it.
+		// Legal forms are dropped as well, but they belong to the name.
+		first, last := textTerms(window.firstWord), textTerms(window.lastWord)
+		if len(first) == 0 || first[0] != terms[0] {
+			continue
+		}
+		if len(last) == 0 || last[len(last)-1] != terms[len(terms)-1] && !endsWithLegalForm(candidate.PreparedFields.Name) {
+			continue
+		}
+
+		for _, idx := range index.candidates(terms) {
+			match := search.NameSimilarity(candidate, index.entities[idx], tfidfIndex)
+			if match < opts.MinMatch {
+				continue
+			}
+			// A single word is too short to tell a misspelled name from another word
+			if len(terms) == 1 && match < 1.0 {
+				continue
+			}
+
+			// Prefer the span without extra terms, but with any trailing words like a legal form
+			found, exists := best[idx]
+			better := !exists || match > found.match
+			if exists && match == found.match {
+				better = len(terms) < found.terms || (len(terms) == found.terms && window.words > found.window.words)
+			}
+			if better {
+				best[idx] = hit{window: window, terms: len(terms), match: match}
+			}
+		}
+	}
+
+	// Group entities by the span they matched
+	type spanKey struct{ start, end int }
+	spans := make(map[spanKey]*search.TextMatch)
+	for idx, found := range best {
+		key := spanKey{start: found.window.start, end: found.window.end}
+		m, exists := spans[key]
+		if !exists {
+			m = &search.TextMatch{
+				Text:  found.window.text,
+				Start: found.window.start,
+				End:   found.window.end,
+			}
+			spans[key] = m
+		}
+		m.Entities = append(m.Entities, search.SearchedEntity[search.Value]{
+			Entity: index.entities[idx],
+			Match:  found.match,
+		})
+	}
+
+	keys := slices.Collect(maps.Keys(spans))
+
+	out := make([]search.TextMatch, 0, len(spans))
+	for key, m := range spans {
+		// Drop names found within a longer name, like "Daniel" in "Daniel Moreno"
+		nested := slices.ContainsFunc(keys, func(other spanKey) bool {
+			return other != key && other.start <= key.start && key.end <= other.end
+		})
+		if nested {
+			continue
+		}
+
+		slices.SortFunc(m.Entities, func(a, b search.SearchedEntity[search.Value]) int {
+			return cmp.Or(cmp.Compare(b.Match, a.Match), strings.Compare(a.SourceID, b.SourceID))
+		})
+		if opts.Limit > 0 && len(m.Entities) > opts.Limit {
+			m.Entities = m.Entities[:opts.Limit]
+		}
+		out = append(out, *m)
+	}
+	slices.SortFunc(out, func(a, b search.TextMatch) int {
+		return cmp.Or(cmp.Compare(a.Start, b.Start), cmp.Compare(a.End, b.End))
+	})
+
+	span.SetAttributes(
+		attribute.Int("text.tokens", len(tokens)),
+		attribute.Int("results_count", len(out)),
+	)
+
+	return out, nil
+}
+
+// textIndex maps the leading characters of name terms to the names which contain them,
+// so each window of text is only compared against a few entities.
+type textIndex struct {
+	refreshedAt time.Time
+
+	entities []search.Entity[search.Value]
+	names    []textIndexName
+
+	prefixes map[string][]int
+}
+
+type textIndexName struct {
+	entity int
+
+	// needed is how many terms of the name must be found in the text
+	needed int
+}
+
+func (s *service) getTextIndex(ctx context.Context) (*textIndex, error) {
+	s.textIndexMu.Lock()
+	defer s.textIndexMu.Unlock()
+
+	// Rebuild the index after each data refresh
+	refreshedAt := s.indexedLists.LatestStats().EndedAt
+	if s.textIndex != nil && s.textIndex.refreshedAt.Equal(refreshedAt) {
+		return s.textIndex, nil
+	}
+
+	entities, err := s.indexedLists.GetEntities(ctx, "")
+	if err != nil {
+		return nil, err
+	}
+
+	index := &textIndex{
+		refreshedAt: refreshedAt,
+		entities:    entities,
+		prefixes:    make(map[string][]int),
+	}
+	for idx, entity := range entities {
+		person := entity.Type == search.EntityPerson
+		index.add(idx, entity.PreparedFields.NameFields, person)
+		for _, fields := range entity.PreparedFields.AltNameFields {
+			index.add(idx, fields, person)
+		}
+	}
+	s.textIndex = index
+
+	return index, nil
+}
+
+func (idx *textIndex) add(entity int, terms []string, person bool) {
+	if len(terms) == 0 {
+		return
+	}
+
+	// Partial names are common words in text ("Daniel" or "Trading International"), so only
+	// long names and people, who are often written without middle names, may have terms missing.
+	needed := neededTerms(len(terms))
+	if !person && len(terms) <= 3 {
+		needed = len(terms)
+	}
+
+	name := len(idx.names)
+	idx.names = append(idx.names, textIndexName{entity: entity, needed: needed})
+
+	for _, prefix := range termPrefixes(terms) {
+		idx.prefixes[prefix] = append(idx.prefixes[prefix], name)
+	}
+}
+
+// candidates returns the entities with a name sharing most of its terms with the query terms.
+func (idx *textIndex) candidates(terms []string) []int {
+	prefixes := termPrefixes(terms)
+
+	shared := make(map[int]int)
+	for _, prefix := range prefixes {
+		for _, name := range idx.prefixes[prefix] {
+			shared[name]++
+		}
+	}
+
+	seen := make(map[int]bool)
+	var out []int
+	for name, count := range shared {
+		n := idx.names[name]
+		if count < n.needed || count < neededTerms(len(prefixes)) || seen[n.entity] {
+			continue
+		}
+		seen[n.entity] = true
+		out = append(out, n.entity)
+	}
+	return out
+}
+
+// neededTerms allows every third term of a name to be missing
+func neededTerms(terms int) int {
+	return terms - terms/3
+}
+
+// termPrefixes returns the distinct leading characters of each term
+func termPrefixes(terms []string) []string {
+	out := make([]string, 0, len(terms))
+	for _, term := range terms {
+		runes := []rune(term)
+		prefix := string(runes[:min(len(runes), textPrefixLength)])
+		if !slices.Contains(out, prefix) {
+			out = append(out, prefix)
+		}
+	}
+	return out
+}
+
+type textToken struct {
+	text       string
+	start, end int // rune offsets
+
+	// breakAfter is set when a window can't continue past the token, such as at a semicolon or newline
+	breakAfter bool
+}
+
+// textSeparators split words apart, besides whitespace
+const textSeparators = `,;:/()[]{}"|`
+
+// textBreaks end a window of words
+const textBreaks = ";()[]{}\"|\n\r"
+
+// tokenizeText splits text into words, recording where each word starts and ends in characters.
+// Punctuation around words is removed, except for the trailing period of abbreviations like "S.A.".
+func tokenizeText(text string) []textToken {
+	var out []textToken
+	var word []rune
+	start := 0
+
+	flush := func(end int) {
+		if len(word) == 0 {
+			return
+		}
+		first, last := 0, len(word)
+		for first < last && !isWordRune(word[first]) {
+			first++
+		}
+		for last > first && !isWordRune(word[last-1]) {
+			last--
+		}
+		// Keep the period ending an abbreviation
+		if last < len(word) && word[last] == '.' && slices.Contains(word[first:last], '.') {
+			last++
+		}
+		if first < last {
+			out = append(out, textToken{
+				text:  string(word[first:last]),
+				start: start + first,
+				end:   end - (len(word) - last),
+			})
+		}
+		word = word[:0]
+	}
+
+	pos := 0
+	for _, r := range text {
+		switch {
+		case unicode.IsSpace(r) || strings.ContainsRune(textSeparators, r):
+			flush(pos)
+			if strings.ContainsRune(textBreaks, r) && len(out) > 0 {
+				out[len(out)-1].breakAfter = true
+			}
+		default:
+			if len(word) == 0 {
+				start = pos
+			}
+			word = append(word, r)
+		}
+		pos++
+	}
+	flush(pos)
+
+	return out
+}
+
+func isWordRune(r rune) bool {
+	return unicode.IsLetter(r) || unicode.IsDigit(r)
+}
+
+type textWindow struct {
+	text       string
+	start, end int
+
+	words               int
+	firstWord, lastWord string
+}
+
+// endsWithLegalForm returns true when a normalized name ends with a legal form, such as "s a" in "acme s a".
+// This is checked even when legal form parsing is disabled for scoring.
+func endsWithLegalForm(name string) bool {
+	trimmed, form := prepare.RemoveLegalForms(name)
+	return !form.Empty() && !strings.HasSuffix(name, trimmed)
+}
+
+// textTerms normalizes a word of text the same way as names
+func textTerms(word string) []string {
+	return strings.Fields(prepare.ExpandTerms(prepare.LowerAndRemovePunctuation(word)))
+}
+
+// textWindows returns every run of up to maxTextWindowWords tokens which could be a name.
+// Runs starting or ending with a stopword or a number are skipped, their names are found
+// in the shorter runs within them.
+func textWindows(text []rune, tokens []textToken) []textWindow {
+	nameLike := make([]bool, len(tokens))
+	for i, token := range tokens {
+		nameLike[i] = slices.ContainsFunc([]rune(token.text), unicode.IsLetter) &&
+			prepare.RemoveStopwords(strings.ToLower(token.text)) != ""
+	}
+
+	var out []textWindow
+	for i := range tokens {
+		if !nameLike[i] {
+			continue
+		}
+		for j := i; j < len(tokens) && j-i < maxTextWindowWords; j++ {
+			if nameLike[j] {
+				out = append(out, textWindow{
+					text:  string(text[tokens[i].start:tokens[j].end]),
+					start: tokens[i].start,
+					end:   tokens[j].end,
+
+					words:     j - i + 1,
+					firstWord: tokens[i].text,
+					lastWord:  tokens[j].text,
+				})
+			}
+			if tokens[j].breakAfter {
+				break
+			}
+		}
+	}
+	return out
+}
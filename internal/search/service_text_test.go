@@ -0,0 +1,42 @@
+package search
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestTokenizeText(t *testing.T) {
+	tokens := tokenizeText(`Paid "TNK Trading S.A." (ref: 4711), Zoë Müller.`)
+
+	var words []string
+	for _, token := range tokens {
+		words = append(words, token.text)
+	}
+	require.Equal(t, []string{"Paid", "TNK", "Trading", "S.A.", "ref", "4711", "Zoë", "Müller"}, words)
+
+	// Offsets are counted in characters
+	require.Equal(t, 6, tokens[1].start)
+	require.Equal(t, 22, tokens[3].end)
+	require.Equal(t, 37, tokens[6].start)
+	require.Equal(t, 40, tokens[6].end)
+
+	// Quotes and brackets end a name
+	require.True(t, tokens[3].breakAfter)
+	require.False(t, tokens[4].breakAfter)
+}
+
+func TestTextWindows(t *testing.T) {
+	text := "Invoice for Daniel Moreno; ACME 2024"
+	windows := textWindows([]rune(text), tokenizeText(text))
+
+	var spans []string
+	for _, w := range windows {
+		require.Equal(t, w.text, string([]rune(text)[w.start:w.end]))
+		spans = append(spans, w.text)
+	}
+	require.Contains(t, spans, "Daniel Moreno")
+	require.Contains(t, spans, "Invoice for Daniel")
+	require.NotContains(t, spans, "Moreno; ACME")
+	require.NotContains(t, spans, "ACME 2024")
+}
@@ -0,0 +1,818 @@
+package stringscore
+
+import (
+	"strings"
+)
+
+const (
+	doubleMetaphoneMaxLength = 4
+)
+
+// PhoneticCode holds the primary and alternate Double Metaphone encodings of a token.
+// Alternate is often equal to Primary, it differs when a spelling has two plausible pronunciations.
+type PhoneticCode struct {
+	Primary   string
+	Alternate string
+}
+
+// Empty returns true when no phonetic code could be produced (e.g. the token had no letters).
+func (c PhoneticCode) Empty() bool {
+	return c.Primary == "" && c.Alternate == ""
+}
+
+// EncodeDoubleMetaphones returns the Double Metaphone codes of each token.
+func EncodeDoubleMetaphones(tokens []string) []PhoneticCode {
+	if len(tokens) == 0 {
+		return nil
+	}
+	out := make([]PhoneticCode, len(tokens))
+	for idx := range tokens {
+		out[idx] = EncodeDoubleMetaphone(tokens[idx])
+	}
+	return out
+}
+
+// EncodeDoubleMetaphone implements Lawrence Philips' Double Metaphone algorithm.
+//
+// Unlike Soundex the algorithm accounts for spelling conventions from several languages
+// (Germanic, Slavic, Romance, etc) and returns a second "alternate" code when a spelling
+// has an ambiguous pronunciation.
+//
+// Examples:
+//   - "Schmidt"  → XMT / SMT
+//   - "Shmit"    → XMT / XMT
+//   - "Smith"    → SM0 / XMT
+//   - "Jose"     → HS / HS
+//
+// See https://en.wikipedia.org/wiki/Metaphone#Double_Metaphone
+func EncodeDoubleMetaphone(s string) PhoneticCode {
+	value := []rune(strings.ToUpper(strings.TrimSpace(s)))
+	if len(value) == 0 {
+		return PhoneticCode{}
+	}
+
+	m := &doubleMetaphone{
+		value:         value,
+		last:          len(value) - 1,
+		slavoGermanic: isSlavoGermanic(string(value)),
+	}
+	m.encode()
+
+	return PhoneticCode{
+		Primary:   m.primary.String(),
+		Alternate: m.alternate.String(),
+	}
+}
+
+type doubleMetaphone struct {
+	value []rune
+	last  int
+
+	slavoGermanic bool
+
+	primary   strings.Builder
+	alternate strings.Builder
+}
+
+func isSlavoGermanic(value string) bool {
+	return strings.ContainsRune(value, 'W') || strings.ContainsRune(value, 'K') ||
+		strings.Contains(value, "CZ") || strings.Contains(value, "WITZ")
+}
+
+func isDoubleMetaphoneVowel(r rune) bool {
+	switch r {
+	case 'A', 'E', 'I', 'O', 'U', 'Y':
+		return true
+	}
+	return false
+}
+
+// charAt returns the rune at idx or zero when idx is out of bounds.
+func (m *doubleMetaphone) charAt(idx int) rune {
+	if idx < 0 || idx > m.last {
+		return 0
+	}
+	return m.value[idx]
+}
+
+// contains reports if the substring of length n starting at start equals any of the options.
+func (m *doubleMetaphone) contains(start, n int, options ...string) bool {
+	if start < 0 || start+n > len(m.value) {
+		return false
+	}
+	sub := string(m.value[start : start+n])
+	for _, opt := range options {
+		if sub == opt {
+			return true
+		}
+	}
+	return false
+}
+
+func (m *doubleMetaphone) complete() bool {
+	return m.primary.Len() >= doubleMetaphoneMaxLength && m.alternate.Len() >= doubleMetaphoneMaxLength
+}
+
+func (m *doubleMetaphone) add(main string) {
+	m.addBoth(main, main)
+}
+
+func (m *doubleMetaphone) addBoth(main, alt string) {
+	m.addPrimary(main)
+	m.addAlternate(alt)
+}
+
+func (m *doubleMetaphone) addPrimary(main string) {
+	appendLimited(&m.primary, main)
+}
+
+func (m *doubleMetaphone) addAlternate(alt string) {
+	appendLimited(&m.alternate, alt)
+}
+
+func appendLimited(b *strings.Builder, code string) {
+	remaining := doubleMetaphoneMaxLength - b.Len()
+	if remaining <= 0 {
+		return
+	}
+	if len(code) > remaining {
+		code = code[:remaining]
+	}
+	b.WriteString(code)
+}
+
+func (m *doubleMetaphone) encode() {
+	idx := 0
+
+	// Skip silent letters when the word starts with them
+	if m.contains(0, 2, "GN", "KN", "PN", "WR", "PS") {
+		idx = 1
+	}
+
+	for !m.complete() && idx <= m.last {
+		r := m.charAt(idx)
+		switch r {
+		case 'A', 'E', 'I', 'O', 'U', 'Y':
+			// Only initial vowels are encoded
+			if idx == 0 {
+				m.add("A")
+			}
+			idx++
+
+		case 'B':
+			m.add("P")
+			idx = m.skipDouble(idx, 'B')
+
+		case 'Ç':
+			m.add("S")
+			idx++
+
+		case 'C':
+			idx = m.handleC(idx)
+
+		case 'D':
+			idx = m.handleD(idx)
+
+		case 'F':
+			m.add("F")
+			idx = m.skipDouble(idx, 'F')
+
+		case 'G':
+			idx = m.handleG(idx)
+
+		case 'H':
+			idx = m.handleH(idx)
+
+		case 'J':
+			idx = m.handleJ(idx)
+
+		case 'K':
+			m.add("K")
+			idx = m.skipDouble(idx, 'K')
+
+		case 'L':
+			idx = m.handleL(idx)
+
+		case 'M':
+			m.add("M")
+			if m.conditionM0(idx) {
+				idx += 2
+			} else {
+				idx++
+			}
+
+		case 'N':
+			m.add("N")
+			idx = m.skipDouble(idx, 'N')
+
+		case 'Ñ':
+			m.add("N")
+			idx++
+
+		case 'P':
+			idx = m.handleP(idx)
+
+		case 'Q':
+			m.add("K")
+			idx = m.skipDouble(idx, 'Q')
+
+		case 'R':
+			idx = m.handleR(idx)
+
+		case 'S':
+			idx = m.handleS(idx)
+
+		case 'T':
+			idx = m.handleT(idx)
+
+		case 'V':
+			m.add("F")
+			idx = m.skipDouble(idx, 'V')
+
+		case 'W':
+			idx = m.handleW(idx)
+
+		case 'X':
+			idx = m.handleX(idx)
+
+		case 'Z':
+			idx = m.handleZ(idx)
+
+		default:
+			// Digits, punctuation, and letters from other scripts are skipped
+			idx++
+		}
+	}
+}
+
+func (m *doubleMetaphone) skipDouble(idx int, r rune) int {
+	if m.charAt(idx+1) == r {
+		return idx + 2
+	}
+	return idx + 1
+}
+
+func (m *doubleMetaphone) handleC(idx int) int {
+	switch {
+	case m.conditionC0(idx):
+		// Various Germanic: "BACHER", "MACHER"
+		m.add("K")
+		return idx + 2
+
+	case idx == 0 && m.contains(idx, 6, "CAESAR"):
+		m.add("S")
+		return idx + 2
+
+	case m.contains(idx, 2, "CH"):
+		return m.handleCH(idx)
+
+	case m.contains(idx, 2, "CZ") && !m.contains(idx-2, 4, "WICZ"):
+		// "Czerny"
+		m.addBoth("S", "X")
+		return idx + 2
+
+	case m.contains(idx+1, 3, "CIA"):
+		// "Focaccia"
+		m.add("X")
+		return idx + 3
+
+	case m.contains(idx, 2, "CC") && !(idx == 1 && m.charAt(0) == 'M'):
+		// Double "C", but not if e.g. "McClellan"
+		return m.handleCC(idx)
+
+	case m.contains(idx, 2, "CK", "CG", "CQ"):
+		m.add("K")
+		return idx + 2
+
+	case m.contains(idx, 2, "CI", "CE", "CY"):
+		// Italian vs English
+		if m.contains(idx, 3, "CIO", "CIE", "CIA") {
+			m.addBoth("S", "X")
+		} else {
+			m.add("S")
+		}
+		return idx + 2
+	}
+
+	m.add("K")
+	switch {
+	case m.contains(idx+1, 2, " C", " Q", " G"):
+		// "Mac Caffrey", "Mac Gregor"
+		return idx + 3
+	case m.contains(idx+1, 1, "C", "K", "Q") && !m.contains(idx+1, 2, "CE", "CI"):
+		return idx + 2
+	}
+	return idx + 1
+}
+
+func (m *doubleMetaphone) handleCC(idx int) int {
+	if m.contains(idx+2, 1, "I", "E", "H") && !m.contains(idx+2, 2, "HU") {
+		// "Bellocchio" but not "Bacchus"
+		if (idx == 1 && m.charAt(idx-1) == 'A') || m.contains(idx-1, 5, "UCCEE", "UCCES") {
+			// "Accident", "Accede", "Succeed"
+			m.add("KS")
+		} else {
+			// "Bacci", "Bertucci", other Italian
+			m.add("X")
+		}
+		return idx + 3
+	}
+	// Pierce's rule
+	m.add("K")
+	return idx + 2
+}
+
+func (m *doubleMetaphone) handleCH(idx int) int {
+	switch {
+	case idx > 0 && m.contains(idx, 4, "CHAE"):
+		// "Michael"
+		m.addBoth("K", "X")
+		return idx + 2
+
+	case m.conditionCH0(idx):
+		// Greek roots: "Chemistry", "Chorus"
+		m.add("K")
+		return idx + 2
+
+	case m.conditionCH1(idx):
+		// Germanic, Greek, or otherwise "CH" for "KH" sound
+		m.add("K")
+		return idx + 2
+	}
+
+	if idx > 0 {
+		if m.contains(0, 2, "MC") {
+			m.add("K")
+		} else {
+			m.addBoth("X", "K")
+		}
+	} else {
+		m.add("X")
+	}
+	return idx + 2
+}
+
+func (m *doubleMetaphone) conditionC0(idx int) bool {
+	if m.contains(idx, 4, "CHIA") {
+		return true
+	}
+	if idx <= 1 {
+		return false
+	}
+	if isDoubleMetaphoneVowel(m.charAt(idx - 2)) {
+		return false
+	}
+	if !m.contains(idx-1, 3, "ACH") {
+		return false
+	}
+	c := m.charAt(idx + 2)
+	return (c != 'I' && c != 'E') || m.contains(idx-2, 6, "BACHER", "MACHER")
+}
+
+func (m *doubleMetaphone) conditionCH0(idx int) bool {
+	if idx != 0 {
+		return false
+	}
+	if !m.contains(idx+1, 5, "HARAC", "HARIS") && !m.contains(idx+1, 3, "HOR", "HYM", "HIA", "HEM") {
+		return false
+	}
+	return !m.contains(0, 5, "CHORE")
+}
+
+func (m *doubleMetaphone) conditionCH1(idx int) bool {
+	return m.contains(0, 4, "VAN ", "VON ") || m.contains(0, 3, "SCH") ||
+		m.contains(idx-2, 6, "ORCHES", "ARCHIT", "ORCHID") ||
+		m.contains(idx+2, 1, "T", "S") ||
+		((m.contains(idx-1, 1, "A", "O", "U", "E") || idx == 0) &&
+			(m.contains(idx+2, 1, "L", "R", "N", "M", "B", "H", "F", "V", "W", " ") || idx+1 == m.last))
+}
+
+func (m *doubleMetaphone) handleD(idx int) int {
+	switch {
+	case m.contains(idx, 2, "DG"):
+		if m.contains(idx+2, 1, "I", "E", "Y") {
+			// "Edge"
+			m.add("J")
+			return idx + 3
+		}
+		// "Edgar"
+		m.add("TK")
+		return idx + 2
+
+	case m.contains(idx, 2, "DT", "DD"):
+		m.add("T")
+		return idx + 2
+	}
+
+	m.add("T")
+	return idx + 1
+}
+
+func (m *doubleMetaphone) handleG(idx int) int {
+	next := m.charAt(idx + 1)
+
+	switch {
+	case next == 'H':
+		return m.handleGH(idx)
+
+	case next == 'N':
+		switch {
+		case idx == 1 && isDoubleMetaphoneVowel(m.charAt(0)) && !m.slavoGermanic:
+			m.addBoth("KN", "N")
+		case !m.contains(idx+2, 2, "EY") && m.charAt(idx+1) != 'Y' && !m.slavoGermanic:
+			// Not e.g. "Cagney"
+			m.addBoth("N", "KN")
+		default:
+			m.add("KN")
+		}
+		return idx + 2
+
+	case m.contains(idx+1, 2, "LI") && !m.slavoGermanic:
+		// "Tagliaro"
+		m.addBoth("KL", "L")
+		return idx + 2
+
+	case idx == 0 && (next == 'Y' || m.contains(idx+1, 2, "ES", "EP", "EB", "EL", "EY", "IB", "IL", "IN", "IE", "EI", "ER")):
+		// -ges-, -gep-, -gel-, -gie- at the beginning
+		m.addBoth("K", "J")
+		return idx + 2
+
+	case (m.contains(idx+1, 2, "ER") || next == 'Y') &&
+		!m.contains(0, 6, "DANGER", "RANGER", "MANGER") &&
+		!m.contains(idx-1, 1, "E", "I") &&
+		!m.contains(idx-1, 3, "RGY", "OGY"):
+		// -ger-, -gy-
+		m.addBoth("K", "J")
+		return idx + 2
+
+	case m.contains(idx+1, 1, "E", "I", "Y") || m.contains(idx-1, 4, "AGGI", "OGGI"):
+		// Italian "Biaggi"
+		switch {
+		case m.contains(0, 4, "VAN ", "VON ") || m.contains(0, 3, "SCH") || m.contains(idx+1, 2, "ET"):
+			// Obvious Germanic
+			m.add("K")
+		case m.contains(idx+1, 3, "IER"):
+			m.add("J")
+		default:
+			m.addBoth("J", "K")
+		}
+		return idx + 2
+
+	case next == 'G':
+		m.add("K")
+		return idx + 2
+	}
+
+	m.add("K")
+	return idx + 1
+}
+
+func (m *doubleMetaphone) handleGH(idx int) int {
+	switch {
+	case idx > 0 && !isDoubleMetaphoneVowel(m.charAt(idx-1)):
+		m.add("K")
+		return idx + 2
+
+	case idx == 0:
+		// "Ghislane", "Ghiradelli"
+		if m.charAt(idx+2) == 'I' {
+			m.add("J")
+		} else {
+			m.add("K")
+		}
+		return idx + 2
+
+	case (idx > 1 && m.contains(idx-2, 1, "B", "H", "D")) ||
+		(idx > 2 && m.contains(idx-3, 1, "B", "H", "D")) ||
+		(idx > 3 && m.contains(idx-4, 1, "B", "H")):
+		// Parker's rule (with some further refinements) - "Hugh", "Bough", "Broughton"
+		return idx + 2
+	}
+
+	if idx > 2 && m.charAt(idx-1) == 'U' && m.contains(idx-3, 1, "C", "G", "L", "R", "T") {
+		// "Laugh", "McLaughlin", "Cough", "Gough", "Rough", "Tough"
+		m.add("F")
+	} else if idx > 0 && m.charAt(idx-1) != 'I' {
+		m.add("K")
+	}
+	return idx + 2
+}
+
+func (m *doubleMetaphone) handleH(idx int) int {
+	// Only keep H when it's followed by a vowel and starts the word or follows a vowel
+	if (idx == 0 || isDoubleMetaphoneVowel(m.charAt(idx-1))) && isDoubleMetaphoneVowel(m.charAt(idx+1)) {
+		m.add("H")
+		return idx + 2
+	}
+	return idx + 1
+}
+
+func (m *doubleMetaphone) handleJ(idx int) int {
+	if m.contains(idx, 4, "JOSE") || m.contains(0, 4, "SAN ") {
+		// Obvious Spanish: "Jose", "San Jacinto"
+		if (idx == 0 && m.charAt(idx+4) == ' ') || len(m.value) == 4 || m.contains(0, 4, "SAN ") {
+			m.add("H")
+		} else {
+			m.addBoth("J", "H")
+		}
+		return idx + 1
+	}
+
+	switch {
+	case idx == 0 && !m.contains(idx, 4, "JOSE"):
+		// "Yankelovich" / "Jankelowicz"
+		m.addBoth("J", "A")
+
+	case isDoubleMetaphoneVowel(m.charAt(idx-1)) && !m.slavoGermanic && (m.charAt(idx+1) == 'A' || m.charAt(idx+1) == 'O'):
+		// Spanish pronunciation of e.g. "Bajador"
+		m.addBoth("J", "H")
+
+	case idx == m.last:
+		m.addBoth("J", "")
+
+	case !m.contains(idx+1, 1, "L", "T", "K", "S", "N", "M", "B", "Z") && !m.contains(idx-1, 1, "S", "K", "L"):
+		m.add("J")
+	}
+
+	return m.skipDouble(idx, 'J')
+}
+
+func (m *doubleMetaphone) handleL(idx int) int {
+	if m.charAt(idx+1) == 'L' {
+		if m.conditionL0(idx) {
+			// Spanish e.g. "Cabrillo", "Gallegos"
+			m.addPrimary("L")
+		} else {
+			m.add("L")
+		}
+		return idx + 2
+	}
+	m.add("L")
+	return idx + 1
+}
+
+func (m *doubleMetaphone) conditionL0(idx int) bool {
+	if idx == len(m.value)-3 && m.contains(idx-1, 4, "ILLO", "ILLA", "ALLE") {
+		return true
+	}
+	return (m.contains(len(m.value)-2, 2, "AS", "OS") || m.contains(m.last, 1, "A", "O")) &&
+		m.contains(idx-1, 4, "ALLE")
+}
+
+func (m *doubleMetaphone) conditionM0(idx int) bool {
+	if m.charAt(idx+1) == 'M' {
+		return true
+	}
+	// "Dumb", "Thumb"
+	return m.contains(idx-1, 3, "UMB") && (idx+1 == m.last || m.contains(idx+2, 2, "ER"))
+}
+
+func (m *doubleMetaphone) handleP(idx int) int {
+	if m.charAt(idx+1) == 'H' {
+		m.add("F")
+		return idx + 2
+	}
+	m.add("P")
+	// Also account for "Campbell" and "Raspberry"
+	if m.contains(idx+1, 1, "P", "B") {
+		return idx + 2
+	}
+	return idx + 1
+}
+
+func (m *doubleMetaphone) handleR(idx int) int {
+	if idx == m.last && !m.slavoGermanic && m.contains(idx-2, 2, "IE") && !m.contains(idx-4, 2, "ME", "MA") {
+		// French e.g. "Rogier", but exclude "Hochmeier"
+		m.addAlternate("R")
+	} else {
+		m.add("R")
+	}
+	return m.skipDouble(idx, 'R')
+}
+
+func (m *doubleMetaphone) handleS(idx int) int {
+	switch {
+	case m.contains(idx-1, 3, "ISL", "YSL"):
+		// Special cases "Island", "Isle", "Carlisle", "Carlysle"
+		return idx + 1
+
+	case idx == 0 && m.contains(idx, 5, "SUGAR"):
+		m.addBoth("X", "S")
+		return idx + 1
+
+	case m.contains(idx, 2, "SH"):
+		if m.contains(idx+1, 4, "HEIM", "HOEK", "HOLM", "HOLZ") {
+			// Germanic
+			m.add("S")
+		} else {
+			m.add("X")
+		}
+		return idx + 2
+
+	case m.contains(idx, 3, "SIO", "SIA") || m.contains(idx, 4, "SIAN"):
+		// Italian and Armenian
+		if m.slavoGermanic {
+			m.add("S")
+		} else {
+			m.addBoth("S", "X")
+		}
+		return idx + 3
+
+	case (idx == 0 && m.contains(idx+1, 1, "M", "N", "L", "W")) || m.contains(idx+1, 1, "Z"):
+		// German & Anglicisations, e.g. "Smith" matches "Schmidt", "Snider" matches "Schneider"
+		m.addBoth("S", "X")
+		if m.contains(idx+1, 1, "Z") {
+			return idx + 2
+		}
+		return idx + 1
+
+	case m.contains(idx, 2, "SC"):
+		return m.handleSC(idx)
+	}
+
+	if idx == m.last && m.contains(idx-2, 2, "AI", "OI") {
+		// French e.g. "Resnais", "Artois"
+		m.addAlternate("S")
+	} else {
+		m.add("S")
+	}
+	if m.contains(idx+1, 1, "S", "Z") {
+		return idx + 2
+	}
+	return idx + 1
+}
+
+func (m *doubleMetaphone) handleSC(idx int) int {
+	switch {
+	case m.charAt(idx+2) == 'H':
+		// Schlesinger's rule
+		switch {
+		case m.contains(idx+3, 2, "OO", "ER", "EN", "UY", "ED", "EM"):
+			// Dutch origin, e.g. "School", "Schooner"
+			if m.contains(idx+3, 2, "ER", "EN") {
+				// "Schermerhorn", "Schenker"
+				m.addBoth("X", "SK")
+			} else {
+				m.add("SK")
+			}
+		case idx == 0 && !isDoubleMetaphoneVowel(m.charAt(3)) && m.charAt(3) != 'W':
+			m.addBoth("X", "S")
+		default:
+			m.add("X")
+		}
+
+	case m.contains(idx+2, 1, "I", "E", "Y"):
+		m.add("S")
+
+	default:
+		m.add("SK")
+	}
+	return idx + 3
+}
+
+func (m *doubleMetaphone) handleT(idx int) int {
+	switch {
+	case m.contains(idx, 4, "TION"):
+		m.add("X")
+		return idx + 3
+
+	case m.contains(idx, 3, "TIA", "TCH"):
+		m.add("X")
+		return idx + 3
+
+	case m.contains(idx, 2, "TH") || m.contains(idx, 3, "TTH"):
+		if m.contains(idx+2, 2, "OM", "AM") || m.contains(0, 4, "VAN ", "VON ") || m.contains(0, 3, "SCH") {
+			// Special case "Thomas", "Thames" or Germanic
+			m.add("T")
+		} else {
+			m.addBoth("0", "T")
+		}
+		return idx + 2
+	}
+
+	m.add("T")
+	if m.contains(idx+1, 1, "T", "D") {
+		return idx + 2
+	}
+	return idx + 1
+}
+
+func (m *doubleMetaphone) handleW(idx int) int {
+	if m.contains(idx, 2, "WR") {
+		// Can also be in the middle of a word
+		m.add("R")
+		return idx + 2
+	}
+
+	switch {
+	case idx == 0 && (isDoubleMetaphoneVowel(m.charAt(idx+1)) || m.contains(idx, 2, "WH")):
+		if isDoubleMetaphoneVowel(m.charAt(idx + 1)) {
+			// "Wasserman" should match "Vasserman"
+			m.addBoth("A", "F")
+		} else {
+			// Need "Uomo" to match "Womo"
+			m.add("A")
+		}
+		return idx + 1
+
+	case (idx == m.last && isDoubleMetaphoneVowel(m.charAt(idx-1))) ||
+		m.contains(idx-1, 5, "EWSKI", "EWSKY", "OWSKI", "OWSKY") ||
+		m.contains(0, 3, "SCH"):
+		// "Arnow" should match "Arnoff"
+		m.addAlternate("F")
+		return idx + 1
+
+	case m.contains(idx, 4, "WICZ", "WITZ"):
+		// Polish e.g. "Filipowicz"
+		m.addBoth("TS", "FX")
+		return idx + 4
+	}
+
+	return idx + 1
+}
+
+func (m *doubleMetaphone) handleX(idx int) int {
+	if idx == 0 {
+		m.add("S")
+		return idx + 1
+	}
+
+	// French e.g. "Breaux"
+	if !(idx == m.last && (m.contains(idx-3, 3, "IAU", "EAU") || m.contains(idx-2, 2, "AU", "OU"))) {
+		m.add("KS")
+	}
+	if m.contains(idx+1, 1, "C", "X") {
+		return idx + 2
+	}
+	return idx + 1
+}
+
+func (m *doubleMetaphone) handleZ(idx int) int {
+	if m.charAt(idx+1) == 'H' {
+		// Chinese pinyin e.g. "Zhao"
+		m.add("J")
+		return idx + 2
+	}
+
+	if m.contains(idx+1, 2, "ZO", "ZI", "ZA") || (m.slavoGermanic && idx > 0 && m.charAt(idx-1) != 'T') {
+		m.addBoth("S", "TS")
+	} else {
+		m.add("S")
+	}
+	return m.skipDouble(idx, 'Z')
+}
+
+// DoubleMetaphoneScore compares the Double Metaphone codes of two tokens.
+//
+// Matching primary codes score 1.0, a match between a primary and alternate code scores 0.9
+// and matching alternate codes score 0.8. Otherwise the tokens are not phonetically similar.
+func DoubleMetaphoneScore(c1, c2 PhoneticCode) float64 {
+	if c1.Empty() || c2.Empty() {
+		return 0.0
+	}
+	switch {
+	case c1.Primary != "" && c1.Primary == c2.Primary:
+		return 1.0
+	case (c1.Primary != "" && c1.Primary == c2.Alternate) || (c1.Alternate != "" && c1.Alternate == c2.Primary):
+		return 0.9
+	case c1.Alternate != "" && c1.Alternate == c2.Alternate:
+		return 0.8
+	}
+	return 0.0
+}
+
+// BestPairsDoubleMetaphone compares the phonetic codes of search and index tokens and returns a decimal fraction score.
+//
+// Each search token is paired with the best scoring index token, where each index token can be matched at most once.
+// The average score is reduced by the fraction of index tokens which went unmatched, similar to BestPairsJaroWinkler.
+func BestPairsDoubleMetaphone(searchCodes, indexCodes []PhoneticCode) float64 {
+	if len(searchCodes) == 0 || len(indexCodes) == 0 {
+		return 0.0
+	}
+
+	matchedIndexCodes := make([]bool, len(indexCodes))
+
+	var total float64
+	var matched int
+	for _, sc := range searchCodes {
+		bestScore, bestIdx := 0.0, -1
+		for idx, ic := range indexCodes {
+			if matchedIndexCodes[idx] {
+				continue
+			}
+			if score := DoubleMetaphoneScore(sc, ic); score > bestScore {
+				bestScore, bestIdx = score, idx
+			}
+		}
+		if bestIdx >= 0 {
+			matchedIndexCodes[bestIdx] = true
+			matched++
+		}
+		total += bestScore
+	}
+
+	average := total / float64(len(searchCodes))
+	matchedFraction := float64(matched) / float64(len(indexCodes))
+
+	return average * scalingFactor(matchedFraction, unmatchedIndexPenaltyWeight)
+}
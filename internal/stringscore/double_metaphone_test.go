@@ -0,0 +1,84 @@
+package stringscore_test
+
+import (
+	"testing"
+
+	"github.com/moov-io/watchman/internal/stringscore"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEncodeDoubleMetaphone(t *testing.T) {
+	cases := []struct {
+		input     string
+		primary   string
+		alternate string
+	}{
+		{"Schmidt", "XMT", "SMT"},
+		{"Shmit", "XMT", "XMT"},
+		{"Smith", "SM0", "XMT"},
+		{"Schneider", "XNTR", "SNTR"},
+		{"Snider", "SNTR", "XNTR"},
+		{"Jose", "HS", "HS"},
+		{"Michael", "MKL", "MXL"},
+		{"Xavier", "SF", "SFR"},
+		{"Rogier", "RJ", "RJR"},
+		{"Arnow", "ARN", "ARNF"},
+		{"Cabrillo", "KPRL", "KPR"},
+		{"Gallegos", "KLKS", "KKS"},
+		{"Filipowicz", "FLPT", "FLPF"},
+		{"Wasserman", "ASRM", "FSRM"},
+		{"Laugh", "LF", "LF"},
+		{"Caesar", "SSR", "SSR"},
+
+		// Treasury/Sanctions context examples
+		{"Muhammad", "MHMT", "MHMT"},
+		{"Mohammed", "MHMT", "MHMT"},
+		{"Qaddafi", "KTF", "KTF"},
+		{"Gaddafi", "KTF", "KTF"},
+		{"Ivanov", "AFNF", "AFNF"},
+		{"Ivanoff", "AFNF", "AFNF"},
+		{"Aleksandr", "ALKS", "ALKS"},
+		{"Alexander", "ALKS", "ALKS"},
+
+		// Edge cases
+		{"", "", ""},
+		{"123", "", ""},
+		{"smith", "SM0", "XMT"},
+	}
+	for _, tc := range cases {
+		t.Run(tc.input, func(t *testing.T) {
+			got := stringscore.EncodeDoubleMetaphone(tc.input)
+			require.Equal(t, tc.primary, got.Primary)
+			require.Equal(t, tc.alternate, got.Alternate)
+		})
+	}
+}
+
+func TestDoubleMetaphoneScore(t *testing.T) {
+	encode := stringscore.EncodeDoubleMetaphone
+
+	require.InDelta(t, 1.0, stringscore.DoubleMetaphoneScore(encode("Schmidt"), encode("Shmit")), 0.001)
+	require.InDelta(t, 0.9, stringscore.DoubleMetaphoneScore(encode("Smith"), encode("Schmidt")), 0.001)
+	require.InDelta(t, 0.0, stringscore.DoubleMetaphoneScore(encode("Smith"), encode("Jones")), 0.001)
+	require.InDelta(t, 0.0, stringscore.DoubleMetaphoneScore(encode(""), encode("Jones")), 0.001)
+}
+
+func TestBestPairsDoubleMetaphone(t *testing.T) {
+	encode := stringscore.EncodeDoubleMetaphones
+
+	cases := []struct {
+		query, index []string
+		expected     float64
+	}{
+		{[]string{"hans", "schmidt"}, []string{"hans", "shmit"}, 1.0},
+		{[]string{"alexander", "ivanov"}, []string{"aleksandr", "ivanoff"}, 1.0},
+		{[]string{"john", "smith"}, []string{"john", "jones"}, 0.4625},
+		{[]string{"john"}, []string{"john", "michael", "smith"}, 0.90},
+		{nil, []string{"john"}, 0.0},
+		{[]string{"john"}, nil, 0.0},
+	}
+	for _, tc := range cases {
+		got := stringscore.BestPairsDoubleMetaphone(encode(tc.query), encode(tc.index))
+		require.InDelta(t, tc.expected, got, 0.01, "%v vs %v", tc.query, tc.index)
+	}
+}
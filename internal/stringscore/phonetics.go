@@ -4,7 +4,8 @@ import (
 	"unicode"
 )
 
-// TODO(adam): implement double metaphone and Beider-Morse Phonetic Matching
+// TODO(adam): implement Beider-Morse Phonetic Matching with language hints from detectLanguage
+// (Double Metaphone is in double_metaphone.go and doesn't use a language hint)
 // add configuration to pick between soundex, double metaphone, and beider-morse
 //
 // phonetic filter can be disabled, which might be good to Moov to do (try DISABLE_PHONETIC_FILTERING=yes)
@@ -0,0 +1,240 @@
+package xlsx
+
+import (
+	"archive/zip"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"path"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// ReadFirstSheet returns the rows of the first worksheet in an XLSX workbook. Each row is
+// returned as the text of its cells, with empty cells filled in so values stay in their column.
+//
+// Only cell values are read. Formulas return their cached value and styles are ignored,
+// so dates are returned as serial numbers, see ParseSerialDate.
+func ReadFirstSheet(r io.ReaderAt, size int64) ([][]string, error) {
+	zr, err := zip.NewReader(r, size)
+	if err != nil {
+		return nil, fmt.Errorf("opening xlsx: %w", err)
+	}
+
+	files := make(map[string]*zip.File, len(zr.File))
+	for _, f := range zr.File {
+		files[f.Name] = f
+	}
+
+	sheetPath, err := firstSheetPath(files)
+	if err != nil {
+		return nil, err
+	}
+
+	var sharedStrings []string
+	if f, exists := files["xl/sharedStrings.xml"]; exists {
+		sharedStrings, err = readSharedStrings(f)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	f, exists := files[sheetPath]
+	if !exists {
+		return nil, fmt.Errorf("worksheet %s not found", sheetPath)
+	}
+	return readSheet(f, sharedStrings)
+}
+
+type workbook struct {
+	Sheets []struct {
+		Name string `xml:"name,attr"`
+		ID   string `xml:"http://schemas.openxmlformats.org/officeDocument/2006/relationships id,attr"`
+	} `xml:"sheets>sheet"`
+}
+
+type relationships struct {
+	Relationships []struct {
+		ID     string `xml:"Id,attr"`
+		Target string `xml:"Target,attr"`
+	} `xml:"Relationship"`
+}
+
+// firstSheetPath finds the file of the first sheet listed in the workbook
+func firstSheetPath(files map[string]*zip.File) (string, error) {
+	var wb workbook
+	if err := decodeFile(files["xl/workbook.xml"], &wb); err != nil {
+		return "", fmt.Errorf("reading workbook: %w", err)
+	}
+	if len(wb.Sheets) == 0 {
+		return "", errors.New("workbook has no sheets")
+	}
+
+	var rels relationships
+	if err := decodeFile(files["xl/_rels/workbook.xml.rels"], &rels); err != nil {
+		return "", fmt.Errorf("reading workbook relationships: %w", err)
+	}
+	for _, rel := range rels.Relationships {
+		if rel.ID != wb.Sheets[0].ID {
+			continue
+		}
+		// Targets are relative to xl/ unless they're absolute
+		if strings.HasPrefix(rel.Target, "/") {
+			return strings.TrimPrefix(rel.Target, "/"), nil
+		}
+		return path.Join("xl", rel.Target), nil
+	}
+	return "", fmt.Errorf("sheet %q has no relationship", wb.Sheets[0].Name)
+}
+
+func decodeFile(f *zip.File, v any) error {
+	if f == nil {
+		return errors.New("file not found")
+	}
+	rc, err := f.Open()
+	if err != nil {
+		return err
+	}
+	defer rc.Close()
+
+	return xml.NewDecoder(rc).Decode(v)
+}
+
+// richText is a string which is either plain or split into formatted runs
+type richText struct {
+	Text string `xml:"t"`
+	Runs []struct {
+		Text string `xml:"t"`
+	} `xml:"r"`
+}
+
+func (rt richText) String() string {
+	if len(rt.Runs) == 0 {
+		return rt.Text
+	}
+	var sb strings.Builder
+	for _, run := range rt.Runs {
+		sb.WriteString(run.Text)
+	}
+	return sb.String()
+}
+
+func readSharedStrings(f *zip.File) ([]string, error) {
+	var sst struct {
+		Items []richText `xml:"si"`
+	}
+	if err := decodeFile(f, &sst); err != nil {
+		return nil, fmt.Errorf("reading shared strings: %w", err)
+	}
+
+	out := make([]string, len(sst.Items))
+	for i, item := range sst.Items {
+		out[i] = item.String()
+	}
+	return out, nil
+}
+
+type cell struct {
+	Ref    string   `xml:"r,attr"`
+	Type   string   `xml:"t,attr"`
+	Value  string   `xml:"v"`
+	Inline richText `xml:"is"`
+}
+
+func readSheet(f *zip.File, sharedStrings []string) ([][]string, error) {
+	rc, err := f.Open()
+	if err != nil {
+		return nil, fmt.Errorf("opening worksheet: %w", err)
+	}
+	defer rc.Close()
+
+	var out [][]string
+
+	// Rows are decoded one at a time since worksheets can be large
+	d := xml.NewDecoder(rc)
+	for {
+		token, err := d.Token()
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				break
+			}
+			return nil, fmt.Errorf("reading worksheet: %w", err)
+		}
+
+		start, ok := token.(xml.StartElement)
+		if !ok || start.Name.Local != "row" {
+			continue
+		}
+
+		var row struct {
+			Index int    `xml:"r,attr"`
+			Cells []cell `xml:"c"`
+		}
+		if err := d.DecodeElement(&row, &start); err != nil {
+			return nil, fmt.Errorf("reading row: %w", err)
+		}
+
+		// Fill in rows without any cells
+		for row.Index > len(out)+1 {
+			out = append(out, nil)
+		}
+
+		var values []string
+		for _, c := range row.Cells {
+			if col := columnIndex(c.Ref); col >= 0 {
+				for len(values) < col {
+					values = append(values, "")
+				}
+			}
+
+			value := c.Value
+			switch c.Type {
+			case "s":
+				idx, err := strconv.Atoi(c.Value)
+				if err != nil || idx < 0 || idx >= len(sharedStrings) {
+					return nil, fmt.Errorf("cell %s: invalid shared string %q", c.Ref, c.Value)
+				}
+				value = sharedStrings[idx]
+			case "inlineStr":
+				value = c.Inline.String()
+			}
+			values = append(values, value)
+		}
+		out = append(out, values)
+	}
+
+	return out, nil
+}
+
+// columnIndex returns the zero-based column of a cell reference like "C12", or -1 without a reference
+func columnIndex(ref string) int {
+	col := 0
+	var found bool
+	for _, r := range ref {
+		if r < 'A' || r > 'Z' {
+			break
+		}
+		col = col*26 + int(r-'A'+1)
+		found = true
+	}
+	if !found {
+		return -1
+	}
+	return col - 1
+}
+
+var (
+	// Excel counts days from 1899-12-30, which accounts for its 1900 leap year bug
+	excelEpoch = time.Date(1899, time.December, 30, 0, 0, 0, 0, time.UTC)
+)
+
+// ParseSerialDate converts a date stored as the number of days since 1900, like "22349", into a time.
+func ParseSerialDate(value string) (time.Time, bool) {
+	days, err := strconv.ParseFloat(strings.TrimSpace(value), 64)
+	if err != nil || days < 1 {
+		return time.Time{}, false
+	}
+	return excelEpoch.AddDate(0, 0, int(days)), true
+}
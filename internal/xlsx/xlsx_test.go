@@ -0,0 +1,126 @@
+package xlsx
+
+import (
+	"archive/zip"
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func buildWorkbook(t *testing.T, files map[string]string) *bytes.Reader {
+	t.Helper()
+
+	var buf bytes.Buffer
+	zw := zip.NewWriter(&buf)
+	for name, content := range files {
+		w, err := zw.Create(name)
+		require.NoError(t, err)
+
+		_, err = w.Write([]byte(content))
+		require.NoError(t, err)
+	}
+	require.NoError(t, zw.Close())
+
+	return bytes.NewReader(buf.Bytes())
+}
+
+const (
+	testWorkbook = `<?xml version="1.0" encoding="UTF-8" standalone="yes"?>
+<workbook xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main" xmlns:r="http://schemas.openxmlformats.org/officeDocument/2006/relationships">
+  <sheets>
+    <sheet name="Consolidated List" sheetId="1" r:id="rId2"/>
+    <sheet name="Other" sheetId="2" r:id="rId1"/>
+  </sheets>
+</workbook>`
+
+	testRelationships = `<?xml version="1.0" encoding="UTF-8" standalone="yes"?>
+<Relationships xmlns="http://schemas.openxmlformats.org/package/2006/relationships">
+  <Relationship Id="rId1" Type="http://schemas.openxmlformats.org/officeDocument/2006/relationships/worksheet" Target="worksheets/sheet2.xml"/>
+  <Relationship Id="rId2" Type="http://schemas.openxmlformats.org/officeDocument/2006/relationships/worksheet" Target="worksheets/sheet1.xml"/>
+</Relationships>`
+
+	testSharedStrings = `<?xml version="1.0" encoding="UTF-8" standalone="yes"?>
+<sst xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main" count="3" uniqueCount="3">
+  <si><t>Name</t></si>
+  <si><t>Date of Birth</t></si>
+  <si><r><t>Ivan </t></r><r><rPr><b/></rPr><t>PETROV</t></r></si>
+</sst>`
+
+	testSheet = `<?xml version="1.0" encoding="UTF-8" standalone="yes"?>
+<worksheet xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main">
+  <sheetData>
+    <row r="1"><c r="A1" t="s"><v>0</v></c><c r="B1" t="s"><v>1</v></c></row>
+    <row r="2"><c r="A2" t="s"><v>2</v></c><c r="C2" t="inlineStr"><is><t>note</t></is></c></row>
+    <row r="4"><c r="B4"><v>22349</v></c></row>
+  </sheetData>
+</worksheet>`
+)
+
+func TestReadFirstSheet(t *testing.T) {
+	r := buildWorkbook(t, map[string]string{
+		"xl/workbook.xml":            testWorkbook,
+		"xl/_rels/workbook.xml.rels": testRelationships,
+		"xl/sharedStrings.xml":       testSharedStrings,
+		"xl/worksheets/sheet1.xml":   testSheet,
+		"xl/worksheets/sheet2.xml":   `<worksheet><sheetData><row r="1"><c r="A1" t="inlineStr"><is><t>wrong sheet</t></is></c></row></sheetData></worksheet>`,
+	})
+
+	rows, err := ReadFirstSheet(r, r.Size())
+	require.NoError(t, err)
+
+	expected := [][]string{
+		{"Name", "Date of Birth"},
+		{"Ivan PETROV", "", "note"},
+		nil,
+		{"", "22349"},
+	}
+	require.Equal(t, expected, rows)
+}
+
+func TestReadFirstSheet_errors(t *testing.T) {
+	t.Run("not a zip", func(t *testing.T) {
+		r := bytes.NewReader([]byte("Name,Date of Birth\n"))
+		_, err := ReadFirstSheet(r, r.Size())
+		require.ErrorContains(t, err, "opening xlsx")
+	})
+
+	t.Run("missing worksheet", func(t *testing.T) {
+		r := buildWorkbook(t, map[string]string{
+			"xl/workbook.xml":            testWorkbook,
+			"xl/_rels/workbook.xml.rels": testRelationships,
+		})
+		_, err := ReadFirstSheet(r, r.Size())
+		require.ErrorContains(t, err, "worksheet xl/worksheets/sheet1.xml not found")
+	})
+
+	t.Run("bad shared string", func(t *testing.T) {
+		r := buildWorkbook(t, map[string]string{
+			"xl/workbook.xml":            testWorkbook,
+			"xl/_rels/workbook.xml.rels": testRelationships,
+			"xl/worksheets/sheet1.xml":   `<worksheet><sheetData><row r="1"><c r="A1" t="s"><v>4</v></c></row></sheetData></worksheet>`,
+		})
+		_, err := ReadFirstSheet(r, r.Size())
+		require.ErrorContains(t, err, "invalid shared string")
+	})
+}
+
+func TestColumnIndex(t *testing.T) {
+	require.Equal(t, 0, columnIndex("A1"))
+	require.Equal(t, 2, columnIndex("C12"))
+	require.Equal(t, 26, columnIndex("AA3"))
+	require.Equal(t, -1, columnIndex(""))
+}
+
+func TestParseSerialDate(t *testing.T) {
+	when, ok := ParseSerialDate("22349")
+	require.True(t, ok)
+	require.Equal(t, time.Date(1961, time.March, 9, 0, 0, 0, 0, time.UTC), when)
+
+	_, ok = ParseSerialDate("1961")
+	require.True(t, ok) // a number of days, callers decide which to expect
+
+	_, ok = ParseSerialDate("08/03/1961")
+	require.False(t, ok)
+}
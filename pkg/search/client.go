@@ -102,6 +102,9 @@ type ListInfoResponse struct {
 	Lists      map[string]int    `json:"lists"`
 	ListHashes map[string]string `json:"listHashes"`
 
+	// SubLists is the entity count of each list a consolidated list is made from, e.g. "EL" within "us_csl"
+	SubLists map[string]map[string]int `json:"subLists,omitempty"`
+
 	StartedAt time.Time `json:"startedAt"`
 	EndedAt   time.Time `json:"endedAt"`
 
@@ -210,13 +213,17 @@ type SearchResponse struct {
 	Query Entity[Value] `json:"query"`
 
 	Entities []SearchedEntity[Value] `json:"entities"`
+
+	// Jurisdictions are query fields located in embargoed or sanctioned jurisdictions
+	Jurisdictions []JurisdictionHit `json:"jurisdictions,omitempty"`
 }
 
 func (s *SearchResponse) UnmarshalJSON(data []byte) error {
 	var aux struct {
-		Query    Entity[Value]           `json:"query"`
-		Entities []SearchedEntity[Value] `json:"entities"`
-		Error    string                  `json:"error"`
+		Query         Entity[Value]           `json:"query"`
+		Entities      []SearchedEntity[Value] `json:"entities"`
+		Jurisdictions []JurisdictionHit       `json:"jurisdictions"`
+		Error         string                  `json:"error"`
 	}
 	err := json.Unmarshal(data, &aux)
 	if err != nil {
@@ -228,6 +235,7 @@ func (s *SearchResponse) UnmarshalJSON(data []byte) error {
 
 	s.Query = aux.Query
 	s.Entities = aux.Entities
+	s.Jurisdictions = aux.Jurisdictions
 
 	return nil
 }
@@ -236,6 +244,18 @@ type SearchOpts struct {
 	Limit    int
 	MinMatch float64
 	Debug    bool
+
+	// ExcludeExpired drops debarments which have ended from the results
+	ExcludeExpired bool
+
+	// Categories limits results to entities in any of the categories, e.g. PEPs or sanctions
+	Categories []ListCategory
+
+	// PEPTenureYears drops PEPs who left their last position more than this many years ago
+	PEPTenureYears int
+
+	// SubLists limits results to entities from these lists of a consolidated source, e.g. "EL" or "SSI"
+	SubLists []string
 }
 
 // SearchByEntity searches for entities (e.g., individuals, businesses) using the provided query fields and
@@ -288,6 +308,18 @@ func SetSearchOpts(q url.Values, opts SearchOpts) url.Values {
 	if opts.Debug {
 		q.Set("debug", "yes")
 	}
+	if opts.ExcludeExpired {
+		q.Set("excludeExpired", "yes")
+	}
+	for _, category := range opts.Categories {
+		q.Add("category", string(category))
+	}
+	if opts.PEPTenureYears > 0 {
+		q.Set("pepTenureYears", strconv.Itoa(opts.PEPTenureYears))
+	}
+	for _, subList := range opts.SubLists {
+		q.Add("subList", subList)
+	}
 
 	return q
 }
@@ -72,21 +72,29 @@ func TestClient_buildQueryParameters(t *testing.T) {
 				},
 			},
 			opts: SearchOpts{
-				Limit:    5,
-				MinMatch: 0.925,
+				Limit:          5,
+				MinMatch:       0.925,
+				ExcludeExpired: true,
+				Categories:     []ListCategory{CategorySanctions, CategoryPEP},
+				PEPTenureYears: 5,
+				SubLists:       []string{"EL", "DPL"},
 			},
 			expected: map[string][]string{
-				"name":          []string{"Acme Crypto Corp"},
-				"type":          []string{"business"},
-				"altNames":      []string{"Super Crypto Corp"},
-				"created":       []string{"2012-12-31"},
-				"emailAddress":  []string{"press@acmecrypto.com"},
-				"phoneNumber":   []string{"123-456-7890"},
-				"website":       []string{"acmecrypto.com"},
-				"address":       []string{"123 Acme St Acmetown 54321 AC US"},
-				"cryptoAddress": []string{"XBT:abc12345"},
-				"limit":         []string{"5"},
-				"minMatch":      []string{"0.93"}, // rounded
+				"name":           []string{"Acme Crypto Corp"},
+				"type":           []string{"business"},
+				"altNames":       []string{"Super Crypto Corp"},
+				"created":        []string{"2012-12-31"},
+				"emailAddress":   []string{"press@acmecrypto.com"},
+				"phoneNumber":    []string{"123-456-7890"},
+				"website":        []string{"acmecrypto.com"},
+				"address":        []string{"123 Acme St Acmetown 54321 AC US"},
+				"cryptoAddress":  []string{"XBT:abc12345"},
+				"limit":          []string{"5"},
+				"minMatch":       []string{"0.93"}, // rounded
+				"excludeExpired": []string{"yes"},
+				"category":       []string{"sanctions", "pep"},
+				"pepTenureYears": []string{"5"},
+				"subList":        []string{"EL", "DPL"},
 			},
 		},
 	}
@@ -53,7 +53,7 @@ func testAPI(tb testing.TB) testSetup {
 	ingestRepository := &ingest.MockRepository{}
 	ingestService := ingest.NewService(logger, conf.Ingest, ingestRepository)
 
-	searchController := search.NewController(logger, searchService, nil)
+	searchController := search.NewController(logger, searchService, nil, nil)
 	ingestController := ingest.NewController(logger, ingestService)
 
 	router := mux.NewRouter()
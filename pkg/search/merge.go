@@ -4,6 +4,7 @@ import (
 	"cmp"
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/adamdecaf/merge"
 )
@@ -59,6 +60,7 @@ func (e *Entity[T]) merge(other Entity[T]) Entity[T] {
 	out.Type = cmp.Or(e.Type, other.Type)
 	out.Source = cmp.Or(e.Source, other.Source)
 	out.SourceID = cmp.Or(e.SourceID, other.SourceID)
+	out.SubList = cmp.Or(e.SubList, other.SubList)
 
 	// Merge type fields
 	switch {
@@ -74,6 +76,7 @@ func (e *Entity[T]) merge(other Entity[T]) Entity[T] {
 			PlaceOfBirth:  cmp.Or(e.Person.PlaceOfBirth, other.Person.PlaceOfBirth),
 			DeathDate:     cmp.Or(e.Person.DeathDate, other.Person.DeathDate),
 			Titles:        mergeStrings(e.Person.Titles, other.Person.Titles),
+			Positions:     mergePositions(e.Person.Positions, other.Person.Positions),
 			GovernmentIDs: mergeGovernmentIDs(e.Person.GovernmentIDs, other.Person.GovernmentIDs),
 		}
 
@@ -141,6 +144,7 @@ func (e *Entity[T]) merge(other Entity[T]) Entity[T] {
 	out.Contact.PhoneNumbers = mergeStrings(e.Contact.PhoneNumbers, other.Contact.PhoneNumbers)
 	out.Contact.FaxNumbers = mergeStrings(e.Contact.FaxNumbers, other.Contact.FaxNumbers)
 	out.Contact.Websites = mergeStrings(e.Contact.Websites, other.Contact.Websites)
+	out.Contact.Country = cmp.Or(e.Contact.Country, other.Contact.Country)
 
 	out.Addresses = mergeAddresses(e.Addresses, other.Addresses)
 	out.CryptoAddresses = mergeCryptoAddresses(e.CryptoAddresses, other.CryptoAddresses)
@@ -163,6 +167,9 @@ func (e *Entity[T]) merge(other Entity[T]) Entity[T] {
 		}
 	}
 
+	out.Topics = mergeStrings(e.Topics, other.Topics)
+	out.Debarment = cmp.Or(e.Debarment, other.Debarment)
+
 	out.SourceData = other.SourceData
 
 	return out
@@ -261,6 +268,28 @@ func mergeAffiliations(a1, a2 []Affiliation) []Affiliation {
 	return out
 }
 
+func mergePositions(p1, p2 []Position) []Position {
+	out := merge.Slices(
+		func(p Position) string {
+			var start string
+			if p.Start != nil {
+				start = p.Start.Format(time.DateOnly)
+			}
+			return strings.ToLower(fmt.Sprintf("%s/%s/%s", p.Title, p.Country, start))
+		},
+		func(acc *Position, p Position) {
+			acc.End = cmp.Or(acc.End, p.End)
+		},
+		p1, p2,
+	)
+
+	if len(out) == 0 {
+		return nil
+	}
+
+	return out
+}
+
 func mergeHistoricalInfo(h1, h2 []HistoricalInfo) []HistoricalInfo {
 	out := merge.Slices(
 		func(h HistoricalInfo) string {
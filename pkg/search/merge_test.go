@@ -45,6 +45,8 @@ var (
 				Address:  "be503b97-a5ec-4494-aacd-dc97c70293f3",
 			},
 		},
+
+		Topics: []string{search.TopicSanction},
 	}
 
 	johnnyBirthDate = time.Date(1971, time.March, 26, 0, 0, 0, 0, time.UTC)
@@ -82,6 +84,8 @@ var (
 				Country:    "US",
 			},
 		},
+
+		Topics: []string{search.TopicSanction, search.TopicPEP},
 	}
 
 	johnJohnnyMerged = search.Entity[search.Value]{
@@ -130,6 +134,8 @@ var (
 				Address:  "be503b97-a5ec-4494-aacd-dc97c70293f3",
 			},
 		},
+
+		Topics: []string{search.TopicSanction, search.TopicPEP},
 	}
 )
 
@@ -157,6 +163,7 @@ func TestMerge(t *testing.T) {
 	require.ElementsMatch(t, johnJohnnyMerged.Affiliations, merged[0].Affiliations)
 	require.Equal(t, johnJohnnyMerged.SanctionsInfo, merged[0].SanctionsInfo)
 	require.ElementsMatch(t, johnJohnnyMerged.HistoricalInfo, merged[0].HistoricalInfo)
+	require.ElementsMatch(t, johnJohnnyMerged.Topics, merged[0].Topics)
 }
 
 func TestMerge__Identity(t *testing.T) {
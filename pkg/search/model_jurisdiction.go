@@ -0,0 +1,24 @@
+package search
+
+// JurisdictionHit is a query field located in a jurisdiction under embargo or comprehensive
+// sanctions by a regime. Hits are returned independently of entity matches.
+type JurisdictionHit struct {
+	// Regime is the authority imposing the measures: US, EU, UK or UN
+	Regime string `json:"regime"`
+
+	// Program is the sanctions program or regulation covering the jurisdiction
+	Program string `json:"program"`
+
+	// Country is the ISO 3166 alpha-2 code of the jurisdiction
+	Country string `json:"country"`
+
+	// Subdivision is the ISO 3166-2 code when only a region of the country is covered (e.g. "UA-43")
+	Subdivision string `json:"subdivision,omitempty"`
+
+	// Field is the part of the query which was located in the jurisdiction:
+	// address, phone, fax, nationality, governmentID or placeOfBirth
+	Field string `json:"field"`
+
+	// Value is the query value of the field
+	Value string `json:"value"`
+}
@@ -0,0 +1,92 @@
+package search
+
+// ScreeningDecision is the outcome of screening a party or message.
+type ScreeningDecision string
+
+var (
+	// ScreeningClear means no entity or jurisdiction was found
+	ScreeningClear ScreeningDecision = "clear"
+
+	// ScreeningReview means a possible match or sanctioned jurisdiction needs a manual review
+	ScreeningReview ScreeningDecision = "review"
+
+	// ScreeningBlock means an entity matched above the block threshold
+	ScreeningBlock ScreeningDecision = "block"
+)
+
+// PaymentScreeningResponse is the result of screening every party of a payment message.
+type PaymentScreeningResponse struct {
+	// Format is the message type: pacs.008, pacs.009, MT103, MT202 or MT202COV
+	Format string `json:"format"`
+
+	// Reference is the message identification (MsgId) or transaction reference (field 20)
+	Reference string `json:"reference"`
+
+	// Decision is the most severe decision of all parties
+	Decision ScreeningDecision `json:"decision"`
+
+	Parties []ScreenedParty `json:"parties"`
+
+	RemittanceInformation []string `json:"remittanceInformation,omitempty"`
+}
+
+// ScreenedParty is a party read from a payment message along with its search results.
+type ScreenedParty struct {
+	// Role is the party's ISO 20022 role (such as debtor, creditorAgent or intermediaryAgent)
+	// or NACHA role (originator, receiver, odfi, rdfi or foreignCorrespondentBank)
+	Role string `json:"role"`
+
+	Name    string   `json:"name,omitempty"`
+	BIC     string   `json:"bic,omitempty"`
+	Account string   `json:"account,omitempty"`
+	Address *Address `json:"address,omitempty"`
+	Country string   `json:"country,omitempty"`
+
+	FinancialInstitution bool `json:"financialInstitution"`
+
+	Decision ScreeningDecision `json:"decision"`
+
+	Entities      []SearchedEntity[Value] `json:"entities"`
+	Jurisdictions []JurisdictionHit       `json:"jurisdictions,omitempty"`
+}
+
+// ACHScreeningResponse is the result of screening every entry of a NACHA file.
+type ACHScreeningResponse struct {
+	// Decision is the most severe decision of all entries
+	Decision ScreeningDecision `json:"decision"`
+
+	Entries []ScreenedACHEntry `json:"entries"`
+}
+
+// ScreenedACHEntry is an entry detail record with its screened parties.
+type ScreenedACHEntry struct {
+	BatchNumber string `json:"batchNumber"`
+	TraceNumber string `json:"traceNumber"`
+
+	// SECCode is the Standard Entry Class of the batch, such as PPD, CCD or IAT
+	SECCode string `json:"secCode"`
+
+	// Amount is in cents
+	Amount int64 `json:"amount"`
+
+	Decision ScreeningDecision `json:"decision"`
+
+	Parties []ScreenedParty `json:"parties"`
+}
+
+// TextScreeningResponse is the result of screening free text, such as a remittance note or news article.
+type TextScreeningResponse struct {
+	Matches []TextMatch `json:"matches"`
+}
+
+// TextMatch is a span of the screened text which matched one or more entities.
+type TextMatch struct {
+	Text string `json:"text"`
+
+	// Start and End are the offsets of Text in the screened text, counted in characters (Unicode code points).
+	// End is exclusive.
+	Start int `json:"start"`
+	End   int `json:"end"`
+
+	Entities []SearchedEntity[Value] `json:"entities"`
+}
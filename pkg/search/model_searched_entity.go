@@ -5,6 +5,10 @@ type SearchedEntity[T any] struct {
 
 	Match float64 `json:"match"`
 
+	// DistanceKm is how far the entity's closest address is from the searched location.
+	// It is only set on radius searches.
+	DistanceKm *float64 `json:"distanceKm,omitempty"`
+
 	// Debug is an optional base64 encoded humand-readable field that contains
 	// detailed field-level match scores and weight adjustments.
 	//
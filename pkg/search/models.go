@@ -1,12 +1,15 @@
 package search
 
 import (
+	"cmp"
 	"fmt"
+	"slices"
 	"strings"
 	"time"
 
 	"github.com/moov-io/watchman/internal/norm"
 	"github.com/moov-io/watchman/internal/prepare"
+	"github.com/moov-io/watchman/internal/stringscore"
 )
 
 type Value interface{}
@@ -19,6 +22,10 @@ type Entity[T Value] struct {
 	// SourceID is the source data's identifier.
 	SourceID string `json:"sourceID"`
 
+	// SubList is the list an entity was published on within a consolidated source,
+	// e.g. "EL" (BIS Entity List) within us_csl or "SSI" within us_non_sdn
+	SubList string `json:"subList,omitempty"`
+
 	// TODO(adam): What has opensanctions done to normalize and join this data
 	// Review https://www.opensanctions.org/reference/
 
@@ -37,6 +44,12 @@ type Entity[T Value] struct {
 	SanctionsInfo  *SanctionsInfo   `json:"sanctionsInfo"`
 	HistoricalInfo []HistoricalInfo `json:"historicalInfo"`
 
+	// Topics classify why an entity is listed, e.g. "sanction" or "role.pep"
+	Topics []string `json:"topics,omitempty"`
+
+	// Debarment is only set for entities of debarment lists
+	Debarment *Debarment `json:"debarment,omitempty"`
+
 	PreparedFields PreparedFields `json:"-"`
 
 	SourceData T `json:"sourceData"` // Contains all original list data with source list naming
@@ -59,6 +72,11 @@ func (sl SourceList) IsRequestType() bool {
 	return sl == SourceAPIRequest || sl == SourceMCPRequest
 }
 
+// IsDebarment returns true for lists of parties ineligible for contracts, which are distinct from sanctions lists.
+func (sl SourceList) IsDebarment() bool {
+	return strings.HasPrefix(string(sl), "debarment_")
+}
+
 var (
 	SourceAPIRequest  SourceList = "api-request"
 	SourceMCPRequest  SourceList = "mcp-request"
@@ -70,6 +88,16 @@ var (
 	SourceUSNonSDN    SourceList = "us_non_sdn"
 	SourceUSFinCEN311 SourceList = "us_fincen_311"
 	SourceUNCSL       SourceList = "un_csl"
+	SourceCASEMA      SourceList = "ca_sema"
+	SourceAUDFAT      SourceList = "au_dfat"
+	SourceCHSECO      SourceList = "ch_seco"
+
+	// Debarment lists of multilateral development banks
+	SourceWorldBankDebarred SourceList = "debarment_worldbank"
+	SourceADBDebarred       SourceList = "debarment_adb"
+	SourceAfDBDebarred      SourceList = "debarment_afdb"
+	SourceIDBDebarred       SourceList = "debarment_idb"
+	SourceEBRDDebarred      SourceList = "debarment_ebrd"
 
 	sourceEmpty SourceList = ""
 )
@@ -86,9 +114,38 @@ type Person struct {
 
 	Titles []string `json:"titles"`
 
+	// Positions are the public offices held by politically exposed persons (PEPs)
+	Positions []Position `json:"positions,omitempty"`
+
 	GovernmentIDs []GovernmentID `json:"governmentIDs"`
 }
 
+// Position is a public office held by a person, such as "Member of Parliament".
+type Position struct {
+	Title   string     `json:"title"`
+	Country string     `json:"country,omitempty"` // Country of office
+	Start   *time.Time `json:"start,omitempty"`
+	End     *time.Time `json:"end,omitempty"` // Empty while the position is held or when unknown
+}
+
+// TenureEnded returns when the person left their most recent position. Nil is returned when
+// there are no positions or any position has not ended.
+func (p *Person) TenureEnded() *time.Time {
+	if p == nil || len(p.Positions) == 0 {
+		return nil
+	}
+	var last *time.Time
+	for _, pos := range p.Positions {
+		if pos.End == nil {
+			return nil
+		}
+		if last == nil || pos.End.After(*last) {
+			last = pos.End
+		}
+	}
+	return last
+}
+
 type Gender string
 
 var (
@@ -102,6 +159,10 @@ type ContactInfo struct {
 	PhoneNumbers   []string `json:"phoneNumbers"`
 	FaxNumbers     []string `json:"faxNumbers"`
 	Websites       []string `json:"websites"`
+
+	// Country (name or ISO code) local phone and fax numbers are dialed from.
+	// The entity's address and government ID countries are used when empty.
+	Country string `json:"country,omitempty"`
 }
 
 // TODO(adam):
@@ -140,6 +201,7 @@ var (
 	GovernmentIDPersonalID          GovernmentIDType = "personal-id"
 	GovernmentIDCitizenship         GovernmentIDType = "citizenship"
 	GovernmentIDNationality         GovernmentIDType = "nationality"
+	GovernmentIDSWIFT               GovernmentIDType = "swift-bic"
 )
 
 type Business struct {
@@ -236,6 +298,76 @@ func (a Address) Format() string {
 	return strings.ReplaceAll(strings.TrimSpace(out), "  ", " ")
 }
 
+// ListCategory groups entities by why they are listed, independent of the list they came from.
+type ListCategory string
+
+var (
+	CategorySanctions ListCategory = "sanctions"
+	CategoryPEP       ListCategory = "pep"
+	CategoryDebarment ListCategory = "debarment"
+
+	// CategoryExportControl is for parties on export control lists, such as the Entity List
+	// of the Bureau of Industry and Security. They're published alongside sanctions in the US CSL.
+	CategoryExportControl ListCategory = "export_control"
+)
+
+// exportControlSubLists are the US CSL lists of export restrictions rather than sanctions
+var exportControlSubLists = []string{
+	"DPL", // Denied Persons List
+	"EL",  // Entity List
+	"MEU", // Military End User List
+	"UVL", // Unverified List
+	"DTC", // ITAR Debarred
+}
+
+// Categories returns each category the entity belongs to. A sanctioned PEP is in both
+// the sanctions and pep categories. Entities of lists without topics, debarment details or
+// PEP data are sanctions, as that's what most of the lists Watchman downloads are.
+func (e Entity[T]) Categories() []ListCategory {
+	exportControl := e.Source == SourceUSCSL && slices.Contains(exportControlSubLists, e.SubList)
+
+	var out []ListCategory
+	if (e.SanctionsInfo != nil && !exportControl) || slices.Contains(e.Topics, TopicSanction) {
+		out = append(out, CategorySanctions)
+	}
+	if slices.Contains(e.Topics, TopicPEP) || slices.Contains(e.Topics, TopicRCA) {
+		out = append(out, CategoryPEP)
+	}
+	if e.Debarment != nil || e.Source.IsDebarment() {
+		out = append(out, CategoryDebarment)
+	}
+	if exportControl {
+		out = append(out, CategoryExportControl)
+	}
+	if len(out) == 0 && len(e.Topics) == 0 && e.Source != "" && !e.Source.IsRequestType() {
+		out = append(out, CategorySanctions)
+	}
+	return out
+}
+
+// InCategory returns true when the entity belongs to any of the categories.
+// The categories computed by Normalize are used when present.
+func (e Entity[T]) InCategory(categories ...ListCategory) bool {
+	found := e.PreparedFields.Categories
+	if found == nil {
+		found = e.Categories()
+	}
+	for _, c := range found {
+		if slices.Contains(categories, c) {
+			return true
+		}
+	}
+	return false
+}
+
+// Topics follow the names used by OpenSanctions and FollowTheMoney.
+// See https://www.opensanctions.org/docs/topics/
+const (
+	TopicSanction = "sanction"
+	TopicPEP      = "role.pep"
+	TopicRCA      = "role.rca" // Relative or close associate of a PEP
+)
+
 type Affiliation struct {
 	EntityName string `json:"entityName"`
 	Type       string `json:"type"` // e.g., "Linked To", "Subsidiary Of", "Owned By"
@@ -255,6 +387,23 @@ type SanctionsInfo struct {
 	// SourceInformationURL  string `json:"sourceInformationURL"`
 }
 
+// Debarment is a period when a person or business is ineligible to be awarded contracts,
+// such as those financed by the World Bank and other multilateral development banks.
+type Debarment struct {
+	Institution string     `json:"institution"` // e.g., "World Bank", "ADB"
+	Grounds     string     `json:"grounds"`     // e.g., "Procurement Guidelines, 1.16(a)(ii)"
+	From        *time.Time `json:"from"`
+	To          *time.Time `json:"to"` // Empty when ineligible indefinitely
+
+	// CrossDebarment is set when the debarment recognizes one made by another institution
+	CrossDebarment bool `json:"crossDebarment"`
+}
+
+// Expired returns true when the debarment ended before now. Debarments without an end never expire.
+func (d *Debarment) Expired(now time.Time) bool {
+	return d != nil && d.To != nil && d.To.Before(now)
+}
+
 type HistoricalInfo struct {
 	Type  string    `json:"type"`  // e.g., "Former Name", "Previous Flag"
 	Value string    `json:"value"` // The historical value
@@ -269,8 +418,38 @@ type PreparedFields struct {
 	NameFields    []string
 	AltNameFields [][]string
 
+	// NamePhonetics and AltNamePhonetics are the Double Metaphone codes of NameFields and AltNameFields.
+	// They are only computed when phonetic name scoring is enabled.
+	NamePhonetics    []stringscore.PhoneticCode
+	AltNamePhonetics [][]stringscore.PhoneticCode
+
+	// PersonName and AltPersonNames are person names split into given, family, etc names.
+	// They are computed for people and queries without a type.
+	PersonName     prepare.PersonName
+	AltPersonNames []prepare.PersonName
+
+	// NameVariants are alternate NameFields with nicknames, diminutives, etc swapped in.
+	// They are only computed when name variants are enabled.
+	NameVariants []NameVariant
+
+	// RomanizedNameFields are alternate NameFields with names written in Chinese, Korean or Japanese
+	// script spelled in Latin letters. They are only computed when CJK romanization is enabled.
+	RomanizedNameFields [][]string
+
+	// LegalForm is the legal form (e.g. "llc", "ooo") of the business name, or else of the first alt
+	// name with one. When a name has several, the one closest to the end is used. Legal forms are
+	// removed from NameFields and AltNameFields. They're only computed when legal form parsing is enabled.
+	LegalForm prepare.LegalForm
+
+	// Categories are the list categories of the entity, so searches can filter without computing them
+	Categories []ListCategory
+
 	Contact   ContactInfo
 	Addresses []PreparedAddress
+
+	// Phones and Faxes are the phone and fax numbers in their E.164 and national forms
+	Phones []norm.Phone
+	Faxes  []norm.Phone
 }
 
 type PreparedAddress struct {
@@ -286,76 +465,221 @@ type PreparedAddress struct {
 	PostalCode string `json:"postalCode"`
 	State      string `json:"state"`
 	Country    string `json:"country"` // ISO-3166 code
+
+	// Subdivision is the ISO 3166-2 code of the state or region (e.g. "UA-43" for Crimea)
+	Subdivision string `json:"subdivision"`
+	// SanctionedRegion is true when the address is in a region targeted by sanctions programs
+	SanctionedRegion bool `json:"sanctionedRegion"`
+
+	Latitude  float64 `json:"latitude"`
+	Longitude float64 `json:"longitude"`
 }
 
 func (e Entity[T]) Normalize() Entity[T] {
+	return e.NormalizeWith(nil)
+}
+
+// NormalizeWith is Normalize with analysis dictionaries which may not be in use yet, so entities can be
+// prepared before they're searched with the dictionaries. A nil analysis uses the dictionaries in use.
+func (e Entity[T]) NormalizeWith(analysis *prepare.Analysis) Entity[T] {
 	// Name
-	e.PreparedFields.Name = prepare.LowerAndRemovePunctuation(e.Name)
-	e.PreparedFields.NameFields = removeStopwords(e.PreparedFields.Name)
+	e.PreparedFields.Name = analysis.ExpandTerms(prepare.LowerAndRemovePunctuation(e.Name))
+	e.PreparedFields.NameFields = removeStopwords(analysis, e.nameWithoutLegalForm(e.PreparedFields.Name))
 
 	// Entity Type
 	if e.Person != nil {
-		e.PreparedFields.AltNames = normalizeNames(e.Person.AltNames)
+		e.PreparedFields.AltNames = normalizeNames(analysis, e.Person.AltNames)
 	}
 	if e.Business != nil {
-		e.PreparedFields.AltNames = normalizeNames(e.Business.AltNames)
+		e.PreparedFields.AltNames = normalizeNames(analysis, e.Business.AltNames)
 	}
 	if e.Organization != nil {
-		e.PreparedFields.AltNames = normalizeNames(e.Organization.AltNames)
+		e.PreparedFields.AltNames = normalizeNames(analysis, e.Organization.AltNames)
 	}
 	if e.Aircraft != nil {
-		e.PreparedFields.AltNames = normalizeNames(e.Aircraft.AltNames)
+		e.PreparedFields.AltNames = normalizeNames(analysis, e.Aircraft.AltNames)
 	}
 	if e.Vessel != nil {
-		e.PreparedFields.AltNames = normalizeNames(e.Vessel.AltNames)
+		e.PreparedFields.AltNames = normalizeNames(analysis, e.Vessel.AltNames)
 	}
 
 	// Alt Names
 	if len(e.PreparedFields.AltNames) > 0 {
 		e.PreparedFields.AltNameFields = make([][]string, len(e.PreparedFields.AltNames))
 		for idx := range e.PreparedFields.AltNames {
-			e.PreparedFields.AltNameFields[idx] = removeStopwords(e.PreparedFields.AltNames[idx])
+			e.PreparedFields.AltNameFields[idx] = removeStopwords(analysis, e.nameWithoutLegalForm(e.PreparedFields.AltNames[idx]))
+		}
+	}
+
+	// Person names
+	if personNameParsing && mayBePerson(e) {
+		cjk := hasCJKSignal(e)
+		e.PreparedFields.PersonName = prepare.ParsePersonName(e.Name, cjk)
+
+		if e.Person != nil && len(e.Person.AltNames) > 0 {
+			e.PreparedFields.AltPersonNames = make([]prepare.PersonName, len(e.Person.AltNames))
+			for idx := range e.Person.AltNames {
+				e.PreparedFields.AltPersonNames[idx] = prepare.ParsePersonName(e.Person.AltNames[idx], cjk)
+			}
+		}
+	}
+
+	// Name variants
+	if nameVariantsEnabled && mayBePerson(e) {
+		e.PreparedFields.NameVariants = generateNameVariants(e.PreparedFields.NameFields)
+	}
+
+	// Romanized names
+	if cjkRomanization {
+		e.PreparedFields.RomanizedNameFields = romanizeNames(analysis, append([]string{e.Name}, rawAltNames(e)...)...)
+	}
+
+	// Phonetic codes
+	if phoneticNameScoring {
+		e.PreparedFields.NamePhonetics = stringscore.EncodeDoubleMetaphones(e.PreparedFields.NameFields)
+
+		if len(e.PreparedFields.AltNameFields) > 0 {
+			e.PreparedFields.AltNamePhonetics = make([][]stringscore.PhoneticCode, len(e.PreparedFields.AltNameFields))
+			for idx := range e.PreparedFields.AltNameFields {
+				e.PreparedFields.AltNamePhonetics[idx] = stringscore.EncodeDoubleMetaphones(e.PreparedFields.AltNameFields[idx])
+			}
 		}
 	}
 
 	// Contact
-	e.PreparedFields.Contact.PhoneNumbers = normalizePhoneNumbers(e.Contact.PhoneNumbers)
-	e.PreparedFields.Contact.FaxNumbers = normalizePhoneNumbers(e.Contact.FaxNumbers)
+	// Local numbers are read as dialed from the contact's country, or else the entity's other countries
+	countries := e.countries()
+	if e.Contact.Country != "" {
+		countries = append([]string{e.Contact.Country}, countries...)
+	}
+	e.PreparedFields.Phones = parsePhoneNumbers(e.Contact.PhoneNumbers, countries)
+	e.PreparedFields.Faxes = parsePhoneNumbers(e.Contact.FaxNumbers, countries)
+
+	e.PreparedFields.Contact.PhoneNumbers = phoneNumberDigits(e.PreparedFields.Phones)
+	e.PreparedFields.Contact.FaxNumbers = phoneNumberDigits(e.PreparedFields.Faxes)
 
 	// Addresses
-	e.PreparedFields.Addresses = normalizeAddresses(e.Addresses)
+	e.PreparedFields.Addresses = normalizeAddresses(analysis, e.Addresses)
+
+	e.PreparedFields.Categories = e.Categories()
 
 	return e
 }
 
-func removeStopwords(input string) []string {
+// nameWithoutLegalForm removes legal forms from the names of businesses and organizations. The legal
+// form of the first name which has one is recorded in PreparedFields.
+func (e *Entity[T]) nameWithoutLegalForm(name string) string {
+	if !legalFormParsing || !mayBeBusiness(*e) {
+		return name
+	}
+
+	name, form := prepare.RemoveLegalForms(name)
+	if e.PreparedFields.LegalForm.Empty() {
+		e.PreparedFields.LegalForm = form
+	}
+	return name
+}
+
+// mayBeBusiness returns true for businesses, organizations and entities without a type.
+func mayBeBusiness[T any](e Entity[T]) bool {
+	switch e.Type {
+	case EntityBusiness, EntityOrganization:
+		return true
+	case emptyEntityType:
+		return e.Person == nil && e.Vessel == nil && e.Aircraft == nil
+	}
+	return false
+}
+
+// mayBePerson returns true for people and entities without a type, as queries often don't specify one.
+func mayBePerson[T any](e Entity[T]) bool {
+	return e.Type == EntityPerson || e.Type == emptyEntityType || e.Person != nil
+}
+
+// cjkCountries are the ISO 3166 codes of countries with Chinese or Korean names
+var cjkCountries = []string{"CN", "HK", "MO", "TW", "KR", "KP"}
+
+// hasCJKSignal returns true when an entity has a name written in Han or Hangul script or an
+// address or government ID from China, Taiwan or Korea.
+func hasCJKSignal[T any](e Entity[T]) bool {
+	for _, name := range append([]string{e.Name}, rawAltNames(e)...) {
+		if prepare.HasCJKScript(name) {
+			return true
+		}
+	}
+	for _, country := range e.countries() {
+		if slices.Contains(cjkCountries, norm.CountryCode(country)) {
+			return true
+		}
+	}
+	return false
+}
+
+func removeStopwords(analysis *prepare.Analysis, input string) []string {
 	if input == "" {
 		return nil
 	}
-	return strings.Fields(prepare.RemoveStopwords(input))
+	return prepare.TokenizeCJK(strings.Fields(analysis.RemoveStopwords(input)))
 }
 
-func normalizeNames(altNames []string) []string {
+func normalizeNames(analysis *prepare.Analysis, altNames []string) []string {
 	if len(altNames) == 0 {
 		return nil
 	}
 
 	out := make([]string, len(altNames))
 	for idx := range altNames {
-		out[idx] = prepare.LowerAndRemovePunctuation(altNames[idx])
+		out[idx] = analysis.ExpandTerms(prepare.LowerAndRemovePunctuation(altNames[idx]))
+	}
+	return out
+}
+
+// countries returns the countries of an entity's addresses and government IDs
+func (e Entity[T]) countries() []string {
+	var out []string
+	add := func(country string) {
+		if country != "" && !slices.Contains(out, country) {
+			out = append(out, country)
+		}
+	}
+	for _, addr := range e.Addresses {
+		add(addr.Country)
+	}
+	var ids []GovernmentID
+	switch {
+	case e.Person != nil:
+		ids = e.Person.GovernmentIDs
+	case e.Business != nil:
+		ids = e.Business.GovernmentIDs
+	case e.Organization != nil:
+		ids = e.Organization.GovernmentIDs
+	}
+	for _, id := range ids {
+		add(id.Country)
 	}
 	return out
 }
 
-func normalizePhoneNumbers(numbers []string) []string {
-	if len(numbers) == 0 {
+// phoneNumberDigits returns the digits of parsed numbers, with the calling code when their country is known
+func phoneNumberDigits(phones []norm.Phone) []string {
+	if len(phones) == 0 {
 		return nil
 	}
 
-	out := make([]string, len(numbers))
+	out := make([]string, len(phones))
+	for idx := range phones {
+		out[idx] = cmp.Or(strings.TrimPrefix(phones[idx].E164, "+"), phones[idx].National)
+	}
+	return out
+}
+
+// parsePhoneNumbers reads numbers written without a calling code as local numbers from one of countries
+func parsePhoneNumbers(numbers []string, countries []string) []norm.Phone {
+	var out []norm.Phone
 	for idx := range numbers {
-		// TODO(adam): pass in Country codes to help normalize phone numbers
-		out[idx] = norm.PhoneNumber(numbers[idx])
+		if phone := norm.ParsePhoneNumber(numbers[idx], countries...); !phone.Empty() {
+			out = append(out, phone)
+		}
 	}
 	return out
 }
@@ -364,26 +688,28 @@ var (
 	addressCleaner = strings.NewReplacer(",", "")
 )
 
-func normalizeAddresses(addresses []Address) []PreparedAddress {
+func normalizeAddresses(analysis *prepare.Analysis, addresses []Address) []PreparedAddress {
 	if len(addresses) == 0 {
 		return nil
 	}
 
 	out := make([]PreparedAddress, len(addresses))
 	for idx := range addresses {
-		out[idx] = normalizeAddress(addresses[idx])
+		out[idx] = normalizeAddress(analysis, addresses[idx])
 	}
 	return out
 }
 
-func normalizeAddress(addr Address) PreparedAddress {
+func normalizeAddress(analysis *prepare.Analysis, addr Address) PreparedAddress {
 	out := PreparedAddress{
-		Line1:      addressCleaner.Replace(strings.ToLower(addr.Line1)),
-		Line2:      addressCleaner.Replace(strings.ToLower(addr.Line2)),
-		City:       strings.ToLower(addr.City),
+		Line1:      analysis.ExpandTerms(addressCleaner.Replace(strings.ToLower(addr.Line1))),
+		Line2:      analysis.ExpandTerms(addressCleaner.Replace(strings.ToLower(addr.Line2))),
+		City:       analysis.ExpandTerms(strings.ToLower(addr.City)),
 		PostalCode: strings.ToLower(addr.PostalCode),
 		State:      strings.ToLower(addr.State),
 		Country:    strings.ToLower(norm.Country(addr.Country)),
+		Latitude:   addr.Latitude,
+		Longitude:  addr.Longitude,
 	}
 
 	if out.Line1 != "" {
@@ -395,6 +721,10 @@ func normalizeAddress(addr Address) PreparedAddress {
 	if out.City != "" {
 		out.CityFields = strings.Fields(out.City)
 	}
+	if sub, found := norm.LookupSubdivision(addr.Country, addr.State, addr.City); found {
+		out.Subdivision = sub.Code
+		out.SanctionedRegion = sub.Sanctioned
+	}
 
 	return out
 }
@@ -6,6 +6,9 @@ import (
 	"testing"
 	"time"
 
+	"github.com/moov-io/watchman/internal/norm"
+	"github.com/moov-io/watchman/internal/prepare"
+
 	"github.com/stretchr/testify/require"
 )
 
@@ -105,6 +108,7 @@ func TestEntity_Normalize(t *testing.T) {
 					Contact: ContactInfo{
 						PhoneNumbers: []string{"15551234567"},
 					},
+					Phones: []norm.Phone{{E164: "+15551234567", National: "5551234567"}},
 					Addresses: []PreparedAddress{
 						{
 							Line1:       "1234 broadway suite 500",
@@ -114,6 +118,7 @@ func TestEntity_Normalize(t *testing.T) {
 							PostalCode:  "10013",
 							State:       "ny",
 							Country:     "united states",
+							Subdivision: "US-NY",
 						},
 					},
 				},
@@ -183,3 +188,112 @@ func TestEntity_Normalize(t *testing.T) {
 		})
 	}
 }
+
+func TestEntity_Normalize_Analysis(t *testing.T) {
+	err := prepare.ConfigureAnalysis(prepare.AnalysisConfig{
+		Synonyms:      [][]string{{"international", "intl"}},
+		Abbreviations: map[string]string{"trdg": "trading", "st": "street"},
+	})
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		require.NoError(t, prepare.ConfigureAnalysis(prepare.AnalysisConfig{}))
+	})
+
+	query := Entity[any]{
+		Name:      "ACME Intl. Trdg",
+		Addresses: []Address{{Line1: "123 Main St.", City: "Anytown"}},
+	}.Normalize()
+	index := Entity[any]{
+		Name:      "Acme International Trading",
+		Addresses: []Address{{Line1: "123 Main Street", City: "Anytown"}},
+	}.Normalize()
+
+	require.Equal(t, index.PreparedFields.Name, query.PreparedFields.Name)
+	require.Equal(t, index.PreparedFields.NameFields, query.PreparedFields.NameFields)
+	require.Equal(t, index.PreparedFields.Addresses, query.PreparedFields.Addresses)
+}
+
+func TestEntity_Normalize_PhoneNumbers(t *testing.T) {
+	entity := Entity[any]{
+		Contact: ContactInfo{
+			PhoneNumbers: []string{"020 7946 0958", "+7 495 123-45-67"},
+			FaxNumbers:   []string{"+44 (0)20 7946 0000"},
+			Country:      "GB",
+		},
+	}.Normalize()
+
+	// Contact numbers are read the same as the parsed numbers
+	require.Equal(t, []string{"442079460958", "74951234567"}, entity.PreparedFields.Contact.PhoneNumbers)
+	require.Equal(t, "+442079460958", entity.PreparedFields.Phones[0].E164)
+	require.Equal(t, []string{"442079460000"}, entity.PreparedFields.Contact.FaxNumbers)
+}
+
+func TestDebarment_Expired(t *testing.T) {
+	now := time.Date(2024, time.June, 1, 0, 0, 0, 0, time.UTC)
+	ended := time.Date(2023, time.December, 31, 0, 0, 0, 0, time.UTC)
+	ending := time.Date(2026, time.December, 31, 0, 0, 0, 0, time.UTC)
+
+	var missing *Debarment
+	require.False(t, missing.Expired(now))
+
+	require.True(t, (&Debarment{To: &ended}).Expired(now))
+	require.False(t, (&Debarment{To: &ending}).Expired(now))
+	require.False(t, (&Debarment{}).Expired(now)) // indefinitely
+
+	require.True(t, SourceWorldBankDebarred.IsDebarment())
+	require.False(t, SourceUSOFAC.IsDebarment())
+}
+
+func TestPerson_TenureEnded(t *testing.T) {
+	start := time.Date(2010, time.January, 1, 0, 0, 0, 0, time.UTC)
+	ended := time.Date(2015, time.June, 30, 0, 0, 0, 0, time.UTC)
+	later := time.Date(2018, time.March, 1, 0, 0, 0, 0, time.UTC)
+
+	var missing *Person
+	require.Nil(t, missing.TenureEnded())
+	require.Nil(t, (&Person{Titles: []string{"Senator"}}).TenureEnded())
+
+	person := &Person{
+		Positions: []Position{
+			{Title: "Senator", Start: &start, End: &ended},
+			{Title: "Minister of Finance", End: &later},
+		},
+	}
+	require.Equal(t, later, *person.TenureEnded())
+
+	// Still holding a position
+	person.Positions = append(person.Positions, Position{Title: "Governor"})
+	require.Nil(t, person.TenureEnded())
+}
+
+func TestEntity_Categories(t *testing.T) {
+	sanctioned := Entity[Value]{Source: SourceUSOFAC}
+	require.Equal(t, []ListCategory{CategorySanctions}, sanctioned.Categories())
+
+	pep := Entity[Value]{Source: "opensanctions_peps", Topics: []string{TopicPEP}}
+	require.Equal(t, []ListCategory{CategoryPEP}, pep.Categories())
+	require.True(t, pep.InCategory(CategorySanctions, CategoryPEP))
+	require.False(t, pep.InCategory(CategorySanctions))
+
+	pep.Topics = append(pep.Topics, TopicSanction)
+	require.Equal(t, []ListCategory{CategorySanctions, CategoryPEP}, pep.Categories())
+
+	debarred := Entity[Value]{Source: SourceWorldBankDebarred}
+	require.Equal(t, []ListCategory{CategoryDebarment}, debarred.Categories())
+
+	// BIS lists in the US CSL are export controls rather than sanctions
+	bis := Entity[Value]{Source: SourceUSCSL, SubList: "EL", SanctionsInfo: &SanctionsInfo{}}
+	require.Equal(t, []ListCategory{CategoryExportControl}, bis.Categories())
+	require.False(t, bis.Normalize().InCategory(CategorySanctions))
+
+	ofac := Entity[Value]{Source: SourceUSCSL, SubList: "SDN", SanctionsInfo: &SanctionsInfo{}}
+	require.Equal(t, []ListCategory{CategorySanctions}, ofac.Categories())
+
+	require.Empty(t, Entity[Value]{Source: SourceAPIRequest}.Categories())
+}
+
+func TestEntity_hasCJKSignal(t *testing.T) {
+	require.False(t, hasCJKSignal(Entity[any]{Name: "Lee Myung Bak"}))
+	require.True(t, hasCJKSignal(Entity[any]{Name: "Lee Myung Bak", Addresses: []Address{{Country: "South Korea"}}}))
+	require.True(t, hasCJKSignal(Entity[any]{Name: "Lee Myung Bak", Person: &Person{AltNames: []string{"이명박"}}}))
+}
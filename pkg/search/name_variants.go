@@ -0,0 +1,52 @@
+package search
+
+import (
+	"os"
+	"strings"
+
+	"github.com/moov-io/base/strx"
+	"github.com/moov-io/watchman/internal/prepare"
+)
+
+var (
+	// nameVariantsEnabled generates alternate person names by swapping in nicknames, diminutives and
+	// other language equivalents (e.g. "Bill" and "William") of each name term.
+	nameVariantsEnabled = strx.Yes(os.Getenv("NAME_VARIANTS_ENABLED"))
+)
+
+const (
+	// maxVariantsPerTerm limits how many variants are generated for each term of a name
+	maxVariantsPerTerm = 10
+
+	// nameVariantPenalty is applied to scores from a name variant so the name as written still ranks higher
+	nameVariantPenalty = 0.97
+)
+
+// NameVariant is an alternate form of a name with one term swapped for a known variant.
+type NameVariant struct {
+	Fields []string
+
+	// Swapped describes the substitution, e.g. "bill=william"
+	Swapped string
+}
+
+func generateNameVariants(fields []string) []NameVariant {
+	var out []NameVariant
+	for idx, term := range fields {
+		variants := prepare.NameVariants(term)
+		if len(variants) > maxVariantsPerTerm {
+			variants = variants[:maxVariantsPerTerm]
+		}
+		for _, variant := range variants {
+			swapped := make([]string, len(fields))
+			copy(swapped, fields)
+			swapped[idx] = variant
+
+			out = append(out, NameVariant{
+				Fields:  swapped,
+				Swapped: strings.Join([]string{term, variant}, "="),
+			})
+		}
+	}
+	return out
+}
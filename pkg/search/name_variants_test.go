@@ -0,0 +1,25 @@
+package search
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestGenerateNameVariants(t *testing.T) {
+	// every term gets its own variants, even when an earlier term has many
+	variants := generateNameVariants([]string{"alexander", "bill", "ivanov"})
+
+	var first, second int
+	for _, v := range variants {
+		switch {
+		case v.Fields[0] != "alexander":
+			first++
+		case v.Fields[1] != "bill":
+			second++
+		}
+	}
+	require.Equal(t, maxVariantsPerTerm, first)
+	require.Greater(t, second, 0)
+	require.Contains(t, variants, NameVariant{Fields: []string{"alexander", "william", "ivanov"}, Swapped: "bill=william"})
+}
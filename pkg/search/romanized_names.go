@@ -0,0 +1,43 @@
+package search
+
+import (
+	"os"
+
+	"github.com/moov-io/base/strx"
+	"github.com/moov-io/watchman/internal/prepare"
+)
+
+var (
+	// cjkRomanization generates romanized spellings (Pinyin, Wade-Giles, Cantonese, Revised Romanization
+	// and Hepburn) of names written in Chinese, Korean or Japanese script.
+	cjkRomanization = strx.Yes(os.Getenv("CJK_ROMANIZATION_VARIANTS"))
+)
+
+const (
+	// romanizationPenalty is applied to scores from a romanized name as the reading of a name can be ambiguous
+	romanizationPenalty = 0.98
+)
+
+// romanizeNames returns the romanized spellings of each name as significant terms.
+// Names are read before normalization, which removes marks needed to read kana.
+func romanizeNames(analysis *prepare.Analysis, names ...string) [][]string {
+	var out [][]string
+	for _, name := range names {
+		for _, variant := range prepare.RomanizeCJK(name) {
+			out = append(out, removeStopwords(analysis, variant))
+		}
+	}
+	return out
+}
+
+func rawAltNames[T any](e Entity[T]) []string {
+	switch {
+	case e.Person != nil:
+		return e.Person.AltNames
+	case e.Business != nil:
+		return e.Business.AltNames
+	case e.Organization != nil:
+		return e.Organization.AltNames
+	}
+	return nil
+}
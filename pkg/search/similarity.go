@@ -53,6 +53,13 @@ func SimilarityWithTFIDF[Q any, I any](query Entity[Q], index Entity[I], tfidfIn
 	return DebugSimilarityWithTFIDF(nil, query, index, tfidfIndex).FinalScore
 }
 
+// NameSimilarity compares only the names of a query and an index entity, using the exact and fuzzy name
+// comparisons of Similarity. Alternate, parsed person and historical names of the index are included.
+// It's used where only a name is known, such as names found in free text.
+func NameSimilarity[Q any, I any](query Entity[Q], index Entity[I], tfidfIndex *tfidf.Index) float64 {
+	return compareNameWithTFIDF(nil, query, index, 1.0, tfidfIndex).Score
+}
+
 // DebugSimilarity does the same as Similarity, but logs debug info to w.
 //
 // The format written to w is not machine readable and is intended for humans to read.
@@ -247,6 +254,8 @@ type ScorePiece struct {
 	Exact          bool    `json:"exact"`          // whether it's an exact match
 	FieldsCompared int     `json:"fieldsCompared"` // how many fields were actually compared
 	PieceType      string  `json:"pieceType"`      // e.g. "identifiers", "name", etc.
+
+	NameVariant string `json:"nameVariant,omitempty"` // e.g. "bill=william" when a name variant produced the score
 }
 
 func boolToScore(b bool) float64 {
@@ -2,6 +2,7 @@ package search
 
 import (
 	"io"
+	"math"
 	"strings"
 
 	"github.com/moov-io/watchman/internal/stringscore"
@@ -17,6 +18,16 @@ const (
 	countryWeight = 4.0 // Country - critical for international
 )
 
+var (
+	// geoDistanceRadius is the distance (in kilometers) where two geocoded addresses are considered
+	// the same location. Scores decay exponentially beyond the radius.
+	geoDistanceRadius = readFloat("ADDRESS_GEODISTANCE_RADIUS_KM", 1.0)
+
+	// geoDistanceWeight is how much the distance between geocoded addresses counts
+	// relative to the address fields above.
+	geoDistanceWeight = readFloat("ADDRESS_GEODISTANCE_WEIGHT", 6.0)
+)
+
 func compareAddresses[Q any, I any](w io.Writer, query Entity[Q], index Entity[I], weight float64) ScorePiece {
 	fieldsCompared := 0
 	var scores []float64
@@ -107,15 +118,19 @@ func compareAddress(w io.Writer, query, index PreparedAddress) float64 {
 		}
 	}
 
-	// Compare state
-	if query.State != "" && index.State != "" {
+	// Compare state, by ISO 3166-2 subdivision when both are known so "Crimea" matches "Respublika Krym"
+	hasSubdivisions := query.Subdivision != "" && index.Subdivision != ""
+	if hasSubdivisions || (query.State != "" && index.State != "") {
 		match := strings.EqualFold(query.State, index.State)
+		if hasSubdivisions {
+			match = strings.EqualFold(query.Subdivision, index.Subdivision)
+		}
 		score := boolToScore(match)
 		totalScore += score * stateWeight
 		totalWeight += stateWeight
 		if w != nil {
-			debug(w, "  State: %.3f (weight: %.1f) [%s] vs [%s]\n",
-				score, stateWeight, query.State, index.State)
+			debug(w, "  State: %.3f (weight: %.1f) [%s] vs [%s] (%s vs %s)\n",
+				score, stateWeight, query.State, index.State, query.Subdivision, index.Subdivision)
 		}
 	}
 
@@ -144,6 +159,18 @@ func compareAddress(w io.Writer, query, index PreparedAddress) float64 {
 		}
 	}
 
+	// Compare coordinates
+	if hasCoordinates(query) && hasCoordinates(index) && geoDistanceWeight > 0 {
+		distance := HaversineDistance(query.Latitude, query.Longitude, index.Latitude, index.Longitude)
+		score := geoDistanceScore(distance)
+		totalScore += score * geoDistanceWeight
+		totalWeight += geoDistanceWeight
+		if w != nil {
+			debug(w, "  Distance: %.3f (weight: %.1f) [%.3fkm apart]\n",
+				score, geoDistanceWeight, distance)
+		}
+	}
+
 	if totalWeight == 0 {
 		if w != nil {
 			debug(w, "  No fields compared\n")
@@ -158,3 +185,35 @@ func compareAddress(w io.Writer, query, index PreparedAddress) float64 {
 	}
 	return finalScore
 }
+
+// hasCoordinates returns true when the address has been geocoded. (0, 0) is treated as missing.
+func hasCoordinates(addr PreparedAddress) bool {
+	return addr.Latitude != 0 || addr.Longitude != 0
+}
+
+const earthRadiusKm = 6371.0
+
+// HaversineDistance returns the great-circle distance in kilometers between two points.
+func HaversineDistance(lat1, lon1, lat2, lon2 float64) float64 {
+	toRadians := func(deg float64) float64 { return deg * math.Pi / 180 }
+
+	dLat := toRadians(lat2 - lat1)
+	dLon := toRadians(lon2 - lon1)
+
+	a := math.Sin(dLat/2)*math.Sin(dLat/2) +
+		math.Cos(toRadians(lat1))*math.Cos(toRadians(lat2))*math.Sin(dLon/2)*math.Sin(dLon/2)
+
+	return 2 * earthRadiusKm * math.Asin(math.Min(1, math.Sqrt(a)))
+}
+
+// geoDistanceScore is 1.0 within geoDistanceRadius and decays exponentially past it,
+// e.g. 0.37 at twice the radius and 0.05 at four times the radius.
+func geoDistanceScore(distanceKm float64) float64 {
+	if geoDistanceRadius <= 0 {
+		return boolToScore(distanceKm == 0)
+	}
+	if distanceKm <= geoDistanceRadius {
+		return 1.0
+	}
+	return math.Exp(-(distanceKm - geoDistanceRadius) / geoDistanceRadius)
+}
@@ -190,7 +190,7 @@ func TestCompareAddress(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			var buf bytes.Buffer
-			score := compareAddress(&buf, normalizeAddress(tt.query), normalizeAddress(tt.index))
+			score := compareAddress(&buf, normalizeAddress(nil, tt.query), normalizeAddress(nil, tt.index))
 
 			if testing.Verbose() {
 				fmt.Println(buf.String())
@@ -227,7 +227,7 @@ func TestCompareAddress_Normalized(t *testing.T) {
 			tt.index.Country = norm.Country(tt.index.Country)
 
 			var buf bytes.Buffer
-			score := compareAddress(&buf, normalizeAddress(tt.query), normalizeAddress(tt.index))
+			score := compareAddress(&buf, normalizeAddress(nil, tt.query), normalizeAddress(nil, tt.index))
 
 			if testing.Verbose() {
 				fmt.Println(buf.String())
@@ -239,6 +239,106 @@ func TestCompareAddress_Normalized(t *testing.T) {
 	}
 }
 
+func TestCompareAddress_GeoDistance(t *testing.T) {
+	// Tverskaya St 7, Moscow
+	query := Address{
+		Line1:     "Tverskaya St 7",
+		City:      "Moscow",
+		Country:   "RU",
+		Latitude:  55.7586,
+		Longitude: 37.6110,
+	}
+
+	t.Run("same building", func(t *testing.T) {
+		index := Address{
+			Line1:     "ul. Tverskaya, d. 7",
+			City:      "Moskva",
+			Country:   "Russia",
+			Latitude:  55.7588,
+			Longitude: 37.6113,
+		}
+		withoutCoords := index
+		withoutCoords.Latitude, withoutCoords.Longitude = 0, 0
+
+		var buf bytes.Buffer
+		score := compareAddress(&buf, normalizeAddress(nil, query), normalizeAddress(nil, index))
+		require.Contains(t, buf.String(), "Distance: 1.000")
+
+		stringScore := compareAddress(nil, normalizeAddress(nil, query), normalizeAddress(nil, withoutCoords))
+		require.Greater(t, score, stringScore)
+		require.Greater(t, score, 0.90)
+	})
+
+	t.Run("different city", func(t *testing.T) {
+		// Tverskaya St 7, Tver
+		index := Address{
+			Line1:     "Tverskaya St 7",
+			City:      "Tver",
+			Country:   "RU",
+			Latitude:  56.8587,
+			Longitude: 35.9176,
+		}
+		var buf bytes.Buffer
+		score := compareAddress(&buf, normalizeAddress(nil, query), normalizeAddress(nil, index))
+		require.Contains(t, buf.String(), "Distance: 0.000")
+		require.Less(t, score, 0.70)
+	})
+
+	t.Run("coordinates only", func(t *testing.T) {
+		index := Address{Latitude: 55.7700, Longitude: 37.6110} // ~1.3km north
+		score := compareAddress(nil, normalizeAddress(nil, Address{Latitude: query.Latitude, Longitude: query.Longitude}), normalizeAddress(nil, index))
+		require.InDelta(t, 0.765, score, 0.01)
+	})
+}
+
+func TestCompareAddress_Subdivision(t *testing.T) {
+	query := normalizeAddress(nil, Address{
+		Line1:   "Kirova St 12",
+		City:    "Simferopol",
+		State:   "Crimea",
+		Country: "Ukraine",
+	})
+	require.Equal(t, "UA-43", query.Subdivision)
+	require.True(t, query.SanctionedRegion)
+
+	index := normalizeAddress(nil, Address{
+		Line1:   "ul. Kirova 12",
+		City:    "Simferopol",
+		State:   "Respublika Krym",
+		Country: "Crimea",
+	})
+	require.Equal(t, "UA-43", index.Subdivision)
+	require.Equal(t, "ukraine", index.Country)
+
+	var buf bytes.Buffer
+	compareAddress(&buf, query, index)
+	require.Contains(t, buf.String(), "State: 1.000")
+
+	// Addresses in different regions
+	index = normalizeAddress(nil, Address{
+		City:    "Donetsk",
+		Country: "Ukraine",
+	})
+	require.Equal(t, "UA-14", index.Subdivision)
+
+	buf.Reset()
+	compareAddress(&buf, query, index)
+	require.Contains(t, buf.String(), "State: 0.000")
+
+	us := normalizeAddress(nil, Address{City: "Louisville", State: "KY", Country: "US"})
+	require.Equal(t, "US-KY", us.Subdivision)
+	require.False(t, us.SanctionedRegion)
+}
+
+func TestHaversineDistance(t *testing.T) {
+	// Moscow to Saint Petersburg
+	require.InDelta(t, 632.0, HaversineDistance(55.7558, 37.6173, 59.9311, 30.3609), 1.0)
+	require.InDelta(t, 0.0, HaversineDistance(55.7558, 37.6173, 55.7558, 37.6173), 0.001)
+
+	require.InDelta(t, 1.0, geoDistanceScore(0.5), 0.001)
+	require.InDelta(t, 0.368, geoDistanceScore(2*geoDistanceRadius), 0.001)
+}
+
 func TestCompareAddressesNoMatch(t *testing.T) {
 	var buf bytes.Buffer
 
@@ -292,7 +392,7 @@ func TestCompareAddressesNoMatch(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			score := compareAddress(&buf, normalizeAddress(tt.query), normalizeAddress(tt.index))
+			score := compareAddress(&buf, normalizeAddress(nil, tt.query), normalizeAddress(nil, tt.index))
 			require.InDelta(t, tt.expected, score, 0.001, "different addresses should have low similarity score: %.2f", score)
 		})
 	}
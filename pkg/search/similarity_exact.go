@@ -3,6 +3,8 @@ package search
 import (
 	"io"
 	"strings"
+
+	"github.com/moov-io/watchman/internal/norm"
 )
 
 // compareExactIdentifiers covers exact matches for identifiers across all entity types
@@ -566,15 +568,15 @@ func compareExactContactInfo[Q any, I any](w io.Writer, query Entity[Q], index E
 	}
 
 	// Compare phone numbers
-	if len(query.PreparedFields.Contact.PhoneNumbers) > 0 && len(index.PreparedFields.Contact.PhoneNumbers) > 0 {
+	if len(query.PreparedFields.Phones) > 0 && len(index.PreparedFields.Phones) > 0 {
 		fieldsCompared++
-		matches = append(matches, compareContactField(query.PreparedFields.Contact.PhoneNumbers, index.PreparedFields.Contact.PhoneNumbers))
+		matches = append(matches, comparePhoneNumbers(query.PreparedFields.Phones, index.PreparedFields.Phones))
 	}
 
 	// Compare fax numbers
-	if len(query.PreparedFields.Contact.FaxNumbers) > 0 && len(index.PreparedFields.Contact.FaxNumbers) > 0 {
+	if len(query.PreparedFields.Faxes) > 0 && len(index.PreparedFields.Faxes) > 0 {
 		fieldsCompared++
-		matches = append(matches, compareContactField(query.PreparedFields.Contact.FaxNumbers, index.PreparedFields.Contact.FaxNumbers))
+		matches = append(matches, comparePhoneNumbers(query.PreparedFields.Faxes, index.PreparedFields.Faxes))
 	}
 
 	if fieldsCompared == 0 {
@@ -633,3 +635,23 @@ func compareContactField(queryValues, indexValues []string) contactFieldMatch {
 		score:      score,
 	}
 }
+
+// comparePhoneNumbers counts the query numbers which match an index number in E.164 or national form
+func comparePhoneNumbers(queryNumbers, indexNumbers []norm.Phone) contactFieldMatch {
+	matches := 0
+
+	for q := range queryNumbers {
+		for i := range indexNumbers {
+			if queryNumbers[q].Matches(indexNumbers[i]) {
+				matches++
+				break
+			}
+		}
+	}
+
+	return contactFieldMatch{
+		matches:    matches,
+		totalQuery: len(queryNumbers),
+		score:      float64(matches) / float64(len(queryNumbers)),
+	}
+}
@@ -41,3 +41,31 @@ func TestCompareBusinessExactIDs(t *testing.T) {
 		})
 	}
 }
+
+func TestCompareExactContactInfo_PhoneCountries(t *testing.T) {
+	index := Entity[any]{
+		Contact: ContactInfo{
+			PhoneNumbers: []string{"020 7946 0958"},
+		},
+		Addresses: []Address{
+			{City: "London", Country: "United Kingdom"},
+		},
+	}.Normalize()
+
+	query := Entity[any]{
+		Contact: ContactInfo{
+			PhoneNumbers: []string{"+44 20 7946 0958"},
+		},
+	}.Normalize()
+
+	got := compareExactContactInfo(nil, query, index, 1.0)
+	require.True(t, got.Matched)
+	require.InDelta(t, 1.0, got.Score, 0.001)
+
+	// The same local number in Germany is a different phone
+	index.Addresses = []Address{{City: "Berlin", Country: "Germany"}}
+	index = index.Normalize()
+
+	got = compareExactContactInfo(nil, query, index, 1.0)
+	require.False(t, got.Matched)
+}
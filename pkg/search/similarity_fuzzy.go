@@ -3,9 +3,11 @@ package search
 import (
 	"io"
 	"math"
+	"os"
 	"regexp"
 	"strings"
 
+	"github.com/moov-io/base/strx"
 	"github.com/moov-io/watchman/internal/prepare"
 	"github.com/moov-io/watchman/internal/stringscore"
 	"github.com/moov-io/watchman/internal/tfidf"
@@ -20,6 +22,30 @@ const (
 	nameMatchThreshold = 0.85 // Overall name match threshold
 )
 
+var (
+	// phoneticNameScoring blends Double Metaphone similarity into name scores. This catches spelling
+	// variants (e.g. "Schmidt" and "Shmit") which Jaro-Winkler underrates.
+	phoneticNameScoring = strx.Yes(os.Getenv("PHONETIC_NAME_SCORING"))
+
+	// phoneticNameWeight is how far a higher phonetic score pulls the name score towards it.
+	phoneticNameWeight = readFloat("PHONETIC_NAME_WEIGHT", 0.35)
+
+	// personNameParsing splits person names into given, family, etc names so they can be
+	// compared regardless of the order they are written in.
+	personNameParsing = strx.Yes(os.Getenv("PERSON_NAME_PARSING"))
+
+	// familyNameWeight is the share of a parsed person name score given to the family name.
+	familyNameWeight = readFloat("FAMILY_NAME_WEIGHT", 0.5)
+
+	// legalFormParsing removes legal forms (e.g. "LLC", "OOO", "有限公司") from business names and
+	// records them so they can be compared on their own.
+	legalFormParsing = strx.Yes(os.Getenv("LEGAL_FORM_PARSING"))
+
+	// legalFormMismatchPenalty is applied to name scores when the legal forms of two businesses
+	// conflict (e.g. a partnership and a company).
+	legalFormMismatchPenalty = readFloat("LEGAL_FORM_MISMATCH_PENALTY", 0.95)
+)
+
 // nameMatch tracks detailed matching information
 type nameMatch struct {
 	score         float64
@@ -27,6 +53,8 @@ type nameMatch struct {
 	totalTerms    int
 	isExact       bool
 	isHistorical  bool
+	isRomanized   bool
+	nameVariant   string
 }
 
 func compareName[Q any, I any](w io.Writer, query Entity[Q], index Entity[I], weight float64) ScorePiece {
@@ -63,10 +91,35 @@ func compareNameWithTFIDF[Q any, I any](w io.Writer, query Entity[Q], index Enti
 		}
 	}
 
+	// Check parsed person names, which aligns family names written in different orders
+	if index.Type == EntityPerson || index.Person != nil {
+		if personMatch := comparePersonNames(query.PreparedFields, index.PreparedFields); personMatch.score > bestMatch.score {
+			debug(w, "parsed person name scored %.2f\n", personMatch.score)
+			bestMatch = personMatch
+		}
+	}
+
+	// Check name variants (nicknames, diminutives, etc)
+	if variantMatch := compareNameVariants(query.PreparedFields, index.PreparedFields, tfidfIndex); variantMatch.score > bestMatch.score {
+		debug(w, "name variant %s scored %.2f\n", variantMatch.nameVariant, variantMatch.score)
+		bestMatch = variantMatch
+	}
+
+	// Check romanized spellings of names written in Chinese, Korean or Japanese script
+	if romanMatch := compareRomanizedNames(query.PreparedFields, index.PreparedFields, tfidfIndex); romanMatch.score > bestMatch.score {
+		debug(w, "romanized name scored %.2f\n", romanMatch.score)
+		bestMatch = romanMatch
+	}
+
+	// Blend in phonetic similarity
+	if phoneticNameScoring {
+		bestMatch = blendPhoneticNameScore(w, bestMatch, query.PreparedFields, index.PreparedFields)
+	}
+
 	// Check historical names with penalty
 	for _, hist := range index.HistoricalInfo {
 		if strings.EqualFold(hist.Type, "Former Name") {
-			indexHistoricalTerms := strings.Fields(prepare.LowerAndRemovePunctuation(hist.Value))
+			indexHistoricalTerms := strings.Fields(prepare.ExpandTerms(prepare.LowerAndRemovePunctuation(hist.Value)))
 
 			histMatch := compareNameTermsWithTFIDF(query.PreparedFields.NameFields, indexHistoricalTerms, tfidfIndex)
 			histMatch.score *= 0.95 // Apply penalty for historical names
@@ -77,9 +130,15 @@ func compareNameWithTFIDF[Q any, I any](w io.Writer, query Entity[Q], index Enti
 		}
 	}
 
+	// Conflicting legal forms are a weak signal against a match
+	if queryForm, indexForm := query.PreparedFields.LegalForm, index.PreparedFields.LegalForm; legalFormsConflict(queryForm, indexForm) {
+		debug(w, "legal forms %s and %s conflict\n", queryForm.Abbreviation, indexForm.Abbreviation)
+		bestMatch.score *= legalFormMismatchPenalty
+	}
+
 	// Apply additional criteria for match quality
 	bestMatch.score = adjustScoreBasedOnQuality(bestMatch, len(query.PreparedFields.NameFields))
-	if !isNameCloseEnough(query.PreparedFields, index.PreparedFields) {
+	if !bestMatch.isRomanized && !isNameCloseEnough(query.PreparedFields, index.PreparedFields) {
 		bestMatch.score *= 0.85
 	}
 
@@ -91,6 +150,7 @@ func compareNameWithTFIDF[Q any, I any](w io.Writer, query Entity[Q], index Enti
 		Exact:          bestMatch.isExact,
 		FieldsCompared: 1,
 		PieceType:      "name",
+		NameVariant:    bestMatch.nameVariant,
 	}
 }
 
@@ -127,6 +187,141 @@ func compareNameTermsWithTFIDF(queryTerms, indexTerms []string, tfidfIndex *tfid
 	}
 }
 
+// comparePersonNames compares the family names and forenames of parsed person names separately.
+// Both the query and index need a family name and forename to be compared, and only scores where
+// both parts match are returned.
+func comparePersonNames(query, index PreparedFields) nameMatch {
+	var bestMatch nameMatch
+
+	q := query.PersonName
+	if len(q.Family) == 0 || len(q.Given) == 0 {
+		return bestMatch
+	}
+
+	check := func(idx prepare.PersonName) {
+		if len(idx.Family) == 0 || len(idx.Given) == 0 {
+			return
+		}
+
+		// Only names whose parts each match are kept, other names are left to the term comparison
+		familyScore := stringscore.BestPairsJaroWinkler(q.Family, idx.Family)
+		forenameScore := stringscore.BestPairsJaroWinkler(q.Forenames(), idx.Forenames())
+
+		// Patronymics are often left out, so they're only compared when both names have one
+		if len(q.Patronymic) > 0 && len(idx.Patronymic) > 0 {
+			forenameScore = (forenameScore + stringscore.BestPairsJaroWinkler(q.Patronymic, idx.Patronymic)) / 2.0
+		}
+		if familyScore < termMatchThreshold || forenameScore < termMatchThreshold {
+			return
+		}
+		score := familyScore*familyNameWeight + forenameScore*(1.0-familyNameWeight)
+
+		if score > bestMatch.score {
+			bestMatch = nameMatch{
+				score:      score,
+				totalTerms: len(query.NameFields),
+				isExact:    score > exactMatchThreshold,
+			}
+			if score > termMatchThreshold {
+				bestMatch.matchingTerms = len(query.NameFields)
+			}
+		}
+	}
+
+	check(index.PersonName)
+	for _, alt := range index.AltPersonNames {
+		check(alt)
+	}
+
+	return bestMatch
+}
+
+// compareNameVariants compares the query's name variants against the index names, and the index's
+// name variants against the query name.
+func compareNameVariants(query, index PreparedFields, tfidfIndex *tfidf.Index) nameMatch {
+	var bestMatch nameMatch
+
+	check := func(match nameMatch, variant string) {
+		match.score *= nameVariantPenalty
+		match.isExact = false
+		match.nameVariant = variant
+		if match.score > bestMatch.score {
+			bestMatch = match
+		}
+	}
+
+	for _, variant := range query.NameVariants {
+		check(compareNameTermsWithTFIDF(variant.Fields, index.NameFields, tfidfIndex), variant.Swapped)
+
+		for idx := range index.AltNameFields {
+			check(compareNameTermsWithTFIDF(variant.Fields, index.AltNameFields[idx], tfidfIndex), variant.Swapped)
+		}
+	}
+	for _, variant := range index.NameVariants {
+		check(compareNameTermsWithTFIDF(query.NameFields, variant.Fields, tfidfIndex), variant.Swapped)
+	}
+
+	return bestMatch
+}
+
+// legalFormsConflict returns true when both legal forms are known and belong to different categories.
+func legalFormsConflict(query, index prepare.LegalForm) bool {
+	if query.Empty() || index.Empty() {
+		return false
+	}
+	return query.Category != index.Category
+}
+
+// compareRomanizedNames compares the query's romanized names against the index names, and the
+// index's romanized names against the query name.
+func compareRomanizedNames(query, index PreparedFields, tfidfIndex *tfidf.Index) nameMatch {
+	var bestMatch nameMatch
+
+	check := func(match nameMatch) {
+		match.score *= romanizationPenalty
+		match.isExact = false
+		match.isRomanized = true
+		if match.score > bestMatch.score {
+			bestMatch = match
+		}
+	}
+
+	for _, fields := range query.RomanizedNameFields {
+		check(compareNameTermsWithTFIDF(fields, index.NameFields, tfidfIndex))
+
+		for idx := range index.AltNameFields {
+			check(compareNameTermsWithTFIDF(fields, index.AltNameFields[idx], tfidfIndex))
+		}
+	}
+	for _, fields := range index.RomanizedNameFields {
+		check(compareNameTermsWithTFIDF(query.NameFields, fields, tfidfIndex))
+	}
+
+	return bestMatch
+}
+
+// blendPhoneticNameScore raises the name score when the names sound alike, but never lowers it.
+func blendPhoneticNameScore(w io.Writer, match nameMatch, query, index PreparedFields) nameMatch {
+	phoneticScore := stringscore.BestPairsDoubleMetaphone(query.NamePhonetics, index.NamePhonetics)
+	for idx := range index.AltNamePhonetics {
+		if score := stringscore.BestPairsDoubleMetaphone(query.NamePhonetics, index.AltNamePhonetics[idx]); score > phoneticScore {
+			phoneticScore = score
+		}
+	}
+	if phoneticScore <= match.score {
+		return match
+	}
+
+	blended := match.score + (phoneticScore-match.score)*phoneticNameWeight
+	debug(w, "phonetic name score=%.2f blended %.2f into %.2f\n", phoneticScore, match.score, blended)
+
+	match.score = blended
+	if blended > termMatchThreshold {
+		match.matchingTerms = match.totalTerms
+	}
+	return match
+}
+
 // adjustScoreBasedOnQuality applies additional quality criteria
 func adjustScoreBasedOnQuality(match nameMatch, queryTermCount int) float64 {
 	// Require minimum number of matching terms for high scores
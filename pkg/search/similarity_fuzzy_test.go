@@ -194,6 +194,194 @@ func TestCompareName(t *testing.T) {
 	}
 }
 
+func TestCompareName_Phonetic(t *testing.T) {
+	query := Entity[any]{Name: "Hans Shmit"}
+	index := Entity[any]{Name: "Hans Schmidt"}
+
+	// Score without phonetics first
+	baseline := compareName(nil, query.Normalize(), index.Normalize(), 1.0)
+
+	phoneticNameScoring = true
+	t.Cleanup(func() { phoneticNameScoring = false })
+
+	var buf bytes.Buffer
+	result := compareName(&buf, query.Normalize(), index.Normalize(), 1.0)
+	require.Contains(t, buf.String(), "phonetic name score=1.00")
+
+	require.Greater(t, result.Score, baseline.Score)
+	require.True(t, result.Matched)
+	require.False(t, result.Exact)
+
+	// Names which don't sound alike are not changed
+	index = Entity[any]{Name: "Hans Jones"}
+	baseline = compareName(nil, query.Normalize(), index.Normalize(), 1.0)
+	result = compareName(nil, query.Normalize(), index.Normalize(), 1.0)
+	require.InDelta(t, baseline.Score, result.Score, 0.001)
+}
+
+func TestCompareName_PersonNameOrder(t *testing.T) {
+	cases := []struct {
+		query, index string
+	}{
+		{"Ivan Ivanov", "Ivanov Ivan Ivanovich"},
+		{"Nicolas Maduro", "MADURO MOROS, Nicolas"},
+		{"Gabriel Garcia", "Gabriel Garcia Marquez"},
+		{"Ayman Zawahiri", "AL ZAWAHIRI, Dr. Ayman"},
+	}
+	for _, tc := range cases {
+		t.Run(tc.query, func(t *testing.T) {
+			query := Entity[any]{Name: tc.query}
+			index := Entity[any]{Name: tc.index, Type: EntityPerson}
+
+			baseline := compareName(nil, query.Normalize(), index.Normalize(), 1.0)
+
+			personNameParsing = true
+			t.Cleanup(func() { personNameParsing = false })
+
+			var buf bytes.Buffer
+			result := compareName(&buf, query.Normalize(), index.Normalize(), 1.0)
+			require.Contains(t, buf.String(), "parsed person name scored")
+
+			require.Greater(t, result.Score, baseline.Score)
+			require.True(t, result.Matched)
+		})
+	}
+
+	personNameParsing = true
+	t.Cleanup(func() { personNameParsing = false })
+
+	// Names written family name first
+	for _, names := range [][]string{{"Jinping Xi", "Xi Jinping"}, {"Jong Un Kim", "Kim Jong Un"}} {
+		query := Entity[any]{Name: names[0]}
+		index := Entity[any]{Name: names[1], Type: EntityPerson}
+
+		result := compareName(nil, query.Normalize(), index.Normalize(), 1.0)
+		require.InDelta(t, 1.0, result.Score, 0.001)
+		require.True(t, result.Exact)
+	}
+
+	t.Run("different given names", func(t *testing.T) {
+		query := Entity[any]{Name: "John Doe"}
+		index := Entity[any]{Name: "Jane Doe", Type: EntityPerson}
+
+		var buf bytes.Buffer
+		result := compareName(&buf, query.Normalize(), index.Normalize(), 1.0)
+		require.NotContains(t, buf.String(), "parsed person name")
+		require.False(t, result.Exact)
+	})
+}
+
+func TestCompareName_NameVariants(t *testing.T) {
+	cases := []struct {
+		query, index string
+		variant      string
+	}{
+		{"Bill Clinton", "William Clinton", "bill=william"},
+		{"Sasha Ivanov", "Aleksandr Ivanov", "sasha=aleksandr"},
+		{"Aleksandr Ivanov", "Sasha Ivanov", "aleksandr=sasha"},
+	}
+	for _, tc := range cases {
+		t.Run(tc.query, func(t *testing.T) {
+			query := Entity[any]{Name: tc.query}
+			index := Entity[any]{Name: tc.index, Type: EntityPerson}
+
+			baseline := compareName(nil, query.Normalize(), index.Normalize(), 1.0)
+			require.Empty(t, baseline.NameVariant)
+
+			nameVariantsEnabled = true
+			t.Cleanup(func() { nameVariantsEnabled = false })
+
+			var buf bytes.Buffer
+			result := compareName(&buf, query.Normalize(), index.Normalize(), 1.0)
+			require.Contains(t, buf.String(), "name variant "+tc.variant)
+
+			require.Greater(t, result.Score, baseline.Score)
+			require.Greater(t, result.Score, 0.90)
+			require.True(t, result.Matched)
+			require.False(t, result.Exact)
+			require.Equal(t, tc.variant, result.NameVariant)
+		})
+	}
+
+	t.Run("businesses", func(t *testing.T) {
+		nameVariantsEnabled = true
+		t.Cleanup(func() { nameVariantsEnabled = false })
+
+		index := Entity[any]{Name: "Bill Holdings", Type: EntityBusiness}
+		require.Empty(t, index.Normalize().PreparedFields.NameVariants)
+	})
+}
+
+func TestCompareName_Romanized(t *testing.T) {
+	cases := []struct {
+		query, index string
+	}{
+		{"Xi Jinping", "习近平"},
+		{"Chan Tai Man", "陳大文"},
+		{"Hsi Chin-ping", "習近平"},
+		{"김정은", "Kim Jong Un"},
+		{"Yamada Taro", "やまだ たろう"},
+	}
+	for _, tc := range cases {
+		t.Run(tc.query, func(t *testing.T) {
+			query := Entity[any]{Name: tc.query}
+			index := Entity[any]{Name: tc.index, Type: EntityPerson}
+
+			baseline := compareName(nil, query.Normalize(), index.Normalize(), 1.0)
+
+			cjkRomanization = true
+			t.Cleanup(func() { cjkRomanization = false })
+
+			var buf bytes.Buffer
+			result := compareName(&buf, query.Normalize(), index.Normalize(), 1.0)
+			require.Contains(t, buf.String(), "romanized name scored")
+
+			require.Greater(t, result.Score, baseline.Score)
+			require.Greater(t, result.Score, 0.90)
+			require.True(t, result.Matched)
+			require.False(t, result.Exact)
+		})
+	}
+}
+
+func TestCompareName_LegalForms(t *testing.T) {
+	legalFormParsing = true
+	t.Cleanup(func() { legalFormParsing = false })
+
+	cases := []struct {
+		query, index string
+	}{
+		{"OOO Gazprom Export", "Gazprom Export LLC"},
+		{"Sberbank PJSC", "Public Joint Stock Company Sberbank"},
+		{"Siemens AG", "Siemens Aktiengesellschaft"},
+	}
+	for _, tc := range cases {
+		t.Run(tc.query, func(t *testing.T) {
+			query := Entity[any]{Name: tc.query}
+			index := Entity[any]{Name: tc.index, Type: EntityBusiness}
+
+			result := compareName(nil, query.Normalize(), index.Normalize(), 1.0)
+			require.InDelta(t, 1.0, result.Score, 0.001)
+			require.True(t, result.Matched)
+		})
+	}
+
+	t.Run("conflicting forms", func(t *testing.T) {
+		query := Entity[any]{Name: "Acme Trading LLP"}
+		index := Entity[any]{Name: "Acme Trading Inc", Type: EntityBusiness}
+
+		var buf bytes.Buffer
+		result := compareName(&buf, query.Normalize(), index.Normalize(), 1.0)
+		require.Contains(t, buf.String(), "legal forms llp and inc conflict")
+		require.InDelta(t, legalFormMismatchPenalty, result.Score, 0.001)
+		require.True(t, result.Matched)
+	})
+}
+
+func TestRemoveStopwords_CJK(t *testing.T) {
+	require.Equal(t, []string{"习", "近", "平"}, removeStopwords(nil, "习近平"))
+}
+
 func TestCompareEntityTitlesFuzzy(t *testing.T) {
 	var buf bytes.Buffer
 
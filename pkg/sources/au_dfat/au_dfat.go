@@ -0,0 +1,35 @@
+// Copyright The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package au_dfat
+
+// Record is one row of the Australian DFAT Consolidated List spreadsheet.
+//
+// Each name of a listing is its own row. Rows share a Reference number with a letter suffix
+// for each alias, such as "12", "12a" and "12b".
+type Record struct {
+	Reference string
+
+	// Name is the name of the individual, entity or vessel
+	Name string
+
+	// Type is "Individual", "Entity" or "Vessel"
+	Type string
+
+	// NameType is "Primary Name", "Alias" or "Original Script"
+	NameType string
+
+	DateOfBirth  string
+	PlaceOfBirth string
+	Citizenship  string
+	Address      string
+
+	AdditionalInformation string
+	ListingInformation    string
+	IMONumber             string
+
+	// Committees are the sanctions regimes the record is listed under
+	Committees  string
+	ControlDate string
+}
@@ -0,0 +1,11 @@
+// Copyright The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package au_dfat
+
+// DetailsURL returns the DFAT Consolidated List page.
+// Records can't be linked to directly, so the same page is returned for every record.
+func DetailsURL() string {
+	return "https://www.dfat.gov.au/international-relations/security/sanctions/consolidated-list"
+}
@@ -0,0 +1,33 @@
+// Copyright The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package au_dfat
+
+import (
+	"context"
+	"io"
+	"os"
+
+	"github.com/moov-io/base/log"
+	"github.com/moov-io/base/strx"
+	"github.com/moov-io/watchman/pkg/download"
+)
+
+var (
+	// Consolidated List from https://www.dfat.gov.au/international-relations/security/sanctions/consolidated-list
+	publicDFATListURL = "https://www.dfat.gov.au/sites/default/files/regulation8_consolidated.xlsx"
+	dfatListURL       = strx.Or(os.Getenv("AU_DFAT_DOWNLOAD_URL"), publicDFATListURL)
+)
+
+func DownloadSanctionsList(ctx context.Context, logger log.Logger, initialDir string) (map[string]io.ReadCloser, error) {
+	dl := download.New(logger, nil)
+
+	logger.Info().Logf("downloading Australian DFAT consolidated list from %s", dfatListURL)
+
+	dfatNameAndSource := map[string]string{
+		"regulation8_consolidated.xlsx": dfatListURL,
+	}
+
+	return dl.GetFiles(ctx, initialDir, dfatNameAndSource)
+}
@@ -0,0 +1,115 @@
+// Copyright The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package au_dfat
+
+import (
+	"context"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/moov-io/base/log"
+)
+
+func TestAUDFATDownload_initialDir(t *testing.T) {
+	dir, err := os.MkdirTemp("", "initial-dir")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	mk := func(t *testing.T, name string, body string) {
+		path := filepath.Join(dir, name)
+		if err := os.WriteFile(path, []byte(body), 0600); err != nil {
+			t.Fatalf("writing %s: %v", path, err)
+		}
+	}
+
+	// create each file
+	mk(t, "regulation8_consolidated.xlsx", "file=regulation8_consolidated.xlsx")
+
+	file, err := DownloadSanctionsList(context.Background(), log.NewNopLogger(), dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(file) == 0 {
+		t.Fatal("no DFAT Consolidated List file")
+	}
+
+	for fn, fd := range file {
+		if strings.EqualFold("regulation8_consolidated.xlsx", filepath.Base(fn)) {
+			_, err := io.ReadAll(fd)
+			if err != nil {
+				t.Fatal(err)
+			}
+		} else {
+			t.Fatalf("unknown file: %v", file)
+		}
+	}
+}
+
+// Test downloading from a file:// URL, as can be set with AU_DFAT_DOWNLOAD_URL
+func TestAUDFATDownload_fromURL(t *testing.T) {
+	f, err := os.CreateTemp("", "dfat-*.xlsx")
+	if err != nil {
+		t.Fatal(err)
+	}
+	content := "spreadsheet"
+	if _, err := f.WriteString(content); err != nil {
+		f.Close()
+		t.Fatal(err)
+	}
+	f.Close()
+	defer os.Remove(f.Name())
+
+	orig := dfatListURL
+	dfatListURL = "file://" + f.Name()
+	defer func() { dfatListURL = orig }()
+
+	dir, err := os.MkdirTemp("", "download-dir")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	files, err := DownloadSanctionsList(context.Background(), log.NewNopLogger(), dir)
+	if err != nil {
+		t.Fatalf("expected download success, got %v", err)
+	}
+	if len(files) != 1 {
+		t.Fatalf("expected exactly 1 file, got %d", len(files))
+	}
+
+	for fn, rc := range files {
+		if base := filepath.Base(fn); base != "regulation8_consolidated.xlsx" {
+			t.Errorf("unexpected filename %q", base)
+		}
+		buf, err := io.ReadAll(rc)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if string(buf) != content {
+			t.Errorf("downloaded content mismatch: %q", string(buf))
+		}
+	}
+}
+
+// Files which can't be downloaded are skipped rather than returning an error
+func TestAUDFATDownload_badURL(t *testing.T) {
+	orig := dfatListURL
+	defer func() { dfatListURL = orig }()
+
+	dfatListURL = "file:///nonexistent/path"
+
+	files, err := DownloadSanctionsList(context.Background(), log.NewNopLogger(), "")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(files) != 0 {
+		t.Errorf("expected no files for bad URL, got %d", len(files))
+	}
+}
@@ -0,0 +1,197 @@
+// Copyright The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package au_dfat
+
+import (
+	"strings"
+	"time"
+
+	"github.com/moov-io/watchman/internal/norm"
+	"github.com/moov-io/watchman/internal/xlsx"
+	"github.com/moov-io/watchman/pkg/search"
+)
+
+var (
+	dateLayouts = []string{"02/01/2006", "2/1/2006", "2006-01-02", "01/2006", "2006"}
+)
+
+// BaseReference returns the reference of a record's listing, which is the reference
+// without the letter suffix added for aliases. "12a" returns "12".
+func (r Record) BaseReference() string {
+	ref := strings.TrimSpace(r.Reference)
+	return strings.TrimRightFunc(ref, func(c rune) bool {
+		return (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z')
+	})
+}
+
+// GroupEntities combines the rows of each listing into one entity. Alias rows become alternate names.
+func GroupEntities(records []Record) []search.Entity[search.Value] {
+	var order []string
+	groups := make(map[string][]Record)
+	for _, record := range records {
+		ref := record.BaseReference()
+		if _, exists := groups[ref]; !exists {
+			order = append(order, ref)
+		}
+		groups[ref] = append(groups[ref], record)
+	}
+
+	out := make([]search.Entity[search.Value], 0, len(order))
+	for _, ref := range order {
+		out = append(out, ToEntity(groups[ref]))
+	}
+	return out
+}
+
+// ToEntity converts the rows of one listing into an entity. The "Primary Name" row is used
+// for details of the listing, or the first row when none is marked as primary.
+func ToEntity(records []Record) search.Entity[search.Value] {
+	if len(records) == 0 {
+		return search.Entity[search.Value]{}
+	}
+
+	primary := records[0]
+	for _, record := range records {
+		if strings.EqualFold(strings.TrimSpace(record.NameType), "Primary Name") {
+			primary = record
+			break
+		}
+	}
+
+	entity := search.Entity[search.Value]{
+		Name:       strings.TrimSpace(primary.Name),
+		Source:     search.SourceAUDFAT,
+		SourceID:   primary.BaseReference(),
+		SourceData: records,
+	}
+
+	var altNames []string
+	for _, record := range records {
+		if name := strings.TrimSpace(record.Name); name != "" && name != entity.Name {
+			altNames = append(altNames, name)
+		}
+	}
+
+	switch strings.ToLower(strings.TrimSpace(primary.Type)) {
+	case "individual":
+		entity.Type = search.EntityPerson
+		entity.Person = &search.Person{
+			Name:          entity.Name,
+			AltNames:      altNames,
+			BirthDate:     parseDate(primary.DateOfBirth),
+			PlaceOfBirth:  strings.TrimSpace(primary.PlaceOfBirth),
+			GovernmentIDs: mapCitizenship(primary.Citizenship),
+		}
+
+	case "vessel":
+		entity.Type = search.EntityVessel
+		entity.Vessel = &search.Vessel{
+			Name:      entity.Name,
+			AltNames:  altNames,
+			IMONumber: strings.TrimSpace(strings.TrimPrefix(strings.TrimSpace(primary.IMONumber), "IMO")),
+		}
+
+	default:
+		entity.Type = search.EntityBusiness
+		entity.Business = &search.Business{
+			Name:     entity.Name,
+			AltNames: altNames,
+		}
+	}
+
+	entity.Addresses = mapAddresses(primary.Address)
+	entity.SanctionsInfo = mapSanctionsInfo(primary)
+
+	return entity.Normalize()
+}
+
+// mapCitizenship reads the citizenship of individuals, which can list several countries
+func mapCitizenship(value string) []search.GovernmentID {
+	var out []search.GovernmentID
+	for _, country := range strings.Split(value, ";") {
+		if country = strings.TrimSpace(country); country != "" {
+			out = append(out, search.GovernmentID{
+				Type:       search.GovernmentIDCitizenship,
+				Country:    norm.Country(country),
+				Identifier: country,
+			})
+		}
+	}
+	return out
+}
+
+// mapAddresses reads the free text addresses of a listing. The country is the last part of each address.
+func mapAddresses(value string) []search.Address {
+	var out []search.Address
+	for _, addr := range strings.Split(value, ";") {
+		addr = strings.TrimSpace(addr)
+		if addr == "" {
+			continue
+		}
+
+		var address search.Address
+		if idx := strings.LastIndex(addr, ","); idx > 0 {
+			address.Line1 = strings.TrimSpace(addr[:idx])
+			address.Country = norm.Country(strings.TrimSpace(addr[idx+1:]))
+		} else {
+			address.Country = norm.Country(addr)
+		}
+		out = append(out, address)
+	}
+	return out
+}
+
+// mapSanctionsInfo uses the committees as programs and describes when the record was listed
+func mapSanctionsInfo(r Record) *search.SanctionsInfo {
+	var programs []string
+	for _, committee := range strings.Split(r.Committees, ";") {
+		if committee = strings.TrimSpace(committee); committee != "" {
+			programs = append(programs, committee)
+		}
+	}
+
+	var parts []string
+	if v := strings.TrimSpace(r.ListingInformation); v != "" {
+		parts = append(parts, v)
+	}
+	if v := strings.TrimSpace(r.AdditionalInformation); v != "" {
+		parts = append(parts, v)
+	}
+	if v := strings.TrimSpace(r.ControlDate); v != "" {
+		if t := parseDate(v); t != nil {
+			v = t.Format("2006-01-02")
+		}
+		parts = append(parts, "Control Date: "+v)
+	}
+
+	if len(programs) == 0 && len(parts) == 0 {
+		return nil
+	}
+	return &search.SanctionsInfo{
+		Programs:    programs,
+		Description: strings.Join(parts, "; "),
+	}
+}
+
+// parseDate reads dates of birth, which can be approximate like "circa 1961", list several dates
+// or be stored as a spreadsheet serial date. The first date which can be read is returned.
+func parseDate(value string) *time.Time {
+	for _, part := range strings.FieldsFunc(value, func(r rune) bool { return r == ';' || r == ',' }) {
+		part = strings.TrimSpace(strings.TrimPrefix(strings.ToLower(strings.TrimSpace(part)), "circa"))
+		if part == "" {
+			continue
+		}
+		for _, layout := range dateLayouts {
+			if t, err := time.Parse(layout, part); err == nil {
+				return &t
+			}
+		}
+		// Four digit years are also valid serial dates, so they're checked first
+		if t, ok := xlsx.ParseSerialDate(part); ok {
+			return &t
+		}
+	}
+	return nil
+}
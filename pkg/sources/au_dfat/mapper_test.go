@@ -0,0 +1,110 @@
+// Copyright The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package au_dfat
+
+import (
+	"testing"
+
+	"github.com/moov-io/watchman/pkg/search"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestRecord_BaseReference(t *testing.T) {
+	require.Equal(t, "12", Record{Reference: "12"}.BaseReference())
+	require.Equal(t, "12", Record{Reference: " 12a "}.BaseReference())
+	require.Equal(t, "12", Record{Reference: "12AB"}.BaseReference())
+	require.Equal(t, "", Record{}.BaseReference())
+}
+
+func TestToEntity_Individual(t *testing.T) {
+	records := []Record{
+		{Reference: "5a", Name: "Johnny DOE", Type: "Individual", NameType: "Alias"},
+		{
+			Reference:          "5",
+			Name:               "John DOE",
+			Type:               "Individual",
+			NameType:           "Primary Name",
+			DateOfBirth:        "02/12/1953",
+			PlaceOfBirth:       "Minsk, Belarus",
+			Citizenship:        "Belarus; Russia",
+			Address:            "1 Main Street, Minsk, Belarus",
+			ListingInformation: "Instrument 2022",
+			Committees:         "Belarus",
+			ControlDate:        "2022-09-02",
+		},
+		{Reference: "5b", Name: "John DOE", Type: "Individual", NameType: "Alias"},
+	}
+
+	entity := ToEntity(records)
+
+	require.Equal(t, search.SourceAUDFAT, entity.Source)
+	require.Equal(t, "5", entity.SourceID)
+	require.Equal(t, search.EntityPerson, entity.Type)
+	require.Equal(t, "John DOE", entity.Name)
+	require.NotNil(t, entity.Person)
+	require.Equal(t, []string{"Johnny DOE"}, entity.Person.AltNames)
+	require.Equal(t, "1953-12-02", entity.Person.BirthDate.Format("2006-01-02"))
+	require.Equal(t, "Minsk, Belarus", entity.Person.PlaceOfBirth)
+
+	expectedIDs := []search.GovernmentID{
+		{Type: search.GovernmentIDCitizenship, Country: "Belarus", Identifier: "Belarus"},
+		{Type: search.GovernmentIDCitizenship, Country: "Russia", Identifier: "Russia"},
+	}
+	require.Equal(t, expectedIDs, entity.Person.GovernmentIDs)
+
+	require.Len(t, entity.Addresses, 1)
+	require.Equal(t, "1 Main Street, Minsk", entity.Addresses[0].Line1)
+	require.Equal(t, "Belarus", entity.Addresses[0].Country)
+
+	require.NotNil(t, entity.SanctionsInfo)
+	require.Equal(t, []string{"Belarus"}, entity.SanctionsInfo.Programs)
+	require.Equal(t, "Instrument 2022; Control Date: 2022-09-02", entity.SanctionsInfo.Description)
+}
+
+func TestToEntity_Entity(t *testing.T) {
+	// The first row is used when none are marked as the primary name
+	records := []Record{
+		{Reference: "9", Name: "ACME Trading Co.", Type: "Entity", NameType: "Alias"},
+		{Reference: "9a", Name: "ACME", Type: "Entity", NameType: "Alias"},
+	}
+
+	entity := ToEntity(records)
+
+	require.Equal(t, "9", entity.SourceID)
+	require.Equal(t, search.EntityBusiness, entity.Type)
+	require.Equal(t, "ACME Trading Co.", entity.Name)
+	require.NotNil(t, entity.Business)
+	require.Equal(t, []string{"ACME"}, entity.Business.AltNames)
+	require.Nil(t, entity.SanctionsInfo)
+}
+
+func TestToEntity_Vessel(t *testing.T) {
+	records := []Record{
+		{Reference: "20", Name: "CHON MA SAN", Type: "Vessel", NameType: "Primary Name", IMONumber: "IMO 8660313", Committees: "DPRK"},
+	}
+
+	entity := ToEntity(records)
+
+	require.Equal(t, search.EntityVessel, entity.Type)
+	require.NotNil(t, entity.Vessel)
+	require.Equal(t, "CHON MA SAN", entity.Vessel.Name)
+	require.Equal(t, "8660313", entity.Vessel.IMONumber)
+	require.Equal(t, []string{"DPRK"}, entity.SanctionsInfo.Programs)
+}
+
+func TestToEntity_Empty(t *testing.T) {
+	require.Equal(t, search.Entity[search.Value]{}, ToEntity(nil))
+}
+
+func TestMapAddresses(t *testing.T) {
+	addresses := mapAddresses("Pyongyang, North Korea; ; Iran")
+	require.Len(t, addresses, 2)
+	require.Equal(t, "Pyongyang", addresses[0].Line1)
+	require.Equal(t, "", addresses[1].Line1)
+	require.Equal(t, "Iran", addresses[1].Country)
+
+	require.Empty(t, mapAddresses(""))
+}
@@ -0,0 +1,79 @@
+// Copyright The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package au_dfat
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/moov-io/watchman/internal/xlsx"
+)
+
+// columns maps the spreadsheet's headers to the Record fields they're read into
+var columns = map[string]func(*Record, string){
+	"reference":                    func(r *Record, v string) { r.Reference = v },
+	"name of individual or entity": func(r *Record, v string) { r.Name = v },
+	"type":                         func(r *Record, v string) { r.Type = v },
+	"name type":                    func(r *Record, v string) { r.NameType = v },
+	"date of birth":                func(r *Record, v string) { r.DateOfBirth = v },
+	"place of birth":               func(r *Record, v string) { r.PlaceOfBirth = v },
+	"citizenship":                  func(r *Record, v string) { r.Citizenship = v },
+	"address":                      func(r *Record, v string) { r.Address = v },
+	"additional information":       func(r *Record, v string) { r.AdditionalInformation = v },
+	"listing information":          func(r *Record, v string) { r.ListingInformation = v },
+	"imo number":                   func(r *Record, v string) { r.IMONumber = v },
+	"committees":                   func(r *Record, v string) { r.Committees = v },
+	"control date":                 func(r *Record, v string) { r.ControlDate = v },
+}
+
+// Read parses the records of the Consolidated List spreadsheet.
+//
+// Columns are found by their header, so the order of columns can change between releases.
+func Read(r io.Reader) ([]Record, error) {
+	bs, err := io.ReadAll(r)
+	if err != nil {
+		return nil, fmt.Errorf("reading DFAT spreadsheet: %w", err)
+	}
+
+	rows, err := xlsx.ReadFirstSheet(bytes.NewReader(bs), int64(len(bs)))
+	if err != nil {
+		return nil, fmt.Errorf("reading DFAT spreadsheet: %w", err)
+	}
+
+	// Find the header row, which can follow a title
+	headerIdx := -1
+	for i, row := range rows {
+		if len(row) > 0 && strings.EqualFold(strings.TrimSpace(row[0]), "reference") {
+			headerIdx = i
+			break
+		}
+	}
+	if headerIdx < 0 {
+		return nil, errors.New("DFAT spreadsheet has no header row")
+	}
+
+	setters := make([]func(*Record, string), len(rows[headerIdx]))
+	for i, header := range rows[headerIdx] {
+		setters[i] = columns[strings.ToLower(strings.TrimSpace(header))]
+	}
+
+	var out []Record
+	for _, row := range rows[headerIdx+1:] {
+		var record Record
+		for i, value := range row {
+			if i < len(setters) && setters[i] != nil {
+				setters[i](&record, strings.TrimSpace(value))
+			}
+		}
+		if record.Reference == "" && record.Name == "" {
+			continue // blank rows
+		}
+		out = append(out, record)
+	}
+	return out, nil
+}
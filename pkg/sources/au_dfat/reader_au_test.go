@@ -0,0 +1,116 @@
+// Copyright The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package au_dfat
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/moov-io/watchman/pkg/search"
+
+	"github.com/stretchr/testify/require"
+)
+
+func readTestRecords(t *testing.T) []Record {
+	t.Helper()
+
+	fd, err := os.Open(filepath.Join("testdata", "regulation8_consolidated.xlsx"))
+	require.NoError(t, err)
+	t.Cleanup(func() { fd.Close() })
+
+	records, err := Read(fd)
+	require.NoError(t, err)
+
+	return records
+}
+
+func TestRead(t *testing.T) {
+	records := readTestRecords(t)
+	require.Len(t, records, 8)
+
+	first := records[0]
+	require.Equal(t, "1", first.Reference)
+	require.Equal(t, "Abdul Ghani BARADAR", first.Name)
+	require.Equal(t, "Individual", first.Type)
+	require.Equal(t, "Primary Name", first.NameType)
+	require.Equal(t, "1968", first.DateOfBirth)
+	require.Equal(t, "1988 (Taliban)", first.Committees)
+	require.Equal(t, "45306", first.ControlDate)
+
+	require.Equal(t, "1a", records[1].Reference)
+	require.Equal(t, "1", records[1].BaseReference())
+	require.Equal(t, "8660313", records[7].IMONumber)
+
+	t.Run("not a spreadsheet", func(t *testing.T) {
+		_, err := Read(strings.NewReader("Reference,Name\n"))
+		require.ErrorContains(t, err, "reading DFAT spreadsheet")
+	})
+}
+
+func TestGroupEntities(t *testing.T) {
+	entities := GroupEntities(readTestRecords(t))
+	require.Len(t, entities, 4)
+
+	person := entities[1]
+	require.Equal(t, "Aleksandr Grigoryevich LUKASHENKO", person.Name)
+	require.Equal(t, search.EntityPerson, person.Type)
+	require.Equal(t, search.SourceAUDFAT, person.Source)
+	require.Equal(t, "2", person.SourceID)
+
+	require.NotNil(t, person.Person)
+	require.Equal(t, []string{"Alyaksandr LUKASHENKA", "Аляксандр Рыгоравіч ЛУКАШЭНКА"}, person.Person.AltNames)
+	require.Equal(t, time.Date(1954, time.August, 30, 0, 0, 0, 0, time.UTC), *person.Person.BirthDate)
+	require.Equal(t, "Kopys, Vitebsk, Belarus", person.Person.PlaceOfBirth)
+
+	expectedIDs := []search.GovernmentID{
+		{Type: search.GovernmentIDCitizenship, Country: "Belarus", Identifier: "Belarus"},
+	}
+	require.Equal(t, expectedIDs, person.Person.GovernmentIDs)
+
+	require.NotNil(t, person.SanctionsInfo)
+	require.Equal(t, []string{"Belarus", "Russia"}, person.SanctionsInfo.Programs)
+	require.Equal(t, "Instrument 2022; President of Belarus; Control Date: 2022-09-02", person.SanctionsInfo.Description)
+
+	// Birth years
+	require.Equal(t, 1968, entities[0].Person.BirthDate.Year())
+
+	business := entities[2]
+	require.Equal(t, search.EntityBusiness, business.Type)
+	require.NotNil(t, business.Business)
+	require.Equal(t, []string{"KOMID"}, business.Business.AltNames)
+	require.Len(t, business.Addresses, 1)
+	require.Equal(t, "Central District, Pyongyang", business.Addresses[0].Line1)
+
+	vessel := entities[3]
+	require.Equal(t, "CHON MA SAN", vessel.Name)
+	require.Equal(t, search.EntityVessel, vessel.Type)
+	require.NotNil(t, vessel.Vessel)
+	require.Equal(t, "8660313", vessel.Vessel.IMONumber)
+	require.Equal(t, []string{"DPRK"}, vessel.SanctionsInfo.Programs)
+}
+
+func TestParseDate(t *testing.T) {
+	cases := map[string]string{
+		"02/12/1953":           "1953-12-02",
+		"Circa 1960":           "1960-01-01",
+		"1955; 1956":           "1955-01-01",
+		"unknown, 01/02/1970":  "1970-02-01",
+		"19966":                "1954-08-30",
+		"":                     "",
+		"not a date of birth ": "",
+	}
+	for input, expected := range cases {
+		got := parseDate(input)
+		if expected == "" {
+			require.Nil(t, got, input)
+			continue
+		}
+		require.NotNil(t, got, input)
+		require.Equal(t, expected, got.Format("2006-01-02"), input)
+	}
+}
@@ -0,0 +1,40 @@
+// Copyright The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package ca_sema
+
+import (
+	"encoding/xml"
+)
+
+// DataSet is the root of the Consolidated Canadian Autonomous Sanctions List XML file
+type DataSet struct {
+	XMLName xml.Name `xml:"data-set"`
+	Records []Record `xml:"record"`
+}
+
+// Record is a person, entity or ship listed under the Special Economic Measures Act (SEMA)
+// or the Justice for Victims of Corrupt Foreign Officials Act (JVCFOA).
+type Record struct {
+	// Country is the regime the record is listed under, such as "Russia" or "Iran"
+	Country  string `xml:"Country"`
+	Schedule string `xml:"Schedule"`
+	Item     string `xml:"Item"`
+
+	DateOfListing string `xml:"DateOfListing"`
+
+	LastName  string `xml:"LastName"`
+	GivenName string `xml:"GivenName"`
+
+	// EntityOrShip is the name of listed entities and ships
+	EntityOrShip string `xml:"EntityOrShip"`
+
+	// TitleOrShip is a person's title, or the type of a ship
+	TitleOrShip   string `xml:"TitleOrShip"`
+	ShipIMONumber string `xml:"ShipIMONumber"`
+
+	DateOfBirthOrShipBuildDate string `xml:"DateOfBirthOrShipBuildDate"`
+
+	Aliases string `xml:"Aliases"`
+}
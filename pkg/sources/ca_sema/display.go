@@ -0,0 +1,11 @@
+// Copyright The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package ca_sema
+
+// DetailsURL returns the Global Affairs Canada consolidated sanctions list page.
+// Records can't be linked to directly, so the same page is returned for every record.
+func DetailsURL() string {
+	return "https://www.international.gc.ca/world-monde/international_relations-relations_internationales/sanctions/consolidated-consolide.aspx"
+}
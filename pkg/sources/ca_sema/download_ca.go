@@ -0,0 +1,33 @@
+// Copyright The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package ca_sema
+
+import (
+	"context"
+	"io"
+	"os"
+
+	"github.com/moov-io/base/log"
+	"github.com/moov-io/base/strx"
+	"github.com/moov-io/watchman/pkg/download"
+)
+
+var (
+	// Consolidated Canadian Autonomous Sanctions List from https://www.international.gc.ca/world-monde/international_relations-relations_internationales/sanctions/consolidated-consolide.aspx
+	publicSEMAListURL = "https://www.international.gc.ca/world-monde/assets/office_docs/international_relations-relations_internationales/sanctions/sema-lmes.xml"
+	semaListURL       = strx.Or(os.Getenv("CA_SEMA_DOWNLOAD_URL"), publicSEMAListURL)
+)
+
+func DownloadSanctionsList(ctx context.Context, logger log.Logger, initialDir string) (map[string]io.ReadCloser, error) {
+	dl := download.New(logger, nil)
+
+	logger.Info().Logf("downloading Canadian autonomous sanctions list from %s", semaListURL)
+
+	semaNameAndSource := map[string]string{
+		"sema-lmes.xml": semaListURL,
+	}
+
+	return dl.GetFiles(ctx, initialDir, semaNameAndSource)
+}
@@ -0,0 +1,136 @@
+// Copyright The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package ca_sema
+
+import (
+	"strings"
+	"time"
+
+	"github.com/moov-io/watchman/pkg/search"
+)
+
+var (
+	dateLayouts = []string{"2006-01-02", "2006-01", "2006"}
+)
+
+// SourceID identifies a record by its regime and where it's listed in the regulations,
+// such as "Russia, Schedule 1, Part 1, Item 12". Item numbers are only unique within a schedule.
+func (r Record) SourceID() string {
+	var parts []string
+	if v := strings.TrimSpace(r.Country); v != "" {
+		parts = append(parts, v)
+	}
+	if v := strings.TrimSpace(r.Schedule); v != "" {
+		parts = append(parts, "Schedule "+v)
+	}
+	if v := strings.TrimSpace(r.Item); v != "" {
+		parts = append(parts, "Item "+v)
+	}
+	return strings.Join(parts, ", ")
+}
+
+// ToEntity converts a Record to the Moov search.Entity format.
+func (r Record) ToEntity() search.Entity[search.Value] {
+	entity := search.Entity[search.Value]{
+		SourceID:   r.SourceID(),
+		Source:     search.SourceCASEMA,
+		SourceData: r,
+	}
+
+	altNames := splitAliases(r.Aliases)
+	dated := parseDate(r.DateOfBirthOrShipBuildDate)
+
+	givenName, lastName := strings.TrimSpace(r.GivenName), strings.TrimSpace(r.LastName)
+	switch {
+	case givenName != "" || lastName != "":
+		entity.Name = strings.TrimSpace(givenName + " " + lastName)
+		entity.Type = search.EntityPerson
+		entity.Person = &search.Person{
+			Name:      entity.Name,
+			AltNames:  altNames,
+			BirthDate: dated,
+		}
+		if title := strings.TrimSpace(r.TitleOrShip); title != "" {
+			entity.Person.Titles = []string{title}
+		}
+
+	case strings.TrimSpace(r.ShipIMONumber) != "":
+		entity.Name = strings.TrimSpace(r.EntityOrShip)
+		entity.Type = search.EntityVessel
+		entity.Vessel = &search.Vessel{
+			Name:      entity.Name,
+			AltNames:  altNames,
+			IMONumber: strings.TrimSpace(strings.TrimPrefix(strings.TrimSpace(r.ShipIMONumber), "IMO")),
+			Built:     dated,
+		}
+
+	default:
+		entity.Name = strings.TrimSpace(r.EntityOrShip)
+		entity.Type = search.EntityBusiness
+		entity.Business = &search.Business{
+			Name:     entity.Name,
+			AltNames: altNames,
+		}
+	}
+
+	entity.SanctionsInfo = mapSanctionsInfo(r)
+
+	return entity.Normalize()
+}
+
+// mapSanctionsInfo uses the regime's country as the program and describes where the record is listed
+func mapSanctionsInfo(r Record) *search.SanctionsInfo {
+	country := strings.TrimSpace(r.Country)
+	listedOn := strings.TrimSpace(r.DateOfListing)
+
+	if country == "" && listedOn == "" {
+		return nil
+	}
+
+	info := &search.SanctionsInfo{}
+	if country != "" {
+		info.Programs = []string{country}
+	}
+
+	var parts []string
+	if v := strings.TrimSpace(r.Schedule); v != "" {
+		parts = append(parts, "Schedule "+v)
+	}
+	if v := strings.TrimSpace(r.Item); v != "" {
+		parts = append(parts, "Item "+v)
+	}
+	if listedOn != "" {
+		parts = append(parts, "Listed On: "+listedOn)
+	}
+	info.Description = strings.Join(parts, "; ")
+
+	return info
+}
+
+// splitAliases separates the aliases of a record, which are listed in one field separated by semicolons.
+// Commas are part of aliases like "Doe, John" or "X Co., Ltd." so they aren't split on.
+func splitAliases(aliases string) []string {
+	var out []string
+	for _, alias := range strings.Split(aliases, ";") {
+		if alias = strings.TrimSpace(alias); alias != "" {
+			out = append(out, alias)
+		}
+	}
+	return out
+}
+
+// parseDate reads dates of birth and build dates, which can be approximate like "circa 1961"
+func parseDate(value string) *time.Time {
+	value = strings.TrimSpace(strings.TrimPrefix(strings.ToLower(strings.TrimSpace(value)), "circa"))
+	if value == "" {
+		return nil
+	}
+	for _, layout := range dateLayouts {
+		if t, err := time.Parse(layout, value); err == nil {
+			return &t
+		}
+	}
+	return nil
+}
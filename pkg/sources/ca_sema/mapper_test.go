@@ -0,0 +1,108 @@
+// Copyright The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package ca_sema
+
+import (
+	"testing"
+	"time"
+
+	"github.com/moov-io/watchman/pkg/search"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestToEntity_Person(t *testing.T) {
+	record := Record{
+		Country:                    "Russia",
+		Schedule:                   "1, Part 1",
+		Item:                       "12",
+		DateOfListing:              "2022-02-24",
+		LastName:                   "Doe",
+		GivenName:                  "John",
+		TitleOrShip:                "Deputy Minister",
+		DateOfBirthOrShipBuildDate: "circa 1970",
+		Aliases:                    "Doe, John; Ivan Doev",
+	}
+
+	entity := record.ToEntity()
+
+	require.Equal(t, search.SourceCASEMA, entity.Source)
+	require.Equal(t, "Russia, Schedule 1, Part 1, Item 12", entity.SourceID)
+	require.Equal(t, search.EntityPerson, entity.Type)
+	require.Equal(t, "John Doe", entity.Name)
+	require.NotNil(t, entity.Person)
+	require.Equal(t, []string{"Doe, John", "Ivan Doev"}, entity.Person.AltNames)
+	require.Equal(t, []string{"Deputy Minister"}, entity.Person.Titles)
+	require.Equal(t, 1970, entity.Person.BirthDate.Year())
+
+	require.NotNil(t, entity.SanctionsInfo)
+	require.Equal(t, []string{"Russia"}, entity.SanctionsInfo.Programs)
+	require.Equal(t, "Schedule 1, Part 1; Item 12; Listed On: 2022-02-24", entity.SanctionsInfo.Description)
+}
+
+func TestToEntity_Business(t *testing.T) {
+	record := Record{
+		Country:      "Iran",
+		Schedule:     "1, Part 2",
+		Item:         "7",
+		EntityOrShip: "X Company Limited",
+		Aliases:      "X Co., Ltd.; ; X Trading",
+	}
+
+	entity := record.ToEntity()
+
+	require.Equal(t, search.EntityBusiness, entity.Type)
+	require.Equal(t, "X Company Limited", entity.Name)
+	require.NotNil(t, entity.Business)
+	require.Equal(t, []string{"X Co., Ltd.", "X Trading"}, entity.Business.AltNames)
+	require.Equal(t, "Schedule 1, Part 2; Item 7", entity.SanctionsInfo.Description)
+}
+
+func TestToEntity_Vessel(t *testing.T) {
+	record := Record{
+		Country:                    "Russia",
+		Schedule:                   "2",
+		Item:                       "14",
+		EntityOrShip:               "NS Champion",
+		TitleOrShip:                "Crude Oil Tanker",
+		ShipIMONumber:              "IMO 9316115",
+		DateOfBirthOrShipBuildDate: "2006",
+	}
+
+	entity := record.ToEntity()
+
+	require.Equal(t, search.EntityVessel, entity.Type)
+	require.Equal(t, "NS Champion", entity.Name)
+	require.NotNil(t, entity.Vessel)
+	require.Equal(t, "9316115", entity.Vessel.IMONumber)
+	require.Equal(t, 2006, entity.Vessel.Built.Year())
+	require.Empty(t, entity.Vessel.AltNames)
+}
+
+func TestSplitAliases(t *testing.T) {
+	cases := []struct {
+		input    string
+		expected []string
+	}{
+		{"", nil},
+		{"IRGC", []string{"IRGC"}},
+		{"PAO Sovcomflot; SCF Group", []string{"PAO Sovcomflot", "SCF Group"}},
+		{"Doe, John", []string{"Doe, John"}},
+		{"X Co., Ltd.;Doe, John ;", []string{"X Co., Ltd.", "Doe, John"}},
+	}
+	for _, tc := range cases {
+		t.Run(tc.input, func(t *testing.T) {
+			require.Equal(t, tc.expected, splitAliases(tc.input))
+		})
+	}
+}
+
+func TestParseDate(t *testing.T) {
+	require.Nil(t, parseDate(""))
+	require.Nil(t, parseDate("unknown"))
+	require.Equal(t, time.Date(1954, time.August, 30, 0, 0, 0, 0, time.UTC), *parseDate("1954-08-30"))
+	require.Equal(t, time.Date(1961, time.January, 1, 0, 0, 0, 0, time.UTC), *parseDate("Circa 1961"))
+	require.Equal(t, time.Date(1980, time.May, 1, 0, 0, 0, 0, time.UTC), *parseDate("1980-05"))
+}
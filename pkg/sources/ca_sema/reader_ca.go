@@ -0,0 +1,45 @@
+// Copyright The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package ca_sema
+
+import (
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+)
+
+// Reader streams the records of the Consolidated Canadian Autonomous Sanctions List.
+type Reader struct {
+	r io.Reader
+}
+
+func NewReader(r io.Reader) *Reader {
+	return &Reader{r: r}
+}
+
+// Read calls fn for each record found in the XML.
+func (r *Reader) Read(fn func(Record)) error {
+	decoder := xml.NewDecoder(r.r)
+
+	for {
+		token, err := decoder.Token()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return fmt.Errorf("reading SEMA XML: %w", err)
+		}
+
+		if se, ok := token.(xml.StartElement); ok && se.Name.Local == "record" {
+			var record Record
+			if err := decoder.DecodeElement(&record, &se); err != nil {
+				return fmt.Errorf("reading SEMA record: %w", err)
+			}
+			fn(record)
+		}
+	}
+	return nil
+}
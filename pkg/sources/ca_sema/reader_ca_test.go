@@ -0,0 +1,74 @@
+// Copyright The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package ca_sema
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/moov-io/watchman/pkg/search"
+
+	"github.com/stretchr/testify/require"
+)
+
+func readTestEntities(t *testing.T) []search.Entity[search.Value] {
+	t.Helper()
+
+	fd, err := os.Open(filepath.Join("testdata", "sema-lmes.xml"))
+	require.NoError(t, err)
+	t.Cleanup(func() { fd.Close() })
+
+	var entities []search.Entity[search.Value]
+	err = NewReader(fd).Read(func(record Record) {
+		entities = append(entities, record.ToEntity())
+	})
+	require.NoError(t, err)
+
+	return entities
+}
+
+func TestReader(t *testing.T) {
+	entities := readTestEntities(t)
+	require.Len(t, entities, 6)
+
+	person := entities[0]
+	require.Equal(t, "Aleksandr Grigoryevich Lukashenko", person.Name)
+	require.Equal(t, search.EntityPerson, person.Type)
+	require.Equal(t, search.SourceCASEMA, person.Source)
+	require.Equal(t, "Belarus, Schedule 1, Part 1, Item 1", person.SourceID)
+	require.NotNil(t, person.Person)
+	require.Equal(t, []string{"Alyaksandr Lukashenka", "Alexander Lukashenko"}, person.Person.AltNames)
+	require.Equal(t, []string{"President of Belarus"}, person.Person.Titles)
+	require.Equal(t, time.Date(1954, time.August, 30, 0, 0, 0, 0, time.UTC), *person.Person.BirthDate)
+
+	require.NotNil(t, person.SanctionsInfo)
+	require.Equal(t, []string{"Belarus"}, person.SanctionsInfo.Programs)
+	require.Equal(t, "Schedule 1, Part 1; Item 1; Listed On: 2020-09-29", person.SanctionsInfo.Description)
+
+	// Approximate dates of birth
+	require.Equal(t, 1961, entities[1].Person.BirthDate.Year())
+
+	business := entities[2]
+	require.Equal(t, "Sovcomflot", business.Name)
+	require.Equal(t, search.EntityBusiness, business.Type)
+	require.NotNil(t, business.Business)
+	require.Equal(t, []string{"PAO Sovcomflot", "SCF Group"}, business.Business.AltNames)
+
+	vessel := entities[3]
+	require.Equal(t, "NS Champion", vessel.Name)
+	require.Equal(t, search.EntityVessel, vessel.Type)
+	require.NotNil(t, vessel.Vessel)
+	require.Equal(t, "9316115", vessel.Vessel.IMONumber)
+	require.Equal(t, 2006, vessel.Vessel.Built.Year())
+	require.Equal(t, "Russia, Schedule 2, Item 14", vessel.SourceID)
+}
+
+func TestReader_malformed(t *testing.T) {
+	err := NewReader(strings.NewReader(`<data-set><record><Country>Russia`)).Read(func(Record) {})
+	require.Error(t, err)
+}
@@ -0,0 +1,186 @@
+// Copyright The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package ch_seco
+
+import (
+	"encoding/xml"
+)
+
+// SanctionsList is the root of SECO's Swiss sanctions list XML file.
+//
+// Programs and places are listed once and referenced by their ssid from each target.
+type SanctionsList struct {
+	XMLName  xml.Name  `xml:"swiss-sanctions-list"`
+	Date     string    `xml:"date,attr"`
+	ListType string    `xml:"list-type,attr"`
+	Programs []Program `xml:"sanctions-program"`
+	Targets  []Target  `xml:"target"`
+	Places   []Place   `xml:"place"`
+}
+
+// Program is a sanctions program, such as the ordinance on measures against Russia
+type Program struct {
+	SSID        string         `xml:"ssid,attr"`
+	VersionDate string         `xml:"version-date,attr"`
+	Keys        []LangValue    `xml:"program-key"`
+	Names       []LangValue    `xml:"program-name"`
+	Sets        []SanctionsSet `xml:"sanctions-set"`
+	Origin      []string       `xml:"origin"`
+}
+
+// SanctionsSet is a group of measures within a program, such as "Art. 15 para. 1 (Financial sanctions)"
+type SanctionsSet struct {
+	SSID  string `xml:"ssid,attr"`
+	Lang  string `xml:"lang,attr"`
+	Value string `xml:",chardata"`
+}
+
+type LangValue struct {
+	Lang  string `xml:"lang,attr"`
+	Value string `xml:",chardata"`
+}
+
+// Target is a listed individual, entity or object
+type Target struct {
+	SSID            string   `xml:"ssid,attr"`
+	SanctionsSetIDs []string `xml:"sanctions-set-id"`
+
+	Modifications []Modification `xml:"modification"`
+
+	Individual *Individual `xml:"individual"`
+	Entity     *Entity     `xml:"entity"`
+	Object     *Object     `xml:"object"`
+
+	ForeignIdentifiers []string           `xml:"foreign-identifier"`
+	GenericAttributes  []GenericAttribute `xml:"generic-attribute"`
+}
+
+// Modification records when a target was listed, amended or delisted
+type Modification struct {
+	SSID             string `xml:"ssid,attr"`
+	ModificationType string `xml:"modification-type,attr"`
+	EnactmentDate    string `xml:"enactment-date,attr"`
+	PublicationDate  string `xml:"publication-date,attr"`
+	EffectiveDate    string `xml:"effective-date,attr"`
+}
+
+type Individual struct {
+	Sex string `xml:"sex,attr"`
+	targetDetails
+}
+
+type Entity struct {
+	targetDetails
+}
+
+// Object is a listed vessel, aircraft or other object
+type Object struct {
+	ObjectType string `xml:"object-type,attr"`
+	targetDetails
+}
+
+type targetDetails struct {
+	Identities       []Identity `xml:"identity"`
+	Justifications   []string   `xml:"justification"`
+	Relations        []Relation `xml:"relation"`
+	OtherInformation []string   `xml:"other-information"`
+}
+
+// Identity is one identity of a target. The main identity holds the primary name,
+// other identities are usually aliases with their own documents.
+type Identity struct {
+	SSID string `xml:"ssid,attr"`
+	Main bool   `xml:"main,attr"`
+
+	Names         []Name                   `xml:"name"`
+	DatesOfBirth  []DayMonthYear           `xml:"day-month-year"`
+	PlacesOfBirth []PlaceReference         `xml:"place-of-birth"`
+	Nationalities []Nationality            `xml:"nationality"`
+	Addresses     []Address                `xml:"address"`
+	Documents     []IdentificationDocument `xml:"identification-document"`
+}
+
+// Name is made of parts, such as a given and family name, which are joined in order
+type Name struct {
+	SSID     string     `xml:"ssid,attr"`
+	NameType string     `xml:"name-type,attr"`
+	Quality  string     `xml:"quality,attr"`
+	Parts    []NamePart `xml:"name-part"`
+}
+
+type NamePart struct {
+	Order            int               `xml:"order,attr"`
+	NamePartType     string            `xml:"name-part-type,attr"`
+	Value            string            `xml:"value"`
+	SpellingVariants []SpellingVariant `xml:"spelling-variant"`
+}
+
+// SpellingVariant is a name part in another language or script
+type SpellingVariant struct {
+	Lang   string `xml:"lang,attr"`
+	Script string `xml:"script,attr"`
+	Value  string `xml:",chardata"`
+}
+
+type DayMonthYear struct {
+	Day   string `xml:"day,attr"`
+	Month string `xml:"month,attr"`
+	Year  string `xml:"year,attr"`
+}
+
+type PlaceReference struct {
+	PlaceID string `xml:"place-id,attr"`
+}
+
+type Nationality struct {
+	Main    bool    `xml:"main,attr"`
+	Country Country `xml:"country"`
+}
+
+type Country struct {
+	ISOCode string `xml:"iso-code,attr"`
+	Name    string `xml:",chardata"`
+}
+
+type Address struct {
+	PlaceID string `xml:"place-id,attr"`
+	Details string `xml:"address-details"`
+	ZipCode string `xml:"zip-code"`
+	CareOf  string `xml:"c-o"`
+	POBox   string `xml:"p-o-box"`
+	Remark  string `xml:"remark"`
+}
+
+// IdentificationDocument is a passport, identity card or other document such as a vessel's IMO number
+type IdentificationDocument struct {
+	SSID         string  `xml:"ssid,attr"`
+	DocumentType string  `xml:"document-type,attr"`
+	Number       string  `xml:"number"`
+	Issuer       Country `xml:"issuer"`
+	DateOfIssue  string  `xml:"date-of-issue"`
+	ExpiryDate   string  `xml:"expiry-date"`
+	Remark       string  `xml:"remark"`
+}
+
+// Relation links a target to another target, such as a person to the entity they lead
+type Relation struct {
+	SSID         string `xml:"ssid,attr"`
+	TargetID     string `xml:"target-id,attr"`
+	RelationType string `xml:"relation-type,attr"`
+	Remark       string `xml:"remark"`
+}
+
+type GenericAttribute struct {
+	Name  string `xml:"name,attr"`
+	Value string `xml:",chardata"`
+}
+
+// Place is a location referenced by addresses and places of birth
+type Place struct {
+	SSID     string  `xml:"ssid,attr"`
+	Location string  `xml:"location"`
+	Area     string  `xml:"area"`
+	Country  Country `xml:"country"`
+}
@@ -0,0 +1,11 @@
+// Copyright The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package ch_seco
+
+// DetailsURL returns SECO's sanctions search (SESAM).
+// Targets can't be linked to directly, so the search page is returned for every target.
+func DetailsURL() string {
+	return "https://www.sesam.search.admin.ch/sesam-search-web/pages/search.xhtml?lang=en"
+}
@@ -0,0 +1,16 @@
+// Copyright The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package ch_seco
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestDetailsURL(t *testing.T) {
+	url := DetailsURL()
+	require.Equal(t, "https://www.sesam.search.admin.ch/sesam-search-web/pages/search.xhtml?lang=en", url)
+}
@@ -0,0 +1,33 @@
+// Copyright The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package ch_seco
+
+import (
+	"context"
+	"io"
+	"os"
+
+	"github.com/moov-io/base/log"
+	"github.com/moov-io/base/strx"
+	"github.com/moov-io/watchman/pkg/download"
+)
+
+var (
+	// Swiss sanctions list from https://www.seco.admin.ch/seco/en/home/Aussenwirtschaftspolitik_Wirtschaftliche_Zusammenarbeit/Wirtschaftsbeziehungen/exportkontrollen-und-sanktionen/sanktionen-embargos.html
+	publicSECOListURL = "https://www.sesam.search.admin.ch/sesam-search-web/pages/downloadXmlGesamtliste.xhtml?lang=en&action=downloadXmlGesamtlisteAction"
+	secoListURL       = strx.Or(os.Getenv("CH_SECO_DOWNLOAD_URL"), publicSECOListURL)
+)
+
+func DownloadSanctionsList(ctx context.Context, logger log.Logger, initialDir string) (map[string]io.ReadCloser, error) {
+	dl := download.New(logger, nil)
+
+	logger.Info().Logf("downloading Swiss SECO sanctions list from %s", secoListURL)
+
+	secoNameAndSource := map[string]string{
+		"swiss_sanctions_list.xml": secoListURL,
+	}
+
+	return dl.GetFiles(ctx, initialDir, secoNameAndSource)
+}
@@ -0,0 +1,115 @@
+// Copyright The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package ch_seco
+
+import (
+	"context"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/moov-io/base/log"
+)
+
+func TestCHSECODownload_initialDir(t *testing.T) {
+	dir, err := os.MkdirTemp("", "initial-dir")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	mk := func(t *testing.T, name string, body string) {
+		path := filepath.Join(dir, name)
+		if err := os.WriteFile(path, []byte(body), 0600); err != nil {
+			t.Fatalf("writing %s: %v", path, err)
+		}
+	}
+
+	// create each file
+	mk(t, "swiss_sanctions_list.xml", "file=swiss_sanctions_list.xml")
+
+	file, err := DownloadSanctionsList(context.Background(), log.NewNopLogger(), dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(file) == 0 {
+		t.Fatal("no SECO sanctions list file")
+	}
+
+	for fn, fd := range file {
+		if strings.EqualFold("swiss_sanctions_list.xml", filepath.Base(fn)) {
+			_, err := io.ReadAll(fd)
+			if err != nil {
+				t.Fatal(err)
+			}
+		} else {
+			t.Fatalf("unknown file: %v", file)
+		}
+	}
+}
+
+// Test downloading from a file:// URL, as can be set with CH_SECO_DOWNLOAD_URL
+func TestCHSECODownload_fromURL(t *testing.T) {
+	f, err := os.CreateTemp("", "seco-*.xml")
+	if err != nil {
+		t.Fatal(err)
+	}
+	content := "<swiss-sanctions-list/>"
+	if _, err := f.WriteString(content); err != nil {
+		f.Close()
+		t.Fatal(err)
+	}
+	f.Close()
+	defer os.Remove(f.Name())
+
+	orig := secoListURL
+	secoListURL = "file://" + f.Name()
+	defer func() { secoListURL = orig }()
+
+	dir, err := os.MkdirTemp("", "download-dir")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	files, err := DownloadSanctionsList(context.Background(), log.NewNopLogger(), dir)
+	if err != nil {
+		t.Fatalf("expected download success, got %v", err)
+	}
+	if len(files) != 1 {
+		t.Fatalf("expected exactly 1 file, got %d", len(files))
+	}
+
+	for fn, rc := range files {
+		if base := filepath.Base(fn); base != "swiss_sanctions_list.xml" {
+			t.Errorf("unexpected filename %q", base)
+		}
+		buf, err := io.ReadAll(rc)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if string(buf) != content {
+			t.Errorf("downloaded content mismatch: %q", string(buf))
+		}
+	}
+}
+
+// Files which can't be downloaded are skipped rather than returning an error
+func TestCHSECODownload_badURL(t *testing.T) {
+	orig := secoListURL
+	defer func() { secoListURL = orig }()
+
+	secoListURL = "file:///nonexistent/path"
+
+	files, err := DownloadSanctionsList(context.Background(), log.NewNopLogger(), "")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(files) != 0 {
+		t.Errorf("expected no files for bad URL, got %d", len(files))
+	}
+}
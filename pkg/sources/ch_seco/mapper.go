@@ -0,0 +1,386 @@
+// Copyright The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package ch_seco
+
+import (
+	"cmp"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/moov-io/watchman/internal/norm"
+	"github.com/moov-io/watchman/internal/prepare"
+	"github.com/moov-io/watchman/pkg/search"
+)
+
+// documentTypes maps SECO's identification document types to government ID types
+var documentTypes = map[string]search.GovernmentIDType{
+	"passport":            search.GovernmentIDPassport,
+	"diplomatic-passport": search.GovernmentIDDiplomaticPass,
+	"service-passport":    search.GovernmentIDPassport,
+	"id-card":             search.GovernmentIDNational,
+	"national-id":         search.GovernmentIDNational,
+	"driving-licence":     search.GovernmentIDDriversLicense,
+	"birth-certificate":   search.GovernmentIDBirthCert,
+	"tax-id":              search.GovernmentIDTax,
+	"registration-number": search.GovernmentIDBusinessRegisration,
+}
+
+// lookups holds the programs, places and names which targets reference by ssid
+type lookups struct {
+	programs map[string]string // sanctions-set ssid to program key
+	sets     map[string]string // sanctions-set ssid to its description
+	places   map[string]Place
+	names    map[string]string // target ssid to primary name
+}
+
+func newLookups(list *SanctionsList) lookups {
+	out := lookups{
+		programs: make(map[string]string),
+		sets:     make(map[string]string),
+		places:   make(map[string]Place),
+		names:    make(map[string]string),
+	}
+	for _, program := range list.Programs {
+		key := englishValue(program.Keys)
+		for _, set := range program.Sets {
+			out.programs[set.SSID] = key
+			if set.Lang == "" || set.Lang == "eng" || out.sets[set.SSID] == "" {
+				out.sets[set.SSID] = strings.TrimSpace(set.Value)
+			}
+		}
+	}
+	for _, place := range list.Places {
+		out.places[place.SSID] = place
+	}
+	for _, target := range list.Targets {
+		if name, _, _ := target.names(); name != "" {
+			out.names[target.SSID] = name
+		}
+	}
+	return out
+}
+
+// ToEntities converts every target of the list into an entity.
+func (list *SanctionsList) ToEntities() []search.Entity[search.Value] {
+	lookup := newLookups(list)
+
+	out := make([]search.Entity[search.Value], 0, len(list.Targets))
+	for _, target := range list.Targets {
+		if entity, ok := toEntity(target, lookup); ok {
+			out = append(out, entity)
+		}
+	}
+	return out
+}
+
+func toEntity(target Target, lookup lookups) (search.Entity[search.Value], bool) {
+	details := target.details()
+	if details == nil {
+		return search.Entity[search.Value]{}, false
+	}
+
+	entity := search.Entity[search.Value]{
+		Source:     search.SourceCHSECO,
+		SourceID:   target.SSID,
+		SourceData: target,
+	}
+
+	name, altNames, formerNames := target.names()
+	entity.Name = name
+	for _, former := range formerNames {
+		entity.HistoricalInfo = append(entity.HistoricalInfo, search.HistoricalInfo{
+			Type:  "Former Name",
+			Value: former,
+		})
+	}
+
+	var birthDate *time.Time
+	var placeOfBirth string
+	var governmentIDs []search.GovernmentID
+	var imoNumber string
+
+	for _, identity := range details.Identities {
+		for _, dob := range identity.DatesOfBirth {
+			if birthDate == nil {
+				birthDate = dob.time()
+			}
+		}
+		for _, pob := range identity.PlacesOfBirth {
+			if placeOfBirth == "" {
+				placeOfBirth = lookup.places[pob.PlaceID].String()
+			}
+		}
+		for _, nationality := range identity.Nationalities {
+			if country := strings.TrimSpace(nationality.Country.Name); country != "" {
+				governmentIDs = append(governmentIDs, search.GovernmentID{
+					Type:       search.GovernmentIDNationality,
+					Country:    norm.Country(country),
+					Identifier: country,
+				})
+			}
+		}
+		for _, addr := range identity.Addresses {
+			entity.Addresses = append(entity.Addresses, mapAddress(addr, lookup.places[addr.PlaceID]))
+		}
+		for _, doc := range identity.Documents {
+			number := strings.TrimSpace(doc.Number)
+			if number == "" {
+				continue
+			}
+			if strings.Contains(doc.DocumentType, "imo") {
+				imoNumber = strings.TrimSpace(strings.TrimPrefix(number, "IMO"))
+				continue
+			}
+			kind, found := documentTypes[doc.DocumentType]
+			if !found {
+				continue
+			}
+			governmentIDs = append(governmentIDs, search.GovernmentID{
+				Name:       doc.DocumentType,
+				Type:       kind,
+				Country:    norm.Country(strings.TrimSpace(doc.Issuer.Name)),
+				Identifier: number,
+			})
+		}
+	}
+
+	for _, rel := range details.Relations {
+		related := lookup.names[rel.TargetID]
+		if related == "" {
+			continue
+		}
+		entity.Affiliations = append(entity.Affiliations, search.Affiliation{
+			EntityName: related,
+			Type:       rel.RelationType,
+			Details:    strings.TrimSpace(rel.Remark),
+		})
+	}
+
+	switch {
+	case target.Individual != nil:
+		entity.Type = search.EntityPerson
+		entity.Person = &search.Person{
+			Name:          entity.Name,
+			AltNames:      altNames,
+			Gender:        mapGender(target.Individual.Sex),
+			BirthDate:     birthDate,
+			PlaceOfBirth:  placeOfBirth,
+			GovernmentIDs: governmentIDs,
+		}
+
+	case target.Object != nil && target.Object.ObjectType == "vessel":
+		entity.Type = search.EntityVessel
+		entity.Vessel = &search.Vessel{
+			Name:      entity.Name,
+			AltNames:  altNames,
+			IMONumber: imoNumber,
+		}
+
+	case target.Object != nil && target.Object.ObjectType == "aircraft":
+		entity.Type = search.EntityAircraft
+		entity.Aircraft = &search.Aircraft{
+			Name:     entity.Name,
+			AltNames: altNames,
+		}
+
+	default:
+		entity.Type = search.EntityBusiness
+		entity.Business = &search.Business{
+			Name:          prepare.RemoveCompanyTitles(entity.Name),
+			AltNames:      altNames,
+			GovernmentIDs: governmentIDs,
+		}
+	}
+
+	entity.SanctionsInfo = mapSanctionsInfo(target, details, lookup)
+
+	return entity.Normalize(), true
+}
+
+func (t Target) details() *targetDetails {
+	switch {
+	case t.Individual != nil:
+		return &t.Individual.targetDetails
+	case t.Entity != nil:
+		return &t.Entity.targetDetails
+	case t.Object != nil:
+		return &t.Object.targetDetails
+	}
+	return nil
+}
+
+// names returns the primary name of a target along with its other names. The primary name is
+// read from the main identity, every other name and spelling variant is an alternate name.
+func (t Target) names() (string, []string, []string) {
+	details := t.details()
+	if details == nil {
+		return "", nil, nil
+	}
+
+	var primary string
+	var altNames, formerNames []string
+
+	for _, identity := range details.Identities {
+		for _, name := range identity.Names {
+			full := name.FullName()
+			if full == "" {
+				continue
+			}
+			if primary == "" && identity.Main && name.NameType == "primary-name" {
+				primary = full
+			} else {
+				altNames = append(altNames, full)
+			}
+			if name.NameType == "formerly-known-as" {
+				formerNames = append(formerNames, full)
+			}
+			if variant := name.SpellingVariant(); variant != "" {
+				altNames = append(altNames, variant)
+			}
+		}
+	}
+	if primary == "" && len(altNames) > 0 {
+		primary, altNames = altNames[0], altNames[1:]
+	}
+	return primary, dedupe(altNames, primary), formerNames
+}
+
+// FullName joins the parts of a name in their order
+func (n Name) FullName() string {
+	parts := slices.Clone(n.Parts)
+	slices.SortStableFunc(parts, func(a, b NamePart) int {
+		return cmp.Compare(a.Order, b.Order)
+	})
+
+	var values []string
+	for _, part := range parts {
+		if v := strings.TrimSpace(part.Value); v != "" {
+			values = append(values, v)
+		}
+	}
+	return strings.Join(values, " ")
+}
+
+// SpellingVariant joins the first spelling variant of each name part, such as the name in Cyrillic
+func (n Name) SpellingVariant() string {
+	parts := slices.Clone(n.Parts)
+	slices.SortStableFunc(parts, func(a, b NamePart) int {
+		return cmp.Compare(a.Order, b.Order)
+	})
+
+	var values []string
+	for _, part := range parts {
+		if len(part.SpellingVariants) == 0 {
+			continue
+		}
+		if v := strings.TrimSpace(part.SpellingVariants[0].Value); v != "" {
+			values = append(values, v)
+		}
+	}
+	return strings.Join(values, " ")
+}
+
+func (d DayMonthYear) time() *time.Time {
+	year, err := strconv.Atoi(d.Year)
+	if err != nil || year <= 0 {
+		return nil
+	}
+	month, _ := strconv.Atoi(d.Month)
+	day, _ := strconv.Atoi(d.Day)
+	t := time.Date(year, time.Month(max(month, 1)), max(day, 1), 0, 0, 0, 0, time.UTC)
+	return &t
+}
+
+func (p Place) String() string {
+	var parts []string
+	for _, v := range []string{p.Location, p.Area, p.Country.Name} {
+		if v = strings.TrimSpace(v); v != "" {
+			parts = append(parts, v)
+		}
+	}
+	return strings.Join(parts, ", ")
+}
+
+func mapAddress(addr Address, place Place) search.Address {
+	line2 := strings.TrimSpace(addr.POBox)
+	if co := strings.TrimSpace(addr.CareOf); co != "" {
+		line2 = strings.TrimSpace("c/o " + co + " " + line2)
+	}
+	return search.Address{
+		Line1:      strings.TrimSpace(addr.Details),
+		Line2:      line2,
+		City:       strings.TrimSpace(place.Location),
+		PostalCode: strings.TrimSpace(addr.ZipCode),
+		State:      strings.TrimSpace(place.Area),
+		Country:    norm.Country(strings.TrimSpace(place.Country.Name)),
+	}
+}
+
+func mapGender(sex string) search.Gender {
+	switch strings.ToLower(sex) {
+	case "male":
+		return search.GenderMale
+	case "female":
+		return search.GenderFemale
+	}
+	return search.GenderUnknown
+}
+
+// mapSanctionsInfo uses the program keys of a target's sanctions sets as programs and
+// describes the measures along with when the target was listed
+func mapSanctionsInfo(target Target, details *targetDetails, lookup lookups) *search.SanctionsInfo {
+	var programs, parts []string
+	for _, id := range target.SanctionsSetIDs {
+		if program := lookup.programs[id]; program != "" && !slices.Contains(programs, program) {
+			programs = append(programs, program)
+		}
+		if set := lookup.sets[id]; set != "" && !slices.Contains(parts, set) {
+			parts = append(parts, set)
+		}
+	}
+	for _, mod := range target.Modifications {
+		if mod.ModificationType == "listed" && mod.EffectiveDate != "" {
+			parts = append(parts, "Listed On: "+mod.EffectiveDate)
+			break
+		}
+	}
+	for _, justification := range details.Justifications {
+		if justification = strings.TrimSpace(justification); justification != "" {
+			parts = append(parts, justification)
+		}
+	}
+
+	if len(programs) == 0 && len(parts) == 0 {
+		return nil
+	}
+	return &search.SanctionsInfo{
+		Programs:    programs,
+		Description: strings.Join(parts, "; "),
+	}
+}
+
+func englishValue(values []LangValue) string {
+	for _, v := range values {
+		if v.Lang == "eng" {
+			return strings.TrimSpace(v.Value)
+		}
+	}
+	if len(values) > 0 {
+		return strings.TrimSpace(values[0].Value)
+	}
+	return ""
+}
+
+func dedupe(values []string, skip string) []string {
+	var out []string
+	for _, v := range values {
+		if v != skip && !slices.Contains(out, v) {
+			out = append(out, v)
+		}
+	}
+	return out
+}
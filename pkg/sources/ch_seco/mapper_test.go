@@ -0,0 +1,120 @@
+// Copyright The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package ch_seco
+
+import (
+	"testing"
+	"time"
+
+	"github.com/moov-io/watchman/pkg/search"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestName_FullName(t *testing.T) {
+	name := Name{
+		Parts: []NamePart{
+			{Order: 3, Value: "DOE", SpellingVariants: []SpellingVariant{{Lang: "rus", Value: "ДОУ"}}},
+			{Order: 1, Value: " John "},
+			{Order: 2, Value: ""},
+		},
+	}
+	require.Equal(t, "John DOE", name.FullName())
+	require.Equal(t, "ДОУ", name.SpellingVariant())
+	require.Equal(t, "", Name{}.FullName())
+}
+
+func TestTarget_Names(t *testing.T) {
+	target := Target{
+		Entity: &Entity{targetDetails{
+			Identities: []Identity{
+				{
+					Names: []Name{
+						{NameType: "alias", Parts: []NamePart{{Value: "ACME"}}},
+					},
+				},
+				{
+					Main: true,
+					Names: []Name{
+						{NameType: "primary-name", Parts: []NamePart{{Value: "ACME Trading"}}},
+						{NameType: "formerly-known-as", Parts: []NamePart{{Value: "ACME"}}},
+					},
+				},
+			},
+		}},
+	}
+
+	primary, altNames, formerNames := target.names()
+	require.Equal(t, "ACME Trading", primary)
+	require.Equal(t, []string{"ACME"}, altNames)
+	require.Equal(t, []string{"ACME"}, formerNames)
+
+	// The first name is used when no main identity has a primary name
+	target.Entity.Identities = target.Entity.Identities[:1]
+	primary, altNames, _ = target.names()
+	require.Equal(t, "ACME", primary)
+	require.Empty(t, altNames)
+
+	primary, _, _ = Target{}.names()
+	require.Equal(t, "", primary)
+}
+
+func TestToEntity_Aircraft(t *testing.T) {
+	target := Target{
+		SSID: "77",
+		Object: &Object{
+			ObjectType: "aircraft",
+			targetDetails: targetDetails{
+				Identities: []Identity{{
+					Main:  true,
+					Names: []Name{{NameType: "primary-name", Parts: []NamePart{{Value: "EP-ABC"}}}},
+				}},
+			},
+		},
+	}
+
+	entity, ok := toEntity(target, lookups{})
+	require.True(t, ok)
+	require.Equal(t, search.EntityAircraft, entity.Type)
+	require.Equal(t, "77", entity.SourceID)
+	require.NotNil(t, entity.Aircraft)
+	require.Equal(t, "EP-ABC", entity.Aircraft.Name)
+	require.Nil(t, entity.SanctionsInfo)
+
+	// Targets without details are skipped
+	_, ok = toEntity(Target{SSID: "78"}, lookups{})
+	require.False(t, ok)
+}
+
+func TestDayMonthYear_Time(t *testing.T) {
+	require.Equal(t, time.Date(1962, time.June, 14, 0, 0, 0, 0, time.UTC), *DayMonthYear{Day: "14", Month: "6", Year: "1962"}.time())
+	require.Equal(t, time.Date(1975, time.January, 1, 0, 0, 0, 0, time.UTC), *DayMonthYear{Year: "1975"}.time())
+	require.Nil(t, DayMonthYear{}.time())
+}
+
+func TestMapAddress(t *testing.T) {
+	place := Place{Location: "Geneva", Area: "GE", Country: Country{Name: "Switzerland"}}
+	addr := mapAddress(Address{Details: "Rue du Rhône 1", ZipCode: "1204", CareOf: "Head office", POBox: "PO Box 5"}, place)
+
+	require.Equal(t, "Rue du Rhône 1", addr.Line1)
+	require.Equal(t, "c/o Head office PO Box 5", addr.Line2)
+	require.Equal(t, "Geneva", addr.City)
+	require.Equal(t, "GE", addr.State)
+	require.Equal(t, "1204", addr.PostalCode)
+	require.Equal(t, "Switzerland", addr.Country)
+	require.Equal(t, "Geneva, GE, Switzerland", place.String())
+}
+
+func TestMapGender(t *testing.T) {
+	require.Equal(t, search.GenderMale, mapGender("Male"))
+	require.Equal(t, search.GenderFemale, mapGender("female"))
+	require.Equal(t, search.GenderUnknown, mapGender(""))
+}
+
+func TestEnglishValue(t *testing.T) {
+	require.Equal(t, "Russia", englishValue([]LangValue{{Lang: "ger", Value: "Russland"}, {Lang: "eng", Value: " Russia "}}))
+	require.Equal(t, "Russland", englishValue([]LangValue{{Lang: "ger", Value: "Russland"}}))
+	require.Equal(t, "", englishValue(nil))
+}
@@ -0,0 +1,22 @@
+// Copyright The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package ch_seco
+
+import (
+	"encoding/xml"
+	"fmt"
+	"io"
+)
+
+// Read parses SECO's sanctions list.
+//
+// The whole list is read at once since targets reference programs and places listed elsewhere in the file.
+func Read(r io.Reader) (*SanctionsList, error) {
+	var list SanctionsList
+	if err := xml.NewDecoder(r).Decode(&list); err != nil {
+		return nil, fmt.Errorf("reading SECO XML: %w", err)
+	}
+	return &list, nil
+}
@@ -0,0 +1,110 @@
+// Copyright The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package ch_seco
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/moov-io/watchman/pkg/search"
+
+	"github.com/stretchr/testify/require"
+)
+
+func readTestList(t *testing.T) *SanctionsList {
+	t.Helper()
+
+	fd, err := os.Open(filepath.Join("testdata", "swiss_sanctions_list.xml"))
+	require.NoError(t, err)
+	t.Cleanup(func() { fd.Close() })
+
+	list, err := Read(fd)
+	require.NoError(t, err)
+
+	return list
+}
+
+func TestRead(t *testing.T) {
+	list := readTestList(t)
+
+	require.Equal(t, "2024-03-01", list.Date)
+	require.Len(t, list.Programs, 2)
+	require.Len(t, list.Targets, 4)
+	require.Len(t, list.Places, 1)
+
+	target := list.Targets[0]
+	require.Equal(t, "5001", target.SSID)
+	require.Equal(t, []string{"110"}, target.SanctionsSetIDs)
+	require.NotNil(t, target.Individual)
+	require.Equal(t, "Ivan Vladimirovich SIDOROV", target.Individual.Identities[0].Names[0].FullName())
+	require.Equal(t, "Иван СИДОРОВ", target.Individual.Identities[0].Names[0].SpellingVariant())
+
+	t.Run("malformed", func(t *testing.T) {
+		_, err := Read(strings.NewReader(`<swiss-sanctions-list><target ssid="1">`))
+		require.ErrorContains(t, err, "reading SECO XML")
+	})
+}
+
+func TestToEntities(t *testing.T) {
+	entities := readTestList(t).ToEntities()
+	require.Len(t, entities, 4)
+
+	person := entities[0]
+	require.Equal(t, "Ivan Vladimirovich SIDOROV", person.Name)
+	require.Equal(t, search.EntityPerson, person.Type)
+	require.Equal(t, search.SourceCHSECO, person.Source)
+	require.Equal(t, "5001", person.SourceID)
+
+	require.NotNil(t, person.Person)
+	require.Equal(t, []string{"Иван СИДОРОВ", "Ivan SIDOROFF"}, person.Person.AltNames)
+	require.Equal(t, search.GenderMale, person.Person.Gender)
+	require.Equal(t, time.Date(1962, time.June, 14, 0, 0, 0, 0, time.UTC), *person.Person.BirthDate)
+	require.Equal(t, "Moscow, Russia", person.Person.PlaceOfBirth)
+
+	expectedIDs := []search.GovernmentID{
+		{Type: search.GovernmentIDNationality, Country: "Russia", Identifier: "Russia"},
+		{Name: "passport", Type: search.GovernmentIDPassport, Country: "Russia", Identifier: "720012345"},
+	}
+	require.Equal(t, expectedIDs, person.Person.GovernmentIDs)
+
+	require.Len(t, person.Addresses, 1)
+	require.Equal(t, "Tverskaya Street 7", person.Addresses[0].Line1)
+	require.Equal(t, "Moscow", person.Addresses[0].City)
+	require.Equal(t, "125009", person.Addresses[0].PostalCode)
+	require.Equal(t, "Russia", person.Addresses[0].Country)
+
+	require.Equal(t, []search.Affiliation{{EntityName: "ROSNEFTEBANK", Type: "related-to", Details: "Board member"}}, person.Affiliations)
+
+	require.NotNil(t, person.SanctionsInfo)
+	require.Equal(t, []string{"Russia"}, person.SanctionsInfo.Programs)
+	require.Equal(t, "Annex 8 (Art. 15 para. 1 and 19 para. 1 and 2); Listed On: 2022-02-25; Member of the Board of Directors of ROSNEFTEBANK.", person.SanctionsInfo.Description)
+
+	business := entities[1]
+	require.Equal(t, search.EntityBusiness, business.Type)
+	require.Equal(t, "ROSNEFTEBANK", business.Name)
+	require.NotNil(t, business.Business)
+	require.Equal(t, []string{"NEFTEBANK"}, business.Business.AltNames)
+	require.Equal(t, []search.HistoricalInfo{{Type: "Former Name", Value: "NEFTEBANK"}}, business.HistoricalInfo)
+	require.Equal(t, search.GovernmentIDBusinessRegisration, business.Business.GovernmentIDs[0].Type)
+	require.Equal(t, "c/o Head office", business.Addresses[0].Line2)
+
+	vessel := entities[2]
+	require.Equal(t, search.EntityVessel, vessel.Type)
+	require.NotNil(t, vessel.Vessel)
+	require.Equal(t, "SEA PEARL", vessel.Vessel.Name)
+	require.Equal(t, "9876543", vessel.Vessel.IMONumber)
+
+	// Names and documents of other identities
+	other := entities[3]
+	require.Equal(t, "Amina HASSAN", other.Name)
+	require.Equal(t, search.GenderFemale, other.Person.Gender)
+	require.Equal(t, 1975, other.Person.BirthDate.Year())
+	require.Equal(t, []string{"Umm Hassan"}, other.Person.AltNames)
+	require.Equal(t, search.GovernmentIDNational, other.Person.GovernmentIDs[1].Type)
+	require.Equal(t, []string{"ISIL (Da'esh) and Al-Qaïda"}, other.SanctionsInfo.Programs)
+}
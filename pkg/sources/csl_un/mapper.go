@@ -13,6 +13,7 @@ import (
 	"strings"
 	"time"
 
+	"github.com/moov-io/watchman/internal/norm"
 	"github.com/moov-io/watchman/pkg/search"
 )
 
@@ -20,8 +21,24 @@ var (
 	websiteRE    = regexp.MustCompile(`https?://[^\s;]+|www\.[^\s;]+`)
 	emailLabelRE = regexp.MustCompile(`(?i)email:\s*(.+)(?:\s*\.\s*|$)`)
 	phoneLabelRE = regexp.MustCompile(`(?i)(?:telephone|phone number|phone):\s*(.+)(?:\s*\.\s*|$)`)
+
+	// Comments reference other listings like "Associated with Al-Qaida (QDe.004)" or "Member of Jemaah Islamiyah (JI) (QDe.092)"
+	affiliationRE = regexp.MustCompile(`(?i)\b(associated with|affiliated with|linked to|member of|leader of|subsidiary of|owned or controlled by)\s+(?:the\s+)?([^.;,()]+?)\s*(?:\([^()]*\)\s*)?\((?:[^()]*,\s*)?([A-Z]{2}[ie]\.\d+)\)`)
+
+	interpolNoticeRE = regexp.MustCompile(`(?i)\s*INTERPOL-UN Security Council Special Notice web link:\s*\S+`)
 )
 
+// affiliationTypes are written the same as other sources, such as "Linked To"
+var affiliationTypes = map[string]string{
+	"associated with":        "Associated With",
+	"affiliated with":        "Affiliated With",
+	"linked to":              "Linked To",
+	"member of":              "Member Of",
+	"leader of":              "Leader Of",
+	"subsidiary of":          "Subsidiary Of",
+	"owned or controlled by": "Owned or Controlled By",
+}
+
 type contactInfo struct {
 	EmailAddresses []string
 	PhoneNumbers   []string
@@ -193,13 +210,29 @@ func (p UNIndividual) ToEntity() search.Entity[search.Value] {
 		}
 	}
 
+	// titles and designations (positions held when listed)
+	for _, title := range slices.Concat(p.Titles, p.Designations) {
+		if title = strings.TrimSpace(title); title != "" {
+			entity.Person.Titles = append(entity.Person.Titles, title)
+		}
+	}
+
 	// extract nationalities
-	for _, n := range p.Nationalities {
-		if n.Text != "" {
-			entity.Person.Titles = append(entity.Person.Titles, n.Text)
+	for _, value := range p.Nationalities {
+		if n := strings.TrimSpace(value.Text); n != "" {
+			entity.Person.Titles = append(entity.Person.Titles, n)
+			entity.Person.GovernmentIDs = append(entity.Person.GovernmentIDs, search.GovernmentID{
+				Type:       search.GovernmentIDNationality,
+				Country:    norm.Country(n),
+				Identifier: n,
+			})
 		}
 	}
 
+	entity.SanctionsInfo = mapSanctionsInfo(p.UNListType, p.ReferenceNumber, p.ListedOn, p.Comments)
+	entity.Affiliations = parseAffiliations(p.Comments)
+	entity.HistoricalInfo = formerNames(p.Aliases)
+
 	return entity.Normalize()
 }
 
@@ -249,9 +282,83 @@ func (e UNEntity) ToEntity() search.Entity[search.Value] {
 		entity.Contact.Websites = append(entity.Contact.Websites, ci.Websites...)
 	}
 
+	entity.SanctionsInfo = mapSanctionsInfo(e.UNListType, e.ReferenceNumber, e.ListedOn, e.Comments)
+	entity.Affiliations = parseAffiliations(e.Comments)
+	entity.HistoricalInfo = formerNames(e.Aliases)
+
 	return entity.Normalize()
 }
 
+// mapSanctionsInfo records the sanctions regime (UN_LIST_TYPE) as the program and describes the listing
+// with its reference number, listing date and narrative comments.
+func mapSanctionsInfo(listType, referenceNumber, listedOn, comments string) *search.SanctionsInfo {
+	listType = strings.TrimSpace(listType)
+	referenceNumber = strings.TrimSpace(referenceNumber)
+	listedOn = strings.TrimSpace(listedOn)
+
+	// The link to INTERPOL notices is on most records and doesn't describe the listing
+	comments = strings.TrimSpace(interpolNoticeRE.ReplaceAllString(comments, ""))
+
+	if listType == "" && referenceNumber == "" && listedOn == "" && comments == "" {
+		return nil
+	}
+
+	info := &search.SanctionsInfo{}
+	if listType != "" {
+		info.Programs = []string{listType}
+	}
+
+	var parts []string
+	if referenceNumber != "" {
+		parts = append(parts, "UN Reference: "+referenceNumber)
+	}
+	if listedOn != "" {
+		parts = append(parts, "Listed On: "+listedOn)
+	}
+	if comments != "" {
+		parts = append(parts, strings.Join(strings.Fields(comments), " "))
+	}
+	info.Description = strings.Join(parts, "; ")
+
+	return info
+}
+
+// parseAffiliations finds the other listings a record is associated with in its comments.
+// The UN reference number of the associated listing is kept in the details.
+func parseAffiliations(comments string) []search.Affiliation {
+	var out []search.Affiliation
+	for _, m := range affiliationRE.FindAllStringSubmatch(comments, -1) {
+		name := strings.Join(strings.Fields(m[2]), " ")
+		if name == "" {
+			continue
+		}
+		aff := search.Affiliation{
+			EntityName: name,
+			Type:       affiliationTypes[strings.ToLower(strings.Join(strings.Fields(m[1]), " "))],
+			Details:    m[3],
+		}
+		if !slices.Contains(out, aff) {
+			out = append(out, aff)
+		}
+	}
+	return out
+}
+
+// formerNames returns the "f.k.a." aliases, which are names the party was previously known by
+func formerNames(aliases []Alias) []search.HistoricalInfo {
+	var out []search.HistoricalInfo
+	for _, alias := range aliases {
+		name := strings.TrimSpace(alias.Name)
+		if name != "" && strings.EqualFold(strings.TrimSpace(alias.Quality), "f.k.a.") {
+			out = append(out, search.HistoricalInfo{
+				Type:  "Former Name",
+				Value: name,
+			})
+		}
+	}
+	return out
+}
+
 func dedup[T cmp.Ordered](input []T) []T {
 	slices.Sort(input)
 	return slices.Compact(input)
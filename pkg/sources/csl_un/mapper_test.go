@@ -8,6 +8,8 @@ import (
 	"testing"
 
 	"github.com/moov-io/watchman/pkg/search"
+
+	"github.com/stretchr/testify/require"
 )
 
 func TestUNIndividual_ToEntity(t *testing.T) {
@@ -116,6 +118,14 @@ func TestUNIndividual_ToEntity(t *testing.T) {
 		t.Errorf("expected nationality USA in Person.Titles, got %v", ent.Person.Titles)
 	}
 
+	// nationality is also recorded as a government ID
+	if len(ent.Person.GovernmentIDs) != 1 {
+		t.Fatalf("expected 1 government ID, got %v", ent.Person.GovernmentIDs)
+	}
+	if id := ent.Person.GovernmentIDs[0]; id.Type != search.GovernmentIDNationality || id.Identifier != "USA" || id.Country != "United States" {
+		t.Errorf("unexpected nationality: %+v", id)
+	}
+
 	// gender parsed from comments
 	if ent.Person.Gender != search.Gender("Male") {
 		t.Errorf("expected gender Male, got %v", ent.Person.Gender)
@@ -204,3 +214,62 @@ func TestUNEntity_ToEntity(t *testing.T) {
 		t.Errorf("business website expected %q got %q", expBizWeb[0], ent.Contact.Websites[0])
 	}
 }
+
+func TestUNEntity_ToEntity_Listing(t *testing.T) {
+	e := UNEntity{
+		DataID:          "6908402",
+		FirstName:       "ADF",
+		UNListType:      "DRC",
+		ReferenceNumber: "CDe.001",
+		ListedOn:        "2014-06-30",
+		Comments: `ADF founder and leader, Jamil Mukulu (CDi.015), was arrested in April 2015.
+Associated with Al-Qaida (QDe.004). Member of Jemaah Islamiyah (JI) (QDe.092).
+INTERPOL-UN Security Council Special Notice web link:https://www.interpol.int/en/How-we-work/Notices/View-UN-Notices-Individuals`,
+		Aliases: []Alias{
+			{Quality: "a.k.a.", Name: "Allied Democratic Forces"},
+			{Quality: "f.k.a.", Name: "ADF/NALU"},
+		},
+	}
+
+	ent := e.ToEntity()
+
+	require.NotNil(t, ent.SanctionsInfo)
+	require.Equal(t, []string{"DRC"}, ent.SanctionsInfo.Programs)
+	expected := "UN Reference: CDe.001; Listed On: 2014-06-30; ADF founder and leader, Jamil Mukulu (CDi.015), was arrested in April 2015. " +
+		"Associated with Al-Qaida (QDe.004). Member of Jemaah Islamiyah (JI) (QDe.092)."
+	require.Equal(t, expected, ent.SanctionsInfo.Description)
+
+	expectedAffiliations := []search.Affiliation{
+		{EntityName: "Al-Qaida", Type: "Associated With", Details: "QDe.004"},
+		{EntityName: "Jemaah Islamiyah", Type: "Member Of", Details: "QDe.092"},
+	}
+	require.Equal(t, expectedAffiliations, ent.Affiliations)
+
+	require.Equal(t, []search.HistoricalInfo{{Type: "Former Name", Value: "ADF/NALU"}}, ent.HistoricalInfo)
+	require.Equal(t, []string{"Allied Democratic Forces", "ADF/NALU"}, ent.Business.AltNames)
+}
+
+func TestUNIndividual_ToEntity_Designations(t *testing.T) {
+	p := UNIndividual{
+		DataID:        "6907995",
+		FirstName:     "GASTON",
+		SecondName:    "IYAMUREMYE",
+		UNListType:    "DRC",
+		Titles:        []string{"General"},
+		Designations:  []string{"FDLR Interim President", "FDLR-FOCA Major General "},
+		Nationalities: []Value{{Text: "Rwanda"}, {Text: "Democratic Republic of the Congo"}},
+	}
+
+	ent := p.ToEntity()
+	expectedTitles := []string{"General", "FDLR Interim President", "FDLR-FOCA Major General", "Rwanda", "Democratic Republic of the Congo"}
+	require.Equal(t, expectedTitles, ent.Person.Titles)
+
+	expectedIDs := []search.GovernmentID{
+		{Type: search.GovernmentIDNationality, Country: "Rwanda", Identifier: "Rwanda"},
+		{Type: search.GovernmentIDNationality, Country: "Congo, Democratic Republic of the", Identifier: "Democratic Republic of the Congo"},
+	}
+	require.Equal(t, expectedIDs, ent.Person.GovernmentIDs)
+
+	require.Equal(t, []string{"DRC"}, ent.SanctionsInfo.Programs)
+	require.Empty(t, ent.Affiliations)
+}
@@ -93,6 +93,10 @@ func TestReader_Read_success(t *testing.T) {
       <SECOND_NAME>Doe</SECOND_NAME>
       <THIRD_NAME></THIRD_NAME>
       <FOURTH_NAME></FOURTH_NAME>
+      <NATIONALITY>
+        <VALUE>Rwanda</VALUE>
+        <VALUE>Uganda</VALUE>
+      </NATIONALITY>
     </INDIVIDUAL>
   </INDIVIDUALS>
   <ENTITIES>
@@ -122,6 +126,7 @@ func TestReader_Read_success(t *testing.T) {
 	if inds[0].DataID != "id1" || inds[0].FirstName != "Jane" {
 		t.Errorf("individual fields incorrect: %+v", inds[0])
 	}
+	require.Equal(t, []Value{{Text: "Rwanda"}, {Text: "Uganda"}}, inds[0].Nationalities)
 
 	if len(ents) != 1 {
 		t.Fatalf("expected 1 entity, got %d", len(ents))
@@ -28,7 +28,10 @@ type UNIndividual struct {
 	ListedOn        string       `xml:"LISTED_ON"`
 	Gender          string       `xml:"GENDER"`
 	Comments        string       `xml:"COMMENTS1"`
-	Nationalities   []Value      `xml:"NATIONALITY"`
+	Titles          []string     `xml:"TITLE>VALUE"`
+	Designations    []string     `xml:"DESIGNATION>VALUE"`
+	Nationalities   []Value      `xml:"NATIONALITY>VALUE"`
+	LastUpdated     []string     `xml:"LAST_DAY_UPDATED>VALUE"`
 	Aliases         []Alias      `xml:"INDIVIDUAL_ALIAS"`
 	Addresses       []Address    `xml:"INDIVIDUAL_ADDRESS"`
 	BirthDates      []BirthDate  `xml:"INDIVIDUAL_DATE_OF_BIRTH"`
@@ -43,13 +46,16 @@ type UNEntity struct {
 	ReferenceNumber string    `xml:"REFERENCE_NUMBER"`
 	ListedOn        string    `xml:"LISTED_ON"`
 	Comments        string    `xml:"COMMENTS1"`
+	LastUpdated     []string  `xml:"LAST_DAY_UPDATED>VALUE"`
 	Aliases         []Alias   `xml:"ENTITY_ALIAS"`
 	Addresses       []Address `xml:"ENTITY_ADDRESS"`
 }
 
 // Supporting nested structs
+
+// Value is one of the VALUE elements of a field, such as each nationality of an individual
 type Value struct {
-	Text string `xml:"VALUE"`
+	Text string `xml:",chardata"`
 }
 
 type Alias struct {
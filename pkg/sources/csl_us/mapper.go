@@ -102,6 +102,7 @@ func ToEntity(src SanctionsEntry) search.Entity[search.Value] {
 common:
 	// Map common fields
 	entity.Name, _ = splitNameIntoAlts(src.Name)
+	entity.SubList = mapSubList(src.Source)
 	entity.Contact = mapContactInfo(src)
 	entity.Addresses = mapAddresses(src)
 	entity.Affiliations = mapAffiliations(src)
@@ -513,6 +514,17 @@ func mapAffiliations(src SanctionsEntry) []search.Affiliation {
 	return affiliations
 }
 
+var subListCode = regexp.MustCompile(`\(([^()]+)\)`)
+
+// mapSubList returns the abbreviation of the list a CSL entry is from, such as "EL" for
+// "Entity List (EL) - Bureau of Industry and Security". Sources without one are returned as-is.
+func mapSubList(source string) string {
+	if m := subListCode.FindStringSubmatch(source); len(m) == 2 {
+		return strings.TrimSpace(strings.TrimSuffix(m[1], " List"))
+	}
+	return strings.TrimSpace(source)
+}
+
 func mapSanctionsInfo(src SanctionsEntry) *search.SanctionsInfo {
 	info := &search.SanctionsInfo{
 		Description: src.Remarks,
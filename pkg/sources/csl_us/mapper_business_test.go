@@ -22,6 +22,7 @@ func TestMapBusiness_Sinobright(t *testing.T) {
 	require.Equal(t, search.EntityBusiness, entity.Type)
 	require.Equal(t, search.SourceUSCSL, entity.Source)
 	require.Equal(t, "233a4d725770c81fb561ffe3842c14010c2201971d6be62eca1e613b", entity.SourceID)
+	require.Equal(t, "ISN", entity.SubList)
 
 	require.Nil(t, entity.Person)
 	require.NotNil(t, entity.Business)
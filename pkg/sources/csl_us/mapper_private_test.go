@@ -83,6 +83,20 @@ func TestSplitNameIntoAlts(t *testing.T) {
 	}
 }
 
+func TestMapSubList(t *testing.T) {
+	cases := map[string]string{
+		"Entity List (EL) - Bureau of Industry and Security":                    "EL",
+		"Military End User (MEU) List - Bureau of Industry and Security":        "MEU",
+		"Non-SDN Menu-Based Sanctions List (NS-MBS List) - Treasury Department": "NS-MBS",
+		"ITAR Debarred (DTC) - State Department":                                "DTC",
+		"Some New List - Treasury Department":                                   "Some New List - Treasury Department",
+		"":                                                                      "",
+	}
+	for input, expected := range cases {
+		require.Equal(t, expected, mapSubList(input), input)
+	}
+}
+
 func TestMapAddresses(t *testing.T) {
 	cases := []struct {
 		input    string
@@ -0,0 +1,61 @@
+// Copyright The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package debarment
+
+import (
+	"encoding/json"
+)
+
+// WorldBankFirm is a firm or individual on the World Bank Listing of Ineligible Firms and Individuals.
+//
+// The listing includes cross debarments, which are debarments of other multilateral development banks
+// recognized by the World Bank.
+type WorldBankFirm struct {
+	ID      json.Number `json:"SUPP_ID"`
+	Name    string      `json:"SUPP_NAME"`
+	Address string      `json:"SUPP_ADDR"`
+	City    string      `json:"SUPP_CITY"`
+	State   string      `json:"SUPP_STATE_CODE"`
+	ZipCode string      `json:"SUPP_ZIP_CODE"`
+	Country string      `json:"COUNTRY_NAME"`
+
+	// FromDate and ToDate are the ineligibility period. ToDate can be "Ongoing" or "Permanent".
+	FromDate string `json:"DEBAR_FROM_DATE"`
+	ToDate   string `json:"DEBAR_TO_DATE"`
+
+	// Grounds are the guidelines violated, or "Cross Debarment" with the debarring institution
+	Grounds string `json:"DEBAR_REASON"`
+
+	IneligibilityStatus string `json:"INELIGIBLY_STAT"`
+
+	// AdditionalInformation often lists other names of the firm
+	AdditionalInformation string `json:"ADD_SUPP_INFO"`
+}
+
+type worldBankResponse struct {
+	Response struct {
+		Firms []WorldBankFirm `json:"ZPROCSUPP"`
+	} `json:"response"`
+}
+
+// Record is a debarred firm or individual read from the CSV export of a multilateral development bank's list.
+//
+// ADB, AfDB, IDB and EBRD don't offer a machine readable list, so their lists are read from CSV files
+// with the columns: Name, Type, Other Names, Country, Address, Grounds, From and To.
+type Record struct {
+	Name string
+
+	// Type is "Individual" or "Firm"
+	Type string
+
+	// OtherNames are separated by semicolons
+	OtherNames string
+
+	Country string
+	Address string
+	Grounds string
+	From    string
+	To      string
+}
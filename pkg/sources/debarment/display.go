@@ -0,0 +1,23 @@
+// Copyright The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package debarment
+
+import (
+	"github.com/moov-io/watchman/pkg/search"
+)
+
+var detailsURLs = map[search.SourceList]string{
+	search.SourceWorldBankDebarred: "https://www.worldbank.org/en/projects-operations/procurement/debarred-firms",
+	search.SourceADBDebarred:       "https://www.adb.org/who-we-are/integrity/sanctions",
+	search.SourceAfDBDebarred:      "https://www.afdb.org/en/projects-and-operations/procurement/debarment-and-sanctions-procedures",
+	search.SourceIDBDebarred:       "https://www.iadb.org/en/who-we-are/transparency/sanctioned-firms-and-individuals",
+	search.SourceEBRDDebarred:      "https://www.ebrd.com/ineligible-entities.html",
+}
+
+// DetailsURL returns the page of the institution's debarment list.
+// Parties can't be linked to directly, so we return the list's page.
+func DetailsURL(entity search.Entity[search.Value]) string {
+	return detailsURLs[entity.Source]
+}
@@ -0,0 +1,23 @@
+// Copyright The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package debarment
+
+import (
+	"testing"
+
+	"github.com/moov-io/watchman/pkg/search"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestDetailsURL(t *testing.T) {
+	entity := search.Entity[search.Value]{Source: search.SourceWorldBankDebarred, SourceID: "11842"}
+	require.Equal(t, "https://www.worldbank.org/en/projects-operations/procurement/debarred-firms", DetailsURL(entity))
+
+	for list := range Institutions {
+		entity.Source = list
+		require.NotEmpty(t, DetailsURL(entity), list)
+	}
+}
@@ -0,0 +1,76 @@
+// Copyright The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package debarment
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+
+	"github.com/moov-io/base/log"
+	"github.com/moov-io/base/strx"
+	"github.com/moov-io/watchman/pkg/download"
+	"github.com/moov-io/watchman/pkg/search"
+)
+
+var (
+	// The API key is hardcoded on the World Bank site, but we offer an override.
+	// https://www.worldbank.org/en/projects-operations/procurement/debarred-firms
+	worldBankAPIKey            = strx.Or(os.Getenv("DEBARMENT_WORLDBANK_API_KEY"), "z9duUaFUiEUYSHs97CU38fcZO7ipOPvm")
+	publicWorldBankDownloadURL = "https://apigwext.worldbank.org/dvsvc/v1.0/json/APPLICATION/ADOBE_EXPRNCE_MGR/FIRM/SANCTIONED_FIRM"
+
+	worldBankDownloadURL = strx.Or(os.Getenv("DEBARMENT_WORLDBANK_DOWNLOAD_URL"), publicWorldBankDownloadURL)
+
+	// Other institutions don't publish a machine readable list, so there's no default URL for their CSV exports
+	mdbDownloadURLs = map[search.SourceList]string{
+		search.SourceADBDebarred:  os.Getenv("DEBARMENT_ADB_DOWNLOAD_URL"),
+		search.SourceAfDBDebarred: os.Getenv("DEBARMENT_AFDB_DOWNLOAD_URL"),
+		search.SourceIDBDebarred:  os.Getenv("DEBARMENT_IDB_DOWNLOAD_URL"),
+		search.SourceEBRDDebarred: os.Getenv("DEBARMENT_EBRD_DOWNLOAD_URL"),
+	}
+)
+
+func DownloadWorldBank(ctx context.Context, logger log.Logger, initialDir string) (map[string]io.ReadCloser, error) {
+	dl := download.New(logger, nil, download.WithAdditionalHeaders(map[string]string{
+		"apikey": worldBankAPIKey,
+	}))
+
+	logger.Info().Logf("downloading World Bank debarred firms from %s", worldBankDownloadURL)
+
+	worldBankNameAndSource := map[string]string{
+		"debarment_worldbank.json": worldBankDownloadURL,
+	}
+
+	return dl.GetFiles(ctx, initialDir, worldBankNameAndSource)
+}
+
+// Filename returns the name of the CSV file read for the debarment list of an institution other than the World Bank
+func Filename(list search.SourceList) string {
+	return string(list) + ".csv"
+}
+
+// DownloadMDB returns the CSV export of an institution's debarment list. The file is read from initialDir
+// or downloaded from the URL set in the list's environment variable, such as DEBARMENT_ADB_DOWNLOAD_URL.
+func DownloadMDB(ctx context.Context, logger log.Logger, initialDir string, list search.SourceList) (map[string]io.ReadCloser, error) {
+	downloadURL, found := mdbDownloadURLs[list]
+	if !found {
+		return nil, fmt.Errorf("unknown debarment list %s", list)
+	}
+
+	filename := Filename(list)
+	if downloadURL == "" {
+		if _, err := os.Stat(filepath.Join(initialDir, filename)); err != nil {
+			return nil, fmt.Errorf("%s not found in initial data directory and no download URL is set", filename)
+		}
+	}
+
+	dl := download.New(logger, nil)
+
+	return dl.GetFiles(ctx, initialDir, map[string]string{
+		filename: downloadURL,
+	})
+}
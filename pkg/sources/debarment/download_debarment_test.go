@@ -0,0 +1,62 @@
+// Copyright The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package debarment
+
+import (
+	"context"
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/moov-io/base/log"
+	"github.com/moov-io/watchman/pkg/download"
+	"github.com/moov-io/watchman/pkg/search"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestDownloadWorldBank_initialDir(t *testing.T) {
+	dir := t.TempDir()
+
+	err := os.WriteFile(filepath.Join(dir, "debarment_worldbank.json"), []byte(`{"response":{}}`), 0600)
+	require.NoError(t, err)
+
+	files, err := DownloadWorldBank(context.Background(), log.NewNopLogger(), dir)
+	require.NoError(t, err)
+	require.Len(t, files, 1)
+
+	for fn, fd := range files {
+		require.Equal(t, "debarment_worldbank.json", filepath.Base(fn))
+
+		bs, err := io.ReadAll(fd)
+		require.NoError(t, err)
+		require.Equal(t, `{"response":{}}`, string(bs))
+	}
+}
+
+func TestDownloadMDB(t *testing.T) {
+	dir := t.TempDir()
+
+	t.Run("missing", func(t *testing.T) {
+		_, err := DownloadMDB(context.Background(), log.NewNopLogger(), dir, search.SourceIDBDebarred)
+		require.ErrorContains(t, err, "debarment_idb.csv not found")
+	})
+
+	t.Run("initialDir", func(t *testing.T) {
+		err := os.WriteFile(filepath.Join(dir, "debarment_idb.csv"), []byte("Name\n"), 0600)
+		require.NoError(t, err)
+
+		files, err := DownloadMDB(context.Background(), log.NewNopLogger(), dir, search.SourceIDBDebarred)
+		require.NoError(t, err)
+		require.Len(t, files, 1)
+		require.NoError(t, download.Files(files).Close())
+	})
+
+	t.Run("unknown", func(t *testing.T) {
+		_, err := DownloadMDB(context.Background(), log.NewNopLogger(), dir, search.SourceUSOFAC)
+		require.ErrorContains(t, err, "unknown debarment list")
+	})
+}
@@ -0,0 +1,176 @@
+// Copyright The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package debarment
+
+import (
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/moov-io/watchman/internal/norm"
+	"github.com/moov-io/watchman/internal/prepare"
+	"github.com/moov-io/watchman/pkg/search"
+)
+
+var (
+	dateLayouts = []string{"2006-01-02T15:04:05", "2006-01-02", "02-Jan-2006", "02-Jan-06", "01/02/2006", "2-Jan-2006"}
+
+	// Institutions are the multilateral development banks publishing each debarment list
+	Institutions = map[search.SourceList]string{
+		search.SourceWorldBankDebarred: "World Bank",
+		search.SourceADBDebarred:       "ADB",
+		search.SourceAfDBDebarred:      "AfDB",
+		search.SourceIDBDebarred:       "IDB",
+		search.SourceEBRDDebarred:      "EBRD",
+	}
+
+	personTitles = []string{"mr. ", "mrs. ", "ms. ", "dr. ", "mr ", "mrs ", "ms "}
+
+	// otherNamesRE finds names in the additional information of World Bank firms,
+	// such as "*Also known as: ACME Ltd; ACME Trading"
+	otherNamesRE = regexp.MustCompile(`(?i)\b(also|formerly) known as:?\s*([^.*]+)`)
+)
+
+// ToEntity converts a World Bank debarred firm to the Moov search.Entity format.
+func (f WorldBankFirm) ToEntity() search.Entity[search.Value] {
+	entity := search.Entity[search.Value]{
+		Source:     search.SourceWorldBankDebarred,
+		SourceID:   cleanSourceID(f.ID.String(), f.Name),
+		SourceData: f,
+	}
+
+	var altNames []string
+	for _, match := range otherNamesRE.FindAllStringSubmatch(f.AdditionalInformation, -1) {
+		names := splitNames(match[2])
+		altNames = append(altNames, names...)
+
+		if strings.EqualFold(match[1], "formerly") {
+			for _, name := range names {
+				entity.HistoricalInfo = append(entity.HistoricalInfo, search.HistoricalInfo{
+					Type:  "Former Name",
+					Value: name,
+				})
+			}
+		}
+	}
+	mapParty(&entity, f.Name, "", altNames)
+
+	country := strings.TrimSpace(f.Country)
+	if address := strings.TrimSpace(f.Address); address != "" || country != "" {
+		entity.Addresses = []search.Address{{
+			Line1:      address,
+			City:       strings.TrimSpace(f.City),
+			State:      strings.TrimSpace(f.State),
+			PostalCode: strings.TrimSpace(f.ZipCode),
+			Country:    norm.Country(country),
+		}}
+	}
+
+	grounds := strings.TrimSpace(f.Grounds)
+	entity.Debarment = &search.Debarment{
+		Institution:    Institutions[search.SourceWorldBankDebarred],
+		Grounds:        grounds,
+		From:           parseDate(f.FromDate),
+		To:             parseDate(f.ToDate),
+		CrossDebarment: strings.Contains(strings.ToLower(grounds), "cross debarment"),
+	}
+	if strings.EqualFold(strings.TrimSpace(f.IneligibilityStatus), "permanent") {
+		entity.Debarment.To = nil
+	}
+
+	return entity.Normalize()
+}
+
+// ToEntity converts a record of another institution's debarment list to the Moov search.Entity format.
+func (r Record) ToEntity(list search.SourceList) search.Entity[search.Value] {
+	entity := search.Entity[search.Value]{
+		Source:     list,
+		SourceID:   cleanSourceID("", r.Name),
+		SourceData: r,
+	}
+	mapParty(&entity, r.Name, r.Type, splitNames(r.OtherNames))
+
+	country := strings.TrimSpace(r.Country)
+	if address := strings.TrimSpace(r.Address); address != "" || country != "" {
+		entity.Addresses = []search.Address{{
+			Line1:   address,
+			Country: norm.Country(country),
+		}}
+	}
+
+	grounds := strings.TrimSpace(r.Grounds)
+	entity.Debarment = &search.Debarment{
+		Institution:    Institutions[list],
+		Grounds:        grounds,
+		From:           parseDate(r.From),
+		To:             parseDate(r.To),
+		CrossDebarment: strings.Contains(strings.ToLower(grounds), "cross debarment"),
+	}
+
+	return entity.Normalize()
+}
+
+// mapParty sets the name and type of a debarred party. Firms and individuals share one list,
+// so individuals are found by their type or a title in their name.
+func mapParty(entity *search.Entity[search.Value], name, partyType string, altNames []string) {
+	name = strings.TrimSpace(name)
+
+	isPerson := strings.EqualFold(strings.TrimSpace(partyType), "individual")
+	lower := strings.ToLower(name)
+	for _, title := range personTitles {
+		if strings.HasPrefix(lower, title) {
+			isPerson = true
+			name = strings.TrimSpace(name[len(title):])
+			break
+		}
+	}
+
+	entity.Name = name
+	if isPerson {
+		entity.Type = search.EntityPerson
+		entity.Person = &search.Person{
+			Name:     name,
+			AltNames: altNames,
+		}
+		return
+	}
+	entity.Type = search.EntityBusiness
+	entity.Business = &search.Business{
+		Name:     prepare.RemoveCompanyTitles(name),
+		AltNames: altNames,
+	}
+}
+
+// cleanSourceID uses the list's identifier, or the party's name on lists without one
+func cleanSourceID(id, name string) string {
+	if id = strings.TrimSpace(id); id != "" {
+		return id
+	}
+	return strings.TrimSpace(name)
+}
+
+func splitNames(value string) []string {
+	var out []string
+	for _, name := range strings.Split(value, ";") {
+		if name = strings.TrimSpace(name); name != "" {
+			out = append(out, name)
+		}
+	}
+	return out
+}
+
+// parseDate reads the start or end of a debarment. Ongoing and permanent debarments have no end date.
+func parseDate(value string) *time.Time {
+	value = strings.TrimSpace(value)
+	if value == "" {
+		return nil
+	}
+	for _, layout := range dateLayouts {
+		if t, err := time.Parse(layout, value); err == nil {
+			return &t
+		}
+	}
+	return nil
+}
@@ -0,0 +1,131 @@
+// Copyright The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package debarment
+
+import (
+	"testing"
+	"time"
+
+	"github.com/moov-io/watchman/pkg/search"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestWorldBankFirm_ToEntity(t *testing.T) {
+	firm := WorldBankFirm{
+		ID:                    "",
+		Name:                  "Example Holdings",
+		Country:               "Kenya",
+		FromDate:              "2020-03-01T00:00:00",
+		ToDate:                "2025-02-28T00:00:00",
+		Grounds:               "Cross Debarment: AfDB",
+		IneligibilityStatus:   "Permanent",
+		AdditionalInformation: "*Formerly known as: Example Trading; Example Ltd.",
+	}
+
+	entity := firm.ToEntity()
+
+	require.Equal(t, search.SourceWorldBankDebarred, entity.Source)
+	require.Equal(t, "Example Holdings", entity.SourceID) // no identifier
+	require.Equal(t, search.EntityBusiness, entity.Type)
+	require.NotNil(t, entity.Business)
+	require.Equal(t, []string{"Example Trading", "Example Ltd"}, entity.Business.AltNames)
+
+	expected := []search.HistoricalInfo{
+		{Type: "Former Name", Value: "Example Trading"},
+		{Type: "Former Name", Value: "Example Ltd"},
+	}
+	require.Equal(t, expected, entity.HistoricalInfo)
+
+	require.Len(t, entity.Addresses, 1)
+	require.Equal(t, "Kenya", entity.Addresses[0].Country)
+
+	require.NotNil(t, entity.Debarment)
+	require.True(t, entity.Debarment.CrossDebarment)
+	require.Equal(t, time.Date(2020, time.March, 1, 0, 0, 0, 0, time.UTC), *entity.Debarment.From)
+	require.Nil(t, entity.Debarment.To) // permanent
+}
+
+func TestRecord_ToEntity(t *testing.T) {
+	record := Record{
+		Name:       "Jane DOE",
+		Type:       "Individual",
+		OtherNames: "Janet DOE; ; J. DOE",
+		Country:    "Philippines",
+		Address:    "Manila",
+		Grounds:    "Corrupt practice",
+		From:       "15-Mar-2021",
+		To:         "14-Mar-2024",
+	}
+
+	entity := record.ToEntity(search.SourceADBDebarred)
+
+	require.Equal(t, search.SourceADBDebarred, entity.Source)
+	require.Equal(t, "Jane DOE", entity.SourceID)
+	require.Equal(t, search.EntityPerson, entity.Type)
+	require.NotNil(t, entity.Person)
+	require.Equal(t, []string{"Janet DOE", "J. DOE"}, entity.Person.AltNames)
+
+	require.Len(t, entity.Addresses, 1)
+	require.Equal(t, "Manila", entity.Addresses[0].Line1)
+
+	require.NotNil(t, entity.Debarment)
+	require.Equal(t, "ADB", entity.Debarment.Institution)
+	require.Equal(t, "Corrupt practice", entity.Debarment.Grounds)
+	require.False(t, entity.Debarment.CrossDebarment)
+	require.Equal(t, time.Date(2021, time.March, 15, 0, 0, 0, 0, time.UTC), *entity.Debarment.From)
+	require.Equal(t, time.Date(2024, time.March, 14, 0, 0, 0, 0, time.UTC), *entity.Debarment.To)
+
+	// Records without an address or country
+	entity = Record{Name: "ACME Ltd", Type: "Firm"}.ToEntity(search.SourceIDBDebarred)
+	require.Equal(t, search.EntityBusiness, entity.Type)
+	require.Empty(t, entity.Addresses)
+	require.Equal(t, "IDB", entity.Debarment.Institution)
+}
+
+func TestMapParty(t *testing.T) {
+	cases := []struct {
+		name, partyType string
+		expectedName    string
+		expectedType    search.EntityType
+	}{
+		{"Mr. John SMITH", "", "John SMITH", search.EntityPerson},
+		{"MRS Jane SMITH", "", "Jane SMITH", search.EntityPerson},
+		{"John SMITH", "individual", "John SMITH", search.EntityPerson},
+		{"Mrsa Trading", "", "Mrsa Trading", search.EntityBusiness},
+		{" ACME Ltd ", "Firm", "ACME Ltd", search.EntityBusiness},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			var entity search.Entity[search.Value]
+			mapParty(&entity, tc.name, tc.partyType, nil)
+
+			require.Equal(t, tc.expectedName, entity.Name)
+			require.Equal(t, tc.expectedType, entity.Type)
+		})
+	}
+}
+
+func TestParseDate(t *testing.T) {
+	cases := map[string]string{
+		"2019-11-22T00:00:00": "2019-11-22",
+		"2019-11-22":          "2019-11-22",
+		"22-NOV-2019":         "2019-11-22",
+		"11/22/2019":          "2019-11-22",
+		"2-Nov-2019":          "2019-11-02",
+		"Ongoing":             "",
+		"Permanent":           "",
+		"":                    "",
+	}
+	for input, expected := range cases {
+		got := parseDate(input)
+		if expected == "" {
+			require.Nil(t, got, input)
+			continue
+		}
+		require.NotNil(t, got, input)
+		require.Equal(t, expected, got.Format("2006-01-02"), input)
+	}
+}
@@ -0,0 +1,81 @@
+// Copyright The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package debarment
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"slices"
+	"strings"
+)
+
+// ReadWorldBank parses the response of the World Bank's debarred firms API.
+func ReadWorldBank(r io.Reader) ([]WorldBankFirm, error) {
+	var resp worldBankResponse
+	if err := json.NewDecoder(r).Decode(&resp); err != nil {
+		return nil, fmt.Errorf("reading World Bank debarred firms: %w", err)
+	}
+	return resp.Response.Firms, nil
+}
+
+// columns maps the CSV headers to the Record fields they're read into
+var columns = map[string]func(*Record, string){
+	"name":        func(r *Record, v string) { r.Name = v },
+	"type":        func(r *Record, v string) { r.Type = v },
+	"other names": func(r *Record, v string) { r.OtherNames = v },
+	"country":     func(r *Record, v string) { r.Country = v },
+	"address":     func(r *Record, v string) { r.Address = v },
+	"grounds":     func(r *Record, v string) { r.Grounds = v },
+	"from":        func(r *Record, v string) { r.From = v },
+	"to":          func(r *Record, v string) { r.To = v },
+}
+
+// ReadMDB parses the CSV export of a debarment list. Columns are found by their header and
+// unknown columns are ignored.
+func ReadMDB(r io.Reader) ([]Record, error) {
+	reader := csv.NewReader(r)
+	reader.FieldsPerRecord = -1
+	reader.TrimLeadingSpace = true
+
+	headers, err := reader.Read()
+	if err != nil {
+		return nil, fmt.Errorf("reading debarment CSV headers: %w", err)
+	}
+	setters := make([]func(*Record, string), len(headers))
+	for i, header := range headers {
+		setters[i] = columns[strings.ToLower(strings.TrimSpace(header))]
+	}
+	hasName := slices.ContainsFunc(headers, func(header string) bool {
+		return strings.EqualFold(strings.TrimSpace(header), "name")
+	})
+	if !hasName {
+		return nil, errors.New("debarment CSV has no Name column")
+	}
+
+	var out []Record
+	for {
+		row, err := reader.Read()
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				break
+			}
+			return nil, fmt.Errorf("reading debarment CSV: %w", err)
+		}
+
+		var record Record
+		for i, value := range row {
+			if i < len(setters) && setters[i] != nil {
+				setters[i](&record, strings.TrimSpace(value))
+			}
+		}
+		if record.Name != "" {
+			out = append(out, record)
+		}
+	}
+	return out, nil
+}
@@ -0,0 +1,98 @@
+// Copyright The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package debarment
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/moov-io/watchman/pkg/search"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestReadWorldBank(t *testing.T) {
+	fd, err := os.Open(filepath.Join("testdata", "debarment_worldbank.json"))
+	require.NoError(t, err)
+	t.Cleanup(func() { fd.Close() })
+
+	firms, err := ReadWorldBank(fd)
+	require.NoError(t, err)
+	require.Len(t, firms, 3)
+
+	// SUPP_ID can be a number or string
+	require.Equal(t, "11842", firms[0].ID.String())
+	require.Equal(t, "12004", firms[1].ID.String())
+
+	business := firms[0].ToEntity()
+	require.Equal(t, "ACME CONSTRUCTION LTD.", business.Name)
+	require.Equal(t, search.EntityBusiness, business.Type)
+	require.Equal(t, search.SourceWorldBankDebarred, business.Source)
+	require.Equal(t, "11842", business.SourceID)
+	require.Equal(t, []string{"ACME Builders", "ACME Group"}, business.Business.AltNames)
+
+	require.Len(t, business.Addresses, 1)
+	require.Equal(t, "12 Harbour Road", business.Addresses[0].Line1)
+	require.Equal(t, "Dhaka", business.Addresses[0].City)
+	require.Equal(t, "1212", business.Addresses[0].PostalCode)
+	require.Equal(t, "Bangladesh", business.Addresses[0].Country)
+
+	require.NotNil(t, business.Debarment)
+	require.Equal(t, "World Bank", business.Debarment.Institution)
+	require.Equal(t, "Procurement Guidelines, 1.16(a)(ii)", business.Debarment.Grounds)
+	require.Equal(t, time.Date(2019, time.November, 22, 0, 0, 0, 0, time.UTC), *business.Debarment.From)
+	require.Equal(t, time.Date(2023, time.November, 21, 0, 0, 0, 0, time.UTC), *business.Debarment.To)
+	require.False(t, business.Debarment.CrossDebarment)
+	require.Nil(t, business.SanctionsInfo)
+
+	person := firms[1].ToEntity()
+	require.Equal(t, "Juan PEREZ", person.Name)
+	require.Equal(t, search.EntityPerson, person.Type)
+	require.Nil(t, person.Debarment.To) // ongoing
+	require.False(t, person.Debarment.Expired(time.Now()))
+
+	cross := firms[2].ToEntity()
+	require.True(t, cross.Debarment.CrossDebarment)
+	require.Equal(t, []search.HistoricalInfo{{Type: "Former Name", Value: "Delta Works Co"}}, cross.HistoricalInfo)
+
+	t.Run("not JSON", func(t *testing.T) {
+		_, err := ReadWorldBank(strings.NewReader("<html></html>"))
+		require.ErrorContains(t, err, "reading World Bank debarred firms")
+	})
+}
+
+func TestReadMDB(t *testing.T) {
+	fd, err := os.Open(filepath.Join("testdata", "debarment_adb.csv"))
+	require.NoError(t, err)
+	t.Cleanup(func() { fd.Close() })
+
+	records, err := ReadMDB(fd)
+	require.NoError(t, err)
+	require.Len(t, records, 2)
+
+	business := records[0].ToEntity(search.SourceADBDebarred)
+	require.Equal(t, "Orion Consulting Pte Ltd", business.Name)
+	require.Equal(t, search.EntityBusiness, business.Type)
+	require.Equal(t, search.SourceADBDebarred, business.Source)
+	require.Equal(t, []string{"Orion Consult", "Orion Advisory"}, business.Business.AltNames)
+	require.Equal(t, "Singapore", business.Addresses[0].Country)
+
+	require.Equal(t, "ADB", business.Debarment.Institution)
+	require.Equal(t, "Corrupt Practice", business.Debarment.Grounds)
+	require.Equal(t, time.Date(2025, time.February, 28, 0, 0, 0, 0, time.UTC), *business.Debarment.To)
+
+	person := records[1].ToEntity(search.SourceADBDebarred)
+	require.Equal(t, search.EntityPerson, person.Type)
+	require.Equal(t, "Ramon Cruz", person.Name)
+	require.Nil(t, person.Debarment.To)
+
+	t.Run("no name column", func(t *testing.T) {
+		_, err := ReadMDB(strings.NewReader("Firm,Grounds\nAcme,Fraud\n"))
+		require.ErrorContains(t, err, "no Name column")
+	})
+}
@@ -5,9 +5,13 @@ import (
 	"strings"
 
 	"github.com/moov-io/watchman/pkg/search"
+	"github.com/moov-io/watchman/pkg/sources/au_dfat"
+	"github.com/moov-io/watchman/pkg/sources/ca_sema"
+	"github.com/moov-io/watchman/pkg/sources/ch_seco"
 	"github.com/moov-io/watchman/pkg/sources/csl_eu"
 	"github.com/moov-io/watchman/pkg/sources/csl_uk"
 	"github.com/moov-io/watchman/pkg/sources/csl_us"
+	"github.com/moov-io/watchman/pkg/sources/debarment"
 	"github.com/moov-io/watchman/pkg/sources/ofac"
 	"github.com/moov-io/watchman/pkg/sources/opensanctions"
 	"github.com/moov-io/watchman/pkg/sources/us_tel"
@@ -16,6 +20,15 @@ import (
 // DetailsURL returns a URL where you can view the entity on the source's website
 func DetailsURL(entity search.Entity[search.Value]) string {
 	switch entity.Source {
+	case search.SourceAUDFAT:
+		return au_dfat.DetailsURL()
+
+	case search.SourceCASEMA:
+		return ca_sema.DetailsURL()
+
+	case search.SourceCHSECO:
+		return ch_seco.DetailsURL()
+
 	case search.SourceEUCSL:
 		return csl_eu.DetailsURL(entity)
 
@@ -35,6 +48,10 @@ func DetailsURL(entity search.Entity[search.Value]) string {
 		// do nothing
 	}
 
+	if entity.Source.IsDebarment() {
+		return debarment.DetailsURL(entity)
+	}
+
 	// Shortcut for open sanctions
 	if strings.HasPrefix(string(entity.Source), "opensanctions_") {
 		return opensanctions.DetailsURL(entity.SourceID)
@@ -0,0 +1,168 @@
+package ftm
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+)
+
+// Entity is a single FollowTheMoney (FtM) entity. FtM describes every record as a schema
+// name and a set of multi-valued properties. Relationships, sanctions and identity documents
+// are entities of their own which reference other entities by ID.
+//
+// OpenSanctions publishes its datasets in this format as entities.ftm.json (JSON Lines)
+// and targets.nested.json, where referenced entities are nested inside properties.
+//
+// Reference: https://followthemoney.tech/explorer/
+type Entity struct {
+	ID         string              `json:"id"`
+	Schema     string              `json:"schema"`
+	Caption    string              `json:"caption,omitempty"`
+	Properties map[string][]string `json:"properties"`
+
+	Datasets  []string `json:"datasets,omitempty"`
+	Referents []string `json:"referents,omitempty"`
+	Target    bool     `json:"target,omitempty"`
+	FirstSeen string   `json:"first_seen,omitempty"`
+	LastSeen  string   `json:"last_seen,omitempty"`
+
+	// nested holds entities which were embedded in properties, they are flattened by the reader
+	nested []Entity
+	// parent is the ID of the entity this was nested inside of
+	parent string
+}
+
+// Get returns the values of a property
+func (e Entity) Get(prop string) []string {
+	return e.Properties[prop]
+}
+
+// First returns the first value of a property, or an empty string.
+func (e Entity) First(prop string) string {
+	if values := e.Properties[prop]; len(values) > 0 {
+		return values[0]
+	}
+	return ""
+}
+
+// Add appends non-empty values to a property
+func (e *Entity) Add(prop string, values ...string) {
+	for _, v := range values {
+		if v == "" {
+			continue
+		}
+		if e.Properties == nil {
+			e.Properties = make(map[string][]string)
+		}
+		e.Properties[prop] = append(e.Properties[prop], v)
+	}
+}
+
+// UnmarshalJSON reads an entity whose property values are either strings or nested entities.
+// Nested entities are replaced with their ID and kept so the reader can flatten them.
+func (e *Entity) UnmarshalJSON(data []byte) error {
+	type entity Entity
+	var raw struct {
+		entity
+		Properties map[string][]json.RawMessage `json:"properties"`
+	}
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+
+	*e = Entity(raw.entity)
+	e.Properties = make(map[string][]string, len(raw.Properties))
+
+	for prop, values := range raw.Properties {
+		for _, value := range values {
+			value = bytes.TrimSpace(value)
+			if len(value) == 0 || bytes.Equal(value, []byte("null")) {
+				continue
+			}
+
+			switch value[0] {
+			case '{':
+				var child Entity
+				if err := json.Unmarshal(value, &child); err != nil {
+					return fmt.Errorf("reading nested %s of %s: %w", prop, e.ID, err)
+				}
+				child.parent = e.ID
+				e.nested = append(e.nested, child)
+				e.Add(prop, child.ID)
+
+			case '"':
+				var s string
+				if err := json.Unmarshal(value, &s); err != nil {
+					return fmt.Errorf("reading %s of %s: %w", prop, e.ID, err)
+				}
+				e.Add(prop, s)
+
+			default:
+				e.Add(prop, string(value))
+			}
+		}
+	}
+	return nil
+}
+
+// FtM schemata which are converted into Watchman entities
+const (
+	SchemaPerson       = "Person"
+	SchemaCompany      = "Company"
+	SchemaOrganization = "Organization"
+	SchemaLegalEntity  = "LegalEntity"
+	SchemaPublicBody   = "PublicBody"
+	SchemaVessel       = "Vessel"
+	SchemaAirplane     = "Airplane"
+)
+
+// FtM schemata which describe or connect other entities
+const (
+	SchemaAddress        = "Address"
+	SchemaSanction       = "Sanction"
+	SchemaIdentification = "Identification"
+	SchemaPassport       = "Passport"
+	SchemaCryptoWallet   = "CryptoWallet"
+	SchemaPosition       = "Position"
+	SchemaOccupancy      = "Occupancy"
+
+	SchemaOwnership      = "Ownership"
+	SchemaDirectorship   = "Directorship"
+	SchemaMembership     = "Membership"
+	SchemaEmployment     = "Employment"
+	SchemaRepresentation = "Representation"
+	SchemaFamily         = "Family"
+	SchemaAssociate      = "Associate"
+	SchemaUnknownLink    = "UnknownLink"
+)
+
+// link describes an FtM interval schema which connects two entities. The affiliation type
+// recorded on each entity depends on which side of the relationship it is.
+type link struct {
+	from, to         string // properties referencing each entity
+	fromType, toType string // affiliation types
+	details          string // property describing the relationship
+}
+
+var links = map[string]link{
+	SchemaOwnership:      {from: "owner", to: "asset", fromType: "Owns", toType: "Owned By", details: "role"},
+	SchemaDirectorship:   {from: "director", to: "organization", fromType: "Director Of", toType: "Directed By", details: "role"},
+	SchemaMembership:     {from: "member", to: "organization", fromType: "Member Of", toType: "Has Member", details: "role"},
+	SchemaEmployment:     {from: "employee", to: "employer", fromType: "Employed By", toType: "Employs", details: "role"},
+	SchemaRepresentation: {from: "agent", to: "client", fromType: "Represents", toType: "Represented By", details: "role"},
+	SchemaFamily:         {from: "person", to: "relative", fromType: "Family Member", toType: "Family Member", details: "relationship"},
+	SchemaAssociate:      {from: "person", to: "associate", fromType: "Associate Of", toType: "Associate Of", details: "relationship"},
+	SchemaUnknownLink:    {from: "subject", to: "object", fromType: "Linked To", toType: "Linked To", details: "role"},
+}
+
+// ExportOptions controls how entities are exported to FtM
+type ExportOptions struct {
+	// Dataset is used when the entity's Source is empty
+	Dataset string
+
+	// Format specifies the output format: "jsonl" for JSON Lines (the default) or "json" for JSON Array
+	Format string
+
+	// Pretty enables indented JSON output
+	Pretty bool
+}
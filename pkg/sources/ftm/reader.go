@@ -0,0 +1,607 @@
+package ftm
+
+import (
+	"bufio"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/moov-io/watchman/internal/norm"
+	"github.com/moov-io/watchman/pkg/search"
+)
+
+// ReadEntities reads FtM entities and converts them to Watchman entities.
+// Supports both JSON Lines and JSON Array input, detected by the first non-whitespace character.
+//
+// All entities are read before converting them because relationships, sanctions and identity
+// documents are separate FtM entities which are folded into the entities they reference.
+func ReadEntities(r io.Reader, sourceList search.SourceList) ([]search.Entity[search.Value], error) {
+	records, err := Read(r)
+	if err != nil {
+		return nil, err
+	}
+	return ToEntities(records, sourceList), nil
+}
+
+// Read decodes FtM entities from JSON Lines or a JSON Array. Entities nested inside
+// properties are returned alongside the entity which contained them.
+func Read(r io.Reader) ([]Entity, error) {
+	br := bufio.NewReader(r)
+
+	// Find the first non-whitespace character to detect format
+	var firstChar byte
+	var err error
+	for {
+		firstChar, err = br.ReadByte()
+		if err != nil {
+			if err == io.EOF {
+				return nil, nil // Empty input
+			}
+			return nil, fmt.Errorf("reading ftm input: %w", err)
+		}
+		if firstChar != ' ' && firstChar != '\n' && firstChar != '\r' && firstChar != '\t' {
+			break
+		}
+	}
+	if err := br.UnreadByte(); err != nil {
+		return nil, fmt.Errorf("unreading byte: %w", err)
+	}
+
+	dec := json.NewDecoder(br)
+	if firstChar == '[' {
+		if _, err := dec.Token(); err != nil {
+			return nil, fmt.Errorf("reading ftm array: %w", err)
+		}
+	}
+
+	var out []Entity
+	for dec.More() {
+		var record Entity
+		if err := dec.Decode(&record); err != nil {
+			if errors.Is(err, io.EOF) {
+				break
+			}
+			return nil, fmt.Errorf("decoding ftm entity %d: %w", len(out)+1, err)
+		}
+		out = flatten(out, record)
+	}
+	return out, nil
+}
+
+func flatten(out []Entity, record Entity) []Entity {
+	nested := record.nested
+	record.nested = nil
+
+	out = append(out, record)
+	for _, child := range nested {
+		out = flatten(out, child)
+	}
+	return out
+}
+
+// ToEntities converts FtM entities into Watchman entities. Only people, companies, organizations,
+// vessels and airplanes become entities. Other schemata are mapped onto the entities they reference:
+//
+//   - Sanction entities become SanctionsInfo
+//   - Ownership, Directorship, Family and other links become Affiliations on both sides
+//   - Identification and Passport entities become GovernmentIDs
+//   - CryptoWallet entities become CryptoAddresses
+//   - Occupancy entities become the Positions of the person holding them
+//   - Address entities referenced by addressEntity become Addresses
+func ToEntities(records []Entity, sourceList search.SourceList) []search.Entity[search.Value] {
+	byID := make(map[string]*Entity, len(records))
+	for i := range records {
+		byID[records[i].ID] = &records[i]
+	}
+	// Merged entities keep the IDs of the records they were merged from
+	for i := range records {
+		for _, ref := range records[i].Referents {
+			if _, exists := byID[ref]; !exists {
+				byID[ref] = &records[i]
+			}
+		}
+	}
+
+	var order []string
+	entities := make(map[string]*search.Entity[search.Value])
+	for _, record := range records {
+		if _, exists := entities[record.ID]; exists || !isParty(record.Schema) {
+			continue
+		}
+		entity := toEntity(record, byID, sourceList)
+		entities[record.ID] = &entity
+		order = append(order, record.ID)
+	}
+
+	find := func(id string) *search.Entity[search.Value] {
+		if record, exists := byID[id]; exists {
+			return entities[record.ID]
+		}
+		return nil
+	}
+
+	sanctions := make(map[string][]Entity)
+	for _, record := range records {
+		switch record.Schema {
+		case SchemaSanction:
+			for _, id := range orParent(record.Get("entity"), record.parent) {
+				if entity := find(id); entity != nil {
+					sanctions[entity.SourceID] = append(sanctions[entity.SourceID], record)
+				}
+			}
+
+		case SchemaIdentification, SchemaPassport:
+			for _, id := range orParent(record.Get("holder"), record.parent) {
+				if entity := find(id); entity != nil {
+					addGovernmentIDs(entity, mapIdentification(record))
+				}
+			}
+
+		case SchemaCryptoWallet:
+			for _, id := range orParent(record.Get("holder"), record.parent) {
+				if entity := find(id); entity != nil && record.First("publicKey") != "" {
+					entity.CryptoAddresses = append(entity.CryptoAddresses, search.CryptoAddress{
+						Currency: record.First("currency"),
+						Address:  record.First("publicKey"),
+					})
+				}
+			}
+
+		case SchemaOccupancy:
+			for _, id := range orParent(record.Get("holder"), record.parent) {
+				if entity := find(id); entity != nil && entity.Person != nil {
+					for _, post := range record.Get("post") {
+						if position, exists := byID[post]; exists {
+							addPosition(entity.Person, mapPosition(record, *position))
+						}
+					}
+				}
+			}
+
+		default:
+			if l, ok := links[record.Schema]; ok {
+				linkEntities(record, l, find)
+			}
+		}
+	}
+
+	out := make([]search.Entity[search.Value], 0, len(order))
+	for _, id := range order {
+		entity := entities[id]
+		entity.SanctionsInfo = mapSanctionsInfo(sanctions[id])
+		if entity.SanctionsInfo == nil && slices.Contains(entity.Topics, search.TopicSanction) {
+			entity.SanctionsInfo = &search.SanctionsInfo{}
+		}
+		out = append(out, entity.Normalize())
+	}
+	return out
+}
+
+func isParty(schema string) bool {
+	switch schema {
+	case SchemaPerson, SchemaCompany, SchemaOrganization, SchemaLegalEntity, SchemaPublicBody, SchemaVessel, SchemaAirplane:
+		return true
+	}
+	return false
+}
+
+// orParent returns the referenced IDs, or the entity a record was nested inside of.
+// Nested records omit the reference back to their parent.
+func orParent(ids []string, parent string) []string {
+	if len(ids) == 0 && parent != "" {
+		return []string{parent}
+	}
+	return ids
+}
+
+func toEntity(record Entity, byID map[string]*Entity, sourceList search.SourceList) search.Entity[search.Value] {
+	entity := search.Entity[search.Value]{
+		Name:       primaryName(record),
+		Source:     sourceList,
+		SourceID:   record.ID,
+		SourceData: record,
+	}
+
+	var altNames []string
+	for _, prop := range []string{"name", "alias", "weakAlias"} {
+		for _, name := range record.Get(prop) {
+			name = strings.TrimSpace(name)
+			if name != "" && name != entity.Name && !slices.Contains(altNames, name) {
+				altNames = append(altNames, name)
+			}
+		}
+	}
+
+	country := norm.Country(firstOf(record, "country", "jurisdiction", "nationality", "flag"))
+	governmentIDs := mapGovernmentIDs(record, country)
+
+	switch record.Schema {
+	case SchemaPerson:
+		entity.Type = search.EntityPerson
+		entity.Person = &search.Person{
+			Name:          entity.Name,
+			AltNames:      altNames,
+			Gender:        mapGender(record.First("gender")),
+			BirthDate:     parseDate(record.First("birthDate")),
+			PlaceOfBirth:  record.First("birthPlace"),
+			DeathDate:     parseDate(record.First("deathDate")),
+			Titles:        append(slices.Clone(record.Get("title")), record.Get("position")...),
+			Positions:     mapTitlePositions(record.Get("position")),
+			GovernmentIDs: governmentIDs,
+		}
+
+	case SchemaOrganization, SchemaPublicBody:
+		entity.Type = search.EntityOrganization
+		entity.Organization = &search.Organization{
+			Name:          entity.Name,
+			AltNames:      altNames,
+			Created:       parseDate(record.First("incorporationDate")),
+			Dissolved:     parseDate(record.First("dissolutionDate")),
+			GovernmentIDs: governmentIDs,
+		}
+
+	case SchemaVessel:
+		entity.Type = search.EntityVessel
+		entity.Vessel = &search.Vessel{
+			Name:                   entity.Name,
+			AltNames:               altNames,
+			IMONumber:              record.First("imoNumber"),
+			Type:                   search.VesselType(record.First("type")),
+			Flag:                   norm.Country(record.First("flag")),
+			Built:                  parseDate(record.First("buildDate")),
+			Model:                  record.First("model"),
+			Tonnage:                parseInt(record.First("tonnage")),
+			MMSI:                   record.First("mmsi"),
+			CallSign:               record.First("callSign"),
+			GrossRegisteredTonnage: parseInt(record.First("grossRegisteredTonnage")),
+		}
+
+	case SchemaAirplane:
+		entity.Type = search.EntityAircraft
+		entity.Aircraft = &search.Aircraft{
+			Name:         entity.Name,
+			AltNames:     altNames,
+			Type:         search.AircraftType(record.First("type")),
+			Flag:         norm.Country(record.First("flag")),
+			Built:        parseDate(record.First("buildDate")),
+			ICAOCode:     record.First("icaoCode"),
+			Model:        record.First("model"),
+			SerialNumber: record.First("serialNumber"),
+		}
+
+	default:
+		entity.Type = search.EntityBusiness
+		entity.Business = &search.Business{
+			Name:          entity.Name,
+			AltNames:      altNames,
+			Created:       parseDate(record.First("incorporationDate")),
+			Dissolved:     parseDate(record.First("dissolutionDate")),
+			GovernmentIDs: governmentIDs,
+		}
+	}
+
+	entity.Contact = search.ContactInfo{
+		EmailAddresses: record.Get("email"),
+		PhoneNumbers:   record.Get("phone"),
+		Websites:       record.Get("website"),
+	}
+	entity.Addresses = mapAddresses(record, byID)
+	entity.HistoricalInfo = mapHistoricalInfo(record)
+	entity.Topics = record.Get("topics")
+
+	return entity
+}
+
+func primaryName(record Entity) string {
+	if name := strings.TrimSpace(record.First("name")); name != "" {
+		return name
+	}
+	if record.Schema == SchemaPerson {
+		var parts []string
+		for _, prop := range []string{"firstName", "secondName", "middleName", "fatherName", "lastName"} {
+			if v := strings.TrimSpace(record.First(prop)); v != "" {
+				parts = append(parts, v)
+			}
+		}
+		if len(parts) > 0 {
+			return strings.Join(parts, " ")
+		}
+	}
+	return strings.TrimSpace(record.Caption)
+}
+
+func firstOf(record Entity, props ...string) string {
+	for _, prop := range props {
+		if v := record.First(prop); v != "" {
+			return v
+		}
+	}
+	return ""
+}
+
+// identifierTypes are the FtM properties holding government issued identifiers
+var identifierTypes = []struct {
+	prop string
+	kind search.GovernmentIDType
+}{
+	{"passportNumber", search.GovernmentIDPassport},
+	{"idNumber", search.GovernmentIDNational},
+	{"socialSecurityNumber", search.GovernmentIDSSN},
+	{"taxNumber", search.GovernmentIDTax},
+	{"innCode", search.GovernmentIDTax},
+	{"vatCode", search.GovernmentIDTax},
+	{"registrationNumber", search.GovernmentIDBusinessRegisration},
+	{"ogrnCode", search.GovernmentIDBusinessRegisration},
+	{"swiftBic", search.GovernmentIDSWIFT},
+}
+
+func mapGovernmentIDs(record Entity, country string) []search.GovernmentID {
+	var out []search.GovernmentID
+	for _, id := range identifierTypes {
+		for _, value := range record.Get(id.prop) {
+			out = append(out, search.GovernmentID{
+				Type:       id.kind,
+				Country:    country,
+				Identifier: value,
+			})
+		}
+	}
+	for _, value := range record.Get("nationality") {
+		out = append(out, search.GovernmentID{
+			Type:       search.GovernmentIDNationality,
+			Country:    norm.Country(value),
+			Identifier: value,
+		})
+	}
+	for _, value := range record.Get("citizenship") {
+		out = append(out, search.GovernmentID{
+			Type:       search.GovernmentIDCitizenship,
+			Country:    norm.Country(value),
+			Identifier: value,
+		})
+	}
+	return out
+}
+
+func mapIdentification(record Entity) search.GovernmentID {
+	kind := search.GovernmentIDNational
+	if record.Schema == SchemaPassport || strings.Contains(strings.ToLower(record.First("type")), "passport") {
+		kind = search.GovernmentIDPassport
+	}
+	return search.GovernmentID{
+		Name:       record.First("type"),
+		Type:       kind,
+		Country:    norm.Country(record.First("country")),
+		Identifier: record.First("number"),
+	}
+}
+
+func addGovernmentIDs(entity *search.Entity[search.Value], id search.GovernmentID) {
+	if id.Identifier == "" {
+		return
+	}
+	switch {
+	case entity.Person != nil:
+		entity.Person.GovernmentIDs = appendGovernmentID(entity.Person.GovernmentIDs, id)
+	case entity.Business != nil:
+		entity.Business.GovernmentIDs = appendGovernmentID(entity.Business.GovernmentIDs, id)
+	case entity.Organization != nil:
+		entity.Organization.GovernmentIDs = appendGovernmentID(entity.Organization.GovernmentIDs, id)
+	}
+}
+
+func appendGovernmentID(ids []search.GovernmentID, id search.GovernmentID) []search.GovernmentID {
+	for _, existing := range ids {
+		if existing.Type == id.Type && existing.Identifier == id.Identifier {
+			return ids
+		}
+	}
+	return append(ids, id)
+}
+
+func mapAddresses(record Entity, byID map[string]*Entity) []search.Address {
+	var out []search.Address
+	for _, id := range record.Get("addressEntity") {
+		addr, exists := byID[id]
+		if !exists {
+			continue
+		}
+		address := search.Address{
+			Line1:      addr.First("street"),
+			Line2:      addr.First("street2"),
+			City:       addr.First("city"),
+			PostalCode: addr.First("postalCode"),
+			State:      firstOf(*addr, "state", "region"),
+			Country:    norm.Country(addr.First("country")),
+		}
+		if address.Line1 == "" && address.City == "" {
+			address.Line1 = firstOf(*addr, "full", "name")
+		}
+		out = append(out, address)
+	}
+	if len(out) > 0 {
+		return out
+	}
+
+	// Plain addresses are a single line of text
+	var country string
+	if countries := record.Get("country"); len(countries) == 1 {
+		country = norm.Country(countries[0])
+	}
+	for _, addr := range record.Get("address") {
+		out = append(out, search.Address{
+			Line1:   strings.TrimSpace(addr),
+			Country: country,
+		})
+	}
+	return out
+}
+
+func mapHistoricalInfo(record Entity) []search.HistoricalInfo {
+	var out []search.HistoricalInfo
+	for _, name := range record.Get("previousName") {
+		out = append(out, search.HistoricalInfo{
+			Type:  "Former Name",
+			Value: name,
+		})
+	}
+	for _, flag := range record.Get("pastFlags") {
+		out = append(out, search.HistoricalInfo{
+			Type:  "Previous Flag",
+			Value: norm.Country(flag),
+		})
+	}
+	return out
+}
+
+// linkEntities records an affiliation on each entity connected by a relationship
+func linkEntities(record Entity, l link, find func(id string) *search.Entity[search.Value]) {
+	from := orParent(record.Get(l.from), record.parent)
+	to := record.Get(l.to)
+	if len(to) == 0 && len(record.Get(l.from)) > 0 {
+		to = orParent(nil, record.parent)
+	}
+	details := strings.Join(record.Get(l.details), "; ")
+
+	for _, fromID := range from {
+		for _, toID := range to {
+			source, target := find(fromID), find(toID)
+			if source == nil || target == nil || source == target {
+				continue
+			}
+			source.Affiliations = appendAffiliation(source.Affiliations, search.Affiliation{
+				EntityName: target.Name,
+				Type:       l.fromType,
+				Details:    details,
+			})
+			target.Affiliations = appendAffiliation(target.Affiliations, search.Affiliation{
+				EntityName: source.Name,
+				Type:       l.toType,
+				Details:    details,
+			})
+		}
+	}
+}
+
+func appendAffiliation(affiliations []search.Affiliation, aff search.Affiliation) []search.Affiliation {
+	if slices.Contains(affiliations, aff) {
+		return affiliations
+	}
+	return append(affiliations, aff)
+}
+
+// mapTitlePositions returns the positions named in a person's "position" property. Only their
+// titles are known until an Occupancy is read for them.
+func mapTitlePositions(titles []string) []search.Position {
+	var out []search.Position
+	for _, title := range titles {
+		if title = strings.TrimSpace(title); title != "" {
+			out = append(out, search.Position{Title: title})
+		}
+	}
+	return out
+}
+
+func mapPosition(occupancy, position Entity) search.Position {
+	return search.Position{
+		Title:   primaryName(position),
+		Country: norm.Country(firstOf(position, "country", "subnationalArea")),
+		Start:   parseDate(occupancy.First("startDate")),
+		End:     parseDate(occupancy.First("endDate")),
+	}
+}
+
+// addPosition records an occupancy on the person. Positions read from the person's own
+// "position" property only have a title, so they're replaced by the occupancy.
+func addPosition(person *search.Person, position search.Position) {
+	if position.Title == "" {
+		return
+	}
+	if !slices.Contains(person.Titles, position.Title) {
+		person.Titles = append(person.Titles, position.Title)
+	}
+	for i, existing := range person.Positions {
+		if existing == (search.Position{Title: position.Title}) {
+			person.Positions[i] = position
+			return
+		}
+	}
+	for _, existing := range person.Positions {
+		if existing.Title == position.Title && existing.Country == position.Country &&
+			formatDate(existing.Start) == formatDate(position.Start) && formatDate(existing.End) == formatDate(position.End) {
+			return
+		}
+	}
+	person.Positions = append(person.Positions, position)
+}
+
+func mapSanctionsInfo(sanctions []Entity) *search.SanctionsInfo {
+	if len(sanctions) == 0 {
+		return nil
+	}
+
+	var info search.SanctionsInfo
+	var description []string
+	for _, sanction := range sanctions {
+		programs := sanction.Get("program")
+		if len(programs) == 0 {
+			programs = sanction.Get("programId")
+		}
+		if len(programs) == 0 {
+			programs = sanction.Get("authority")
+		}
+		for _, program := range programs {
+			if program = strings.TrimSpace(program); program != "" && !slices.Contains(info.Programs, program) {
+				info.Programs = append(info.Programs, program)
+			}
+		}
+
+		for _, provision := range sanction.Get("provisions") {
+			if strings.Contains(strings.ToLower(provision), "secondary") {
+				info.Secondary = true
+			}
+		}
+		for _, reason := range sanction.Get("reason") {
+			if reason = strings.TrimSpace(reason); reason != "" && !slices.Contains(description, reason) {
+				description = append(description, reason)
+			}
+		}
+	}
+	info.Description = strings.Join(description, "; ")
+
+	return &info
+}
+
+func mapGender(value string) search.Gender {
+	switch strings.ToLower(strings.TrimSpace(value)) {
+	case "male", "m":
+		return search.GenderMale
+	case "female", "f":
+		return search.GenderFemale
+	}
+	return search.GenderUnknown
+}
+
+// parseDate reads FtM dates, which are ISO 8601 prefixes of varying precision
+func parseDate(value string) *time.Time {
+	value = strings.TrimSpace(value)
+	if value == "" {
+		return nil
+	}
+	for _, format := range []string{time.RFC3339, "2006-01-02T15:04:05", "2006-01-02", "2006-01", "2006"} {
+		if t, err := time.Parse(format, value); err == nil {
+			return &t
+		}
+	}
+	return nil
+}
+
+func parseInt(value string) int {
+	n, _ := strconv.ParseFloat(strings.TrimSpace(value), 64)
+	return int(n)
+}
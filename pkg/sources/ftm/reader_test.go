@@ -0,0 +1,238 @@
+package ftm
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/moov-io/watchman/pkg/search"
+	"github.com/stretchr/testify/require"
+)
+
+func readTestEntities(t *testing.T) []search.Entity[search.Value] {
+	t.Helper()
+
+	fd, err := os.Open(filepath.Join("testdata", "entities.ftm.json"))
+	require.NoError(t, err)
+	t.Cleanup(func() { fd.Close() })
+
+	entities, err := ReadEntities(fd, "opensanctions_sanctions")
+	require.NoError(t, err)
+	return entities
+}
+
+func findEntity(t *testing.T, entities []search.Entity[search.Value], sourceID string) search.Entity[search.Value] {
+	t.Helper()
+
+	for _, entity := range entities {
+		if entity.SourceID == sourceID {
+			return entity
+		}
+	}
+	t.Fatalf("entity %s not found", sourceID)
+	return search.Entity[search.Value]{}
+}
+
+func TestReadEntities(t *testing.T) {
+	entities := readTestEntities(t)
+	require.Len(t, entities, 4) // Address, links, sanctions and documents are folded into parties
+
+	t.Run("person", func(t *testing.T) {
+		person := findEntity(t, entities, "ofac-11111")
+		require.Equal(t, "Ivan PETROV", person.Name)
+		require.Equal(t, search.EntityPerson, person.Type)
+		require.Equal(t, search.SourceList("opensanctions_sanctions"), person.Source)
+
+		require.NotNil(t, person.Person)
+		require.Equal(t, []string{"Ivan Petrovich PETROV"}, person.Person.AltNames)
+		require.Equal(t, search.GenderMale, person.Person.Gender)
+		require.Equal(t, time.Date(1961, time.March, 9, 0, 0, 0, 0, time.UTC), *person.Person.BirthDate)
+		require.Equal(t, "Moscow, Russia", person.Person.PlaceOfBirth)
+
+		expectedIDs := []search.GovernmentID{
+			{Type: search.GovernmentIDPassport, Country: "Russia", Identifier: "720123456"},
+			{Type: search.GovernmentIDNationality, Country: "Russia", Identifier: "ru"},
+		}
+		require.Equal(t, expectedIDs, person.Person.GovernmentIDs)
+
+		expectedAddresses := []search.Address{
+			{Line1: "12 Tverskaya St", City: "Moscow", PostalCode: "125009", Country: "Russia"},
+		}
+		require.Equal(t, expectedAddresses, person.Addresses)
+
+		expectedAffiliations := []search.Affiliation{
+			{EntityName: "Petrov Holdings LLC", Type: "Owns", Details: "shareholder"},
+			{EntityName: "Anna PETROVA", Type: "Family Member", Details: "Spouse"},
+		}
+		require.Equal(t, expectedAffiliations, person.Affiliations)
+
+		require.Equal(t, &search.SanctionsInfo{
+			Programs:    []string{"RUSSIA-EO14024", "UKRAINE-EO13662"},
+			Secondary:   true,
+			Description: "Operating in the financial services sector of the Russian Federation economy",
+		}, person.SanctionsInfo)
+
+		expectedHistory := []search.HistoricalInfo{
+			{Type: "Former Name", Value: "Ivan SIDOROV"},
+		}
+		require.Equal(t, expectedHistory, person.HistoricalInfo)
+
+		require.Equal(t, []string{"sanction"}, person.Topics)
+
+		require.IsType(t, Entity{}, person.SourceData)
+		require.NotEmpty(t, person.PreparedFields.Name)
+	})
+
+	t.Run("relative", func(t *testing.T) {
+		person := findEntity(t, entities, "ofac-33333")
+		require.Equal(t, "Anna PETROVA", person.Name)
+		require.Equal(t, search.GenderFemale, person.Person.Gender)
+		require.Equal(t, 1965, person.Person.BirthDate.Year())
+		require.Nil(t, person.SanctionsInfo)
+
+		require.Equal(t, []search.GovernmentID{
+			{Name: "National ID", Type: search.GovernmentIDNational, Country: "Russia", Identifier: "4510 123456"},
+		}, person.Person.GovernmentIDs)
+		require.Equal(t, []search.Affiliation{
+			{EntityName: "Ivan PETROV", Type: "Family Member", Details: "Spouse"},
+		}, person.Affiliations)
+	})
+
+	t.Run("company", func(t *testing.T) {
+		company := findEntity(t, entities, "ofac-22222")
+		require.Equal(t, search.EntityBusiness, company.Type)
+		require.NotNil(t, company.Business)
+		require.Equal(t, time.Date(2004, time.June, 1, 0, 0, 0, 0, time.UTC), *company.Business.Created)
+		require.Len(t, company.Business.GovernmentIDs, 2)
+		require.Equal(t, search.GovernmentIDTax, company.Business.GovernmentIDs[0].Type)
+		require.Equal(t, search.GovernmentIDBusinessRegisration, company.Business.GovernmentIDs[1].Type)
+
+		require.Equal(t, []search.Address{{Line1: "Moscow, Russia", Country: "Russia"}}, company.Addresses)
+		require.Equal(t, []string{"https://petrov-holdings.example"}, company.Contact.Websites)
+		require.Equal(t, []search.CryptoAddress{
+			{Currency: "ETH", Address: "0x7F367cC41522cE07553e823bf3be79A889DEbe1B"},
+		}, company.CryptoAddresses)
+
+		expectedAffiliations := []search.Affiliation{
+			{EntityName: "Ivan PETROV", Type: "Owned By", Details: "shareholder"},
+			{EntityName: "OCEAN STAR", Type: "Owns"},
+		}
+		require.Equal(t, expectedAffiliations, company.Affiliations)
+
+		// Sanctions without a program fall back to the authority
+		require.Equal(t, []string{"Office of Foreign Assets Control"}, company.SanctionsInfo.Programs)
+	})
+
+	t.Run("vessel", func(t *testing.T) {
+		vessel := findEntity(t, entities, "ofac-44444")
+		require.Equal(t, search.EntityVessel, vessel.Type)
+		require.Equal(t, "9187629", vessel.Vessel.IMONumber)
+		require.Equal(t, "351234000", vessel.Vessel.MMSI)
+		require.Equal(t, "3FAB7", vessel.Vessel.CallSign)
+		require.Equal(t, "Panama", vessel.Vessel.Flag)
+		require.Equal(t, 81479, vessel.Vessel.GrossRegisteredTonnage)
+		require.Equal(t, 1999, vessel.Vessel.Built.Year())
+		require.Equal(t, []search.HistoricalInfo{{Type: "Previous Flag", Value: "Russia"}}, vessel.HistoricalInfo)
+		require.Equal(t, []search.Affiliation{{EntityName: "Petrov Holdings LLC", Type: "Owned By"}}, vessel.Affiliations)
+	})
+}
+
+func TestReadEntities_Nested(t *testing.T) {
+	input := `[
+  {
+    "id": "NK-abc",
+    "schema": "Person",
+    "properties": {
+      "name": ["Maria GARCIA"],
+      "sanctions": [
+        {"id": "NK-abc-sanction", "schema": "Sanction", "properties": {"program": ["SDGT"], "startDate": ["2019-01-01"]}}
+      ],
+      "ownershipOwner": [
+        {"id": "NK-abc-own", "schema": "Ownership", "properties": {
+          "asset": [{"id": "NK-def", "schema": "Company", "properties": {"name": ["Garcia Trading SA"]}}]
+        }}
+      ]
+    }
+  }
+]`
+	records, err := Read(strings.NewReader(input))
+	require.NoError(t, err)
+	require.Len(t, records, 4)
+	require.Equal(t, []string{"NK-abc-sanction"}, records[0].Get("sanctions"))
+
+	entities := ToEntities(records, "test")
+	require.Len(t, entities, 2)
+
+	person := findEntity(t, entities, "NK-abc")
+	require.Equal(t, []string{"SDGT"}, person.SanctionsInfo.Programs)
+	require.Equal(t, []search.Affiliation{{EntityName: "Garcia Trading SA", Type: "Owns"}}, person.Affiliations)
+
+	company := findEntity(t, entities, "NK-def")
+	require.Equal(t, []search.Affiliation{{EntityName: "Maria GARCIA", Type: "Owned By"}}, company.Affiliations)
+}
+
+func TestReadEntities_Occupancy(t *testing.T) {
+	input := `{"id": "Q1", "schema": "Person", "properties": {"name": ["Jane DOE"], "topics": ["role.pep"], "position": ["Minister of Finance"], "positionOccupancies": [
+  {"id": "occ-1", "schema": "Occupancy", "properties": {"startDate": ["2015-01-10"], "endDate": ["2019-06-30"], "post": [
+    {"id": "pos-1", "schema": "Position", "properties": {"name": ["Minister of Finance"], "country": ["ng"]}}
+  ]}},
+  {"id": "occ-2", "schema": "Occupancy", "properties": {"startDate": ["2020"], "post": [
+    {"id": "pos-2", "schema": "Position", "properties": {"name": ["Member of the Senate"], "country": ["ng"]}}
+  ]}}
+]}}`
+	entities, err := ReadEntities(strings.NewReader(input), "test")
+	require.NoError(t, err)
+	require.Len(t, entities, 1)
+
+	person := entities[0].Person
+	require.Equal(t, []string{"Minister of Finance", "Member of the Senate"}, person.Titles)
+	require.Len(t, person.Positions, 2)
+
+	minister := person.Positions[0]
+	require.Equal(t, "Minister of Finance", minister.Title)
+	require.Equal(t, "Nigeria", minister.Country)
+	require.Equal(t, time.Date(2015, time.January, 10, 0, 0, 0, 0, time.UTC), *minister.Start)
+	require.Equal(t, time.Date(2019, time.June, 30, 0, 0, 0, 0, time.UTC), *minister.End)
+
+	senator := person.Positions[1]
+	require.Equal(t, "Member of the Senate", senator.Title)
+	require.Nil(t, senator.End)
+	require.Nil(t, person.TenureEnded())
+
+	require.True(t, entities[0].InCategory(search.CategoryPEP))
+
+	// Positions without an Occupancy only have their title
+	input = `{"id": "Q2", "schema": "Person", "properties": {"name": ["John DOE"], "topics": ["role.pep"], "title": ["Dr."], "position": ["Governor of Lagos"]}}`
+	entities, err = ReadEntities(strings.NewReader(input), "test")
+	require.NoError(t, err)
+	require.Len(t, entities, 1)
+	require.Equal(t, []search.Position{{Title: "Governor of Lagos"}}, entities[0].Person.Positions)
+}
+
+func TestReadEntities_Empty(t *testing.T) {
+	entities, err := ReadEntities(strings.NewReader("  \n"), "test")
+	require.NoError(t, err)
+	require.Empty(t, entities)
+
+	_, err = ReadEntities(strings.NewReader(`{"id": "x", "schema": `), "test")
+	require.ErrorContains(t, err, "decoding ftm entity 1")
+}
+
+func TestParseDate(t *testing.T) {
+	cases := map[string]time.Time{
+		"2022-04-06":           time.Date(2022, time.April, 6, 0, 0, 0, 0, time.UTC),
+		"2022-04":              time.Date(2022, time.April, 1, 0, 0, 0, 0, time.UTC),
+		"2022":                 time.Date(2022, time.January, 1, 0, 0, 0, 0, time.UTC),
+		"2022-04-06T10:30:00":  time.Date(2022, time.April, 6, 10, 30, 0, 0, time.UTC),
+		"2022-04-06T10:30:00Z": time.Date(2022, time.April, 6, 10, 30, 0, 0, time.UTC),
+	}
+	for input, expected := range cases {
+		got := parseDate(input)
+		require.NotNil(t, got, input)
+		require.True(t, expected.Equal(*got), input)
+	}
+	require.Nil(t, parseDate(""))
+	require.Nil(t, parseDate("circa 1960"))
+}
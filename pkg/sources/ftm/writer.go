@@ -0,0 +1,392 @@
+package ftm
+
+import (
+	"cmp"
+	"crypto/sha1"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/moov-io/watchman/internal/norm"
+	"github.com/moov-io/watchman/pkg/search"
+)
+
+// WriteEntities exports Watchman entities to FtM.
+// Supports both JSON Lines ("jsonl") and JSON Array ("json") output formats.
+func WriteEntities(w io.Writer, entities []search.Entity[search.Value], opts ExportOptions) error {
+	return writeRecords(w, FromWatchmanEntities(entities, opts.Dataset), opts)
+}
+
+// WriteSearchedEntities exports search results to FtM.
+// Supports both JSON Lines ("jsonl") and JSON Array ("json") output formats.
+func WriteSearchedEntities(w io.Writer, entities []search.SearchedEntity[search.Value], opts ExportOptions) error {
+	out := make([]search.Entity[search.Value], 0, len(entities))
+	for _, entity := range entities {
+		out = append(out, entity.Entity)
+	}
+	return WriteEntities(w, out, opts)
+}
+
+// ContentType returns the media type of entities written in format, "application/json" for
+// JSON Array output and "application/x-ndjson" otherwise.
+func ContentType(format string) string {
+	switch strings.ToLower(format) {
+	case "json", "array":
+		return "application/json"
+	}
+	return "application/x-ndjson"
+}
+
+func writeRecords(w io.Writer, records []Entity, opts ExportOptions) error {
+	switch strings.ToLower(opts.Format) {
+	case "jsonl", "json-lines", "ndjson", "":
+		return writeJSONLines(w, records, opts.Pretty)
+	case "json", "array":
+		return writeJSONArray(w, records, opts.Pretty)
+	default:
+		return fmt.Errorf("unknown ftm export format: %s", opts.Format)
+	}
+}
+
+func writeJSONArray(w io.Writer, records []Entity, pretty bool) error {
+	encoder := json.NewEncoder(w)
+	if pretty {
+		encoder.SetIndent("", "  ")
+	}
+	return encoder.Encode(records)
+}
+
+func writeJSONLines(w io.Writer, records []Entity, pretty bool) error {
+	encoder := json.NewEncoder(w)
+	if pretty {
+		encoder.SetIndent("", "  ")
+	}
+	for _, record := range records {
+		if err := encoder.Encode(record); err != nil {
+			return fmt.Errorf("writing ftm entity %s: %w", record.ID, err)
+		}
+	}
+	return nil
+}
+
+// FromWatchmanEntities converts Watchman entities into FtM entities. Alongside each entity the
+// Address, Sanction, CryptoWallet, Position and relationship entities describing it are returned.
+// Affiliations with entities which are not part of the export reference a LegalEntity
+// holding only the affiliated name.
+func FromWatchmanEntities(entities []search.Entity[search.Value], defaultDataset string) []Entity {
+	ids := make(map[string]string, len(entities))
+	for _, entity := range entities {
+		key := strings.ToLower(entity.Name)
+		if _, exists := ids[key]; !exists {
+			ids[key] = entityID(entity)
+		}
+	}
+
+	var out []Entity
+	seen := make(map[string]bool)
+	emit := func(record Entity) {
+		if record.ID == "" || seen[record.ID] {
+			return
+		}
+		seen[record.ID] = true
+		out = append(out, record)
+	}
+
+	for _, entity := range entities {
+		dataset := cmp.Or(string(entity.Source), defaultDataset)
+		datasets := []string{dataset}
+		if dataset == "" {
+			datasets = nil
+		}
+
+		record, related := FromWatchmanEntity(entity)
+		record.Datasets = datasets
+		emit(record)
+
+		for _, r := range related {
+			r.Datasets = datasets
+			emit(r)
+		}
+
+		for _, aff := range entity.Affiliations {
+			if aff.EntityName == "" {
+				continue
+			}
+			otherID, exists := ids[strings.ToLower(aff.EntityName)]
+			if !exists {
+				other := Entity{
+					ID:     makeID(SchemaLegalEntity, aff.EntityName),
+					Schema: SchemaLegalEntity,
+				}
+				other.Add("name", aff.EntityName)
+				other.Datasets = datasets
+				emit(other)
+				otherID = other.ID
+			}
+			rel := linkRecord(record.ID, otherID, aff)
+			rel.Datasets = datasets
+			emit(rel)
+		}
+	}
+	return out
+}
+
+func entityID(entity search.Entity[search.Value]) string {
+	if entity.SourceID != "" {
+		return entity.SourceID
+	}
+	return makeID(string(entity.Source), string(entity.Type), entity.Name)
+}
+
+// makeID derives a stable entity ID from its parts
+func makeID(parts ...string) string {
+	h := sha1.Sum([]byte(strings.Join(parts, ".")))
+	return hex.EncodeToString(h[:])
+}
+
+// FromWatchmanEntity converts a Watchman entity into an FtM entity and the Address,
+// Sanction, CryptoWallet, Position and Occupancy entities which reference it.
+func FromWatchmanEntity(entity search.Entity[search.Value]) (Entity, []Entity) {
+	record := Entity{
+		ID:      entityID(entity),
+		Caption: entity.Name,
+	}
+	record.Add("name", entity.Name)
+
+	var governmentIDs []search.GovernmentID
+
+	switch {
+	case entity.Person != nil:
+		record.Schema = SchemaPerson
+		record.Add("alias", entity.Person.AltNames...)
+		record.Add("gender", formatGender(entity.Person.Gender))
+		record.Add("birthDate", formatDate(entity.Person.BirthDate))
+		record.Add("birthPlace", entity.Person.PlaceOfBirth)
+		record.Add("deathDate", formatDate(entity.Person.DeathDate))
+		record.Add("title", entity.Person.Titles...)
+		governmentIDs = entity.Person.GovernmentIDs
+
+	case entity.Business != nil:
+		record.Schema = SchemaCompany
+		record.Add("alias", entity.Business.AltNames...)
+		record.Add("incorporationDate", formatDate(entity.Business.Created))
+		record.Add("dissolutionDate", formatDate(entity.Business.Dissolved))
+		governmentIDs = entity.Business.GovernmentIDs
+
+	case entity.Organization != nil:
+		record.Schema = SchemaOrganization
+		record.Add("alias", entity.Organization.AltNames...)
+		record.Add("incorporationDate", formatDate(entity.Organization.Created))
+		record.Add("dissolutionDate", formatDate(entity.Organization.Dissolved))
+		governmentIDs = entity.Organization.GovernmentIDs
+
+	case entity.Vessel != nil:
+		record.Schema = SchemaVessel
+		record.Add("alias", entity.Vessel.AltNames...)
+		record.Add("imoNumber", entity.Vessel.IMONumber)
+		record.Add("type", string(entity.Vessel.Type))
+		record.Add("flag", countryCode(entity.Vessel.Flag))
+		record.Add("buildDate", formatDate(entity.Vessel.Built))
+		record.Add("model", entity.Vessel.Model)
+		record.Add("tonnage", formatInt(entity.Vessel.Tonnage))
+		record.Add("mmsi", entity.Vessel.MMSI)
+		record.Add("callSign", entity.Vessel.CallSign)
+		record.Add("grossRegisteredTonnage", formatInt(entity.Vessel.GrossRegisteredTonnage))
+
+	case entity.Aircraft != nil:
+		record.Schema = SchemaAirplane
+		record.Add("alias", entity.Aircraft.AltNames...)
+		record.Add("type", string(entity.Aircraft.Type))
+		record.Add("flag", countryCode(entity.Aircraft.Flag))
+		record.Add("buildDate", formatDate(entity.Aircraft.Built))
+		record.Add("icaoCode", entity.Aircraft.ICAOCode)
+		record.Add("model", entity.Aircraft.Model)
+		record.Add("serialNumber", entity.Aircraft.SerialNumber)
+
+	default:
+		record.Schema = SchemaLegalEntity
+	}
+
+	for _, id := range governmentIDs {
+		switch id.Type {
+		case search.GovernmentIDNationality:
+			record.Add("nationality", countryCode(cmp.Or(id.Country, id.Identifier)))
+		case search.GovernmentIDCitizenship:
+			record.Add("citizenship", countryCode(cmp.Or(id.Country, id.Identifier)))
+		default:
+			if prop := identifierProperty(id.Type); prop != "" {
+				record.Add(prop, id.Identifier)
+			}
+		}
+	}
+
+	record.Add("topics", entity.Topics...)
+	if entity.SanctionsInfo != nil && !slices.Contains(entity.Topics, search.TopicSanction) {
+		record.Add("topics", search.TopicSanction)
+	}
+
+	record.Add("email", entity.Contact.EmailAddresses...)
+	record.Add("phone", entity.Contact.PhoneNumbers...)
+	record.Add("website", entity.Contact.Websites...)
+
+	for _, hist := range entity.HistoricalInfo {
+		switch hist.Type {
+		case "Former Name":
+			record.Add("previousName", hist.Value)
+		case "Previous Flag":
+			record.Add("pastFlags", countryCode(hist.Value))
+		}
+	}
+
+	var related []Entity
+	for _, addr := range entity.Addresses {
+		address := Entity{
+			ID:     makeID(SchemaAddress, addr.Format()),
+			Schema: SchemaAddress,
+		}
+		address.Add("full", addr.Format())
+		address.Add("street", addr.Line1)
+		address.Add("street2", addr.Line2)
+		address.Add("city", addr.City)
+		address.Add("postalCode", addr.PostalCode)
+		address.Add("state", addr.State)
+		address.Add("country", countryCode(addr.Country))
+
+		record.Add("addressEntity", address.ID)
+		if code := countryCode(addr.Country); !slices.Contains(record.Get("country"), code) {
+			record.Add("country", code)
+		}
+		related = append(related, address)
+	}
+
+	for _, crypto := range entity.CryptoAddresses {
+		wallet := Entity{
+			ID:     makeID(SchemaCryptoWallet, crypto.Currency, crypto.Address),
+			Schema: SchemaCryptoWallet,
+		}
+		wallet.Add("publicKey", crypto.Address)
+		wallet.Add("currency", crypto.Currency)
+		wallet.Add("holder", record.ID)
+		related = append(related, wallet)
+	}
+
+	if entity.Person != nil {
+		for _, pos := range entity.Person.Positions {
+			position := Entity{
+				ID:     makeID(SchemaPosition, pos.Title, pos.Country),
+				Schema: SchemaPosition,
+			}
+			position.Add("name", pos.Title)
+			position.Add("country", countryCode(pos.Country))
+
+			occupancy := Entity{
+				ID:     makeID(SchemaOccupancy, record.ID, position.ID, formatDate(pos.Start)),
+				Schema: SchemaOccupancy,
+			}
+			occupancy.Add("holder", record.ID)
+			occupancy.Add("post", position.ID)
+			occupancy.Add("startDate", formatDate(pos.Start))
+			occupancy.Add("endDate", formatDate(pos.End))
+
+			related = append(related, position, occupancy)
+		}
+	}
+
+	if info := entity.SanctionsInfo; info != nil {
+		sanction := Entity{
+			ID:     makeID(SchemaSanction, record.ID),
+			Schema: SchemaSanction,
+		}
+		sanction.Add("entity", record.ID)
+		sanction.Add("program", info.Programs...)
+		sanction.Add("reason", info.Description)
+		if info.Secondary {
+			sanction.Add("provisions", "Secondary sanctions")
+		}
+		related = append(related, sanction)
+	}
+
+	return record, related
+}
+
+// linkRecord creates the FtM relationship for an affiliation. Affiliation types which do not
+// match a known relationship become an UnknownLink with the type as its role.
+func linkRecord(fromID, toID string, aff search.Affiliation) Entity {
+	schema, l := SchemaUnknownLink, links[SchemaUnknownLink]
+	role := aff.Type
+	if aff.Details != "" {
+		role += "; " + aff.Details
+	}
+
+	for name, candidate := range links {
+		switch {
+		case strings.EqualFold(aff.Type, candidate.fromType):
+			schema, l, role = name, candidate, aff.Details
+		case strings.EqualFold(aff.Type, candidate.toType):
+			schema, l, role = name, candidate, aff.Details
+			fromID, toID = toID, fromID
+		default:
+			continue
+		}
+		break
+	}
+
+	// Symmetric relationships are recorded once regardless of which side they're read from
+	if l.fromType == l.toType && toID < fromID {
+		fromID, toID = toID, fromID
+	}
+
+	record := Entity{
+		ID:     makeID(schema, fromID, toID),
+		Schema: schema,
+	}
+	record.Add(l.from, fromID)
+	record.Add(l.to, toID)
+	record.Add(l.details, role)
+	return record
+}
+
+func identifierProperty(kind search.GovernmentIDType) string {
+	for _, id := range identifierTypes {
+		if id.kind == kind {
+			return id.prop
+		}
+	}
+	return ""
+}
+
+func countryCode(country string) string {
+	if country == "" {
+		return ""
+	}
+	return strings.ToLower(cmp.Or(norm.CountryCode(country), country))
+}
+
+func formatGender(g search.Gender) string {
+	switch g {
+	case search.GenderMale:
+		return "male"
+	case search.GenderFemale:
+		return "female"
+	}
+	return ""
+}
+
+func formatDate(when *time.Time) string {
+	if when == nil || when.IsZero() {
+		return ""
+	}
+	return when.Format("2006-01-02")
+}
+
+func formatInt(n int) string {
+	if n == 0 {
+		return ""
+	}
+	return strconv.Itoa(n)
+}
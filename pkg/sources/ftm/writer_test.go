@@ -0,0 +1,216 @@
+package ftm
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/moov-io/watchman/pkg/search"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWriteEntities_JSONLines(t *testing.T) {
+	birthDate := time.Date(1985, 3, 15, 0, 0, 0, 0, time.UTC)
+	entities := []search.Entity[search.Value]{
+		{
+			Name:     "John Smith",
+			Type:     search.EntityPerson,
+			Source:   "test",
+			SourceID: "1001",
+			Person: &search.Person{
+				Name:      "John Smith",
+				AltNames:  []string{"Johnny Smith"},
+				Gender:    search.GenderMale,
+				BirthDate: &birthDate,
+				GovernmentIDs: []search.GovernmentID{
+					{Type: search.GovernmentIDPassport, Identifier: "X1234567"},
+					{Type: search.GovernmentIDNationality, Country: "United States of America", Identifier: "US"},
+				},
+			},
+			Addresses: []search.Address{
+				{Line1: "123 Main St", City: "Las Vegas", State: "NV", PostalCode: "89101", Country: "US"},
+			},
+			Affiliations: []search.Affiliation{
+				{EntityName: "Smith Industries", Type: "Director Of"},
+				{EntityName: "Acme Corp", Type: "Subsidiary Of"},
+			},
+			SanctionsInfo: &search.SanctionsInfo{
+				Programs:    []string{"SDGT"},
+				Description: "Financial support",
+			},
+		},
+		{
+			Name:     "Smith Industries",
+			Type:     search.EntityBusiness,
+			Source:   "test",
+			SourceID: "1002",
+			Business: &search.Business{
+				Name: "Smith Industries",
+			},
+		},
+	}
+
+	var buf bytes.Buffer
+	err := WriteEntities(&buf, entities, ExportOptions{Format: "jsonl"})
+	require.NoError(t, err)
+
+	lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+	records := make(map[string]Entity)
+	var schemas []string
+	for _, line := range lines {
+		var record Entity
+		require.NoError(t, json.Unmarshal([]byte(line), &record))
+		require.Equal(t, []string{"test"}, record.Datasets)
+
+		records[record.ID] = record
+		schemas = append(schemas, record.Schema)
+	}
+	expected := []string{SchemaPerson, SchemaAddress, SchemaSanction, SchemaDirectorship, SchemaLegalEntity, SchemaUnknownLink, SchemaCompany}
+	require.Equal(t, expected, schemas)
+
+	person := records["1001"]
+	require.Equal(t, []string{"John Smith"}, person.Get("name"))
+	require.Equal(t, []string{"Johnny Smith"}, person.Get("alias"))
+	require.Equal(t, []string{"male"}, person.Get("gender"))
+	require.Equal(t, []string{"1985-03-15"}, person.Get("birthDate"))
+	require.Equal(t, []string{"X1234567"}, person.Get("passportNumber"))
+	require.Equal(t, []string{"us"}, person.Get("nationality"))
+	require.Equal(t, []string{"us"}, person.Get("country"))
+	require.Equal(t, []string{"sanction"}, person.Get("topics"))
+	require.Len(t, person.Get("addressEntity"), 1)
+
+	address := records[person.First("addressEntity")]
+	require.Equal(t, "Las Vegas", address.First("city"))
+
+	// Round trip back into Watchman entities
+	read, err := ReadEntities(&buf, "test")
+	require.NoError(t, err)
+	require.Len(t, read, 3)
+
+	john := findEntity(t, read, "1001")
+	require.Equal(t, []string{"SDGT"}, john.SanctionsInfo.Programs)
+	require.Equal(t, "Financial support", john.SanctionsInfo.Description)
+	require.Equal(t, []string{"sanction"}, john.Topics)
+	require.Equal(t, []search.Affiliation{
+		{EntityName: "Smith Industries", Type: "Director Of"},
+		{EntityName: "Acme Corp", Type: "Linked To", Details: "Subsidiary Of"},
+	}, john.Affiliations)
+	require.Equal(t, "Las Vegas", john.Addresses[0].City)
+	require.Equal(t, "United States", john.Addresses[0].Country)
+
+	company := findEntity(t, read, "1002")
+	require.Equal(t, []search.Affiliation{{EntityName: "John Smith", Type: "Directed By"}}, company.Affiliations)
+}
+
+func TestWriteEntities_JSONArray(t *testing.T) {
+	entities := []search.Entity[search.Value]{
+		{
+			Name:   "OCEAN STAR",
+			Type:   search.EntityVessel,
+			Source: "test",
+			Vessel: &search.Vessel{
+				Name:      "OCEAN STAR",
+				IMONumber: "9187629",
+				Flag:      "Panama",
+			},
+			HistoricalInfo: []search.HistoricalInfo{
+				{Type: "Former Name", Value: "SEA STAR"},
+				{Type: "Previous Flag", Value: "Russia"},
+			},
+		},
+	}
+
+	var buf bytes.Buffer
+	err := WriteEntities(&buf, entities, ExportOptions{Format: "json", Pretty: true})
+	require.NoError(t, err)
+
+	var records []Entity
+	require.NoError(t, json.Unmarshal(buf.Bytes(), &records))
+	require.Len(t, records, 1)
+
+	vessel := records[0]
+	require.Equal(t, SchemaVessel, vessel.Schema)
+	require.NotEmpty(t, vessel.ID) // derived when the entity has no SourceID
+	require.Equal(t, []string{"9187629"}, vessel.Get("imoNumber"))
+	require.Equal(t, []string{"pa"}, vessel.Get("flag"))
+	require.Equal(t, []string{"SEA STAR"}, vessel.Get("previousName"))
+	require.Equal(t, []string{"ru"}, vessel.Get("pastFlags"))
+
+	err = WriteEntities(&buf, entities, ExportOptions{Format: "xml"})
+	require.ErrorContains(t, err, "unknown ftm export format")
+}
+
+func TestContentType(t *testing.T) {
+	require.Equal(t, "application/x-ndjson", ContentType(""))
+	require.Equal(t, "application/x-ndjson", ContentType("jsonl"))
+	require.Equal(t, "application/json", ContentType("json"))
+	require.Equal(t, "application/json", ContentType("ARRAY"))
+}
+
+func TestWriteEntities_Positions(t *testing.T) {
+	start := time.Date(2015, time.January, 10, 0, 0, 0, 0, time.UTC)
+	end := time.Date(2019, time.June, 30, 0, 0, 0, 0, time.UTC)
+	entities := []search.Entity[search.Value]{
+		{
+			Name:     "Jane DOE",
+			Type:     search.EntityPerson,
+			Source:   "test",
+			SourceID: "Q1",
+			Person: &search.Person{
+				Name:   "Jane DOE",
+				Titles: []string{"Minister of Finance"},
+				Positions: []search.Position{
+					{Title: "Minister of Finance", Country: "Nigeria", Start: &start, End: &end},
+				},
+			},
+			Topics: []string{search.TopicPEP},
+		},
+	}
+
+	var buf bytes.Buffer
+	err := WriteEntities(&buf, entities, ExportOptions{})
+	require.NoError(t, err)
+
+	records, err := Read(bytes.NewReader(buf.Bytes()))
+	require.NoError(t, err)
+	require.Len(t, records, 3)
+	require.Equal(t, SchemaPosition, records[1].Schema)
+	require.Equal(t, []string{"ng"}, records[1].Get("country"))
+	require.Equal(t, SchemaOccupancy, records[2].Schema)
+	require.Equal(t, []string{"Q1"}, records[2].Get("holder"))
+	require.Equal(t, []string{"2019-06-30"}, records[2].Get("endDate"))
+
+	read := ToEntities(records, "test")
+	require.Len(t, read, 1)
+	require.Equal(t, []string{"Minister of Finance"}, read[0].Person.Titles)
+	require.Len(t, read[0].Person.Positions, 1)
+	require.Equal(t, "Nigeria", read[0].Person.Positions[0].Country)
+	require.True(t, end.Equal(*read[0].Person.TenureEnded()))
+}
+
+func TestWriteSearchedEntities(t *testing.T) {
+	entities := []search.SearchedEntity[search.Value]{
+		{
+			Entity: search.Entity[search.Value]{
+				Name:     "Acme Corp",
+				Type:     search.EntityBusiness,
+				Source:   search.SourceUSOFAC,
+				SourceID: "555",
+				Business: &search.Business{Name: "Acme Corp"},
+			},
+			Match: 0.95,
+		},
+	}
+
+	var buf bytes.Buffer
+	err := WriteSearchedEntities(&buf, entities, ExportOptions{})
+	require.NoError(t, err)
+
+	var record Entity
+	require.NoError(t, json.Unmarshal(buf.Bytes(), &record))
+	require.Equal(t, "555", record.ID)
+	require.Equal(t, SchemaCompany, record.Schema)
+	require.Equal(t, []string{"us_ofac"}, record.Datasets)
+}
@@ -0,0 +1,708 @@
+// Copyright The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package ofac
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"maps"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"github.com/moov-io/watchman/pkg/download"
+)
+
+// AdvancedEntry is a sanctioned party from the SDN_ADVANCED.XML or CONS_ADVANCED.XML files,
+// with every reference value (alias types, countries, feature types, etc) resolved to its text.
+type AdvancedEntry struct {
+	// FixedRef is the party's unique identifier, which is the ent_num of the CSV files
+	FixedRef string `json:"fixedRef"`
+
+	// PartyType is Individual, Entity, Vessel or Aircraft
+	PartyType string `json:"partyType"`
+
+	Names []AdvancedName `json:"names"`
+
+	// Lists are the sanctions lists the party is on, such as "SDN List"
+	Lists    []string `json:"lists"`
+	Programs []string `json:"programs"`
+
+	Features      []AdvancedFeature      `json:"features"`
+	Documents     []AdvancedDocument     `json:"documents"`
+	Relationships []AdvancedRelationship `json:"relationships"`
+
+	Comment string `json:"comment,omitempty"`
+}
+
+// AdvancedName is one alias of a party written in a script.
+type AdvancedName struct {
+	// AliasType is Name (the primary name), A.K.A., F.K.A. or N.K.A.
+	AliasType  string `json:"aliasType"`
+	Primary    bool   `json:"primary"`
+	LowQuality bool   `json:"lowQuality"`
+	Script     string `json:"script"`
+
+	Parts []AdvancedNamePart `json:"parts"`
+}
+
+// AdvancedNamePart is a part of a name, like a Last Name, First Name or Entity Name.
+type AdvancedNamePart struct {
+	Type  string `json:"type"`
+	Value string `json:"value"`
+}
+
+// FullName joins the name parts with given names first.
+func (n AdvancedName) FullName() string {
+	var given, family, other []string
+	for _, part := range n.Parts {
+		switch strings.ToLower(part.Type) {
+		case "first name", "middle name", "patronymic":
+			given = append(given, part.Value)
+		case "last name", "maiden name", "matronymic":
+			family = append(family, part.Value)
+		default:
+			other = append(other, part.Value)
+		}
+	}
+	return strings.Join(slices.Concat(given, family, other), " ")
+}
+
+// AdvancedFeature is an attribute of a party, such as a Birthdate, Place of Birth, Location or Vessel Flag.
+type AdvancedFeature struct {
+	Type string `json:"type"`
+
+	// Value is the feature's text or reference value
+	Value string `json:"value,omitempty"`
+
+	// Date is formatted as 2006-01-02, 2006-01 or 2006 depending on its precision,
+	// and ranges are written as "1957 to 1962".
+	Date        string `json:"date,omitempty"`
+	Approximate bool   `json:"approximate,omitempty"`
+
+	Location *AdvancedLocation `json:"location,omitempty"`
+}
+
+// AdvancedLocation is an address or place referenced by features.
+type AdvancedLocation struct {
+	Address1      string `json:"address1,omitempty"`
+	Address2      string `json:"address2,omitempty"`
+	Address3      string `json:"address3,omitempty"`
+	City          string `json:"city,omitempty"`
+	StateProvince string `json:"stateProvince,omitempty"`
+	PostalCode    string `json:"postalCode,omitempty"`
+	Region        string `json:"region,omitempty"`
+
+	Country     string `json:"country,omitempty"`
+	CountryISO2 string `json:"countryISO2,omitempty"`
+}
+
+// String formats the location from its most specific to least specific parts.
+func (l AdvancedLocation) String() string {
+	var parts []string
+	for _, p := range []string{l.Address1, l.Address2, l.Address3, l.City, l.StateProvince, l.PostalCode, l.Region, l.Country} {
+		if p != "" {
+			parts = append(parts, p)
+		}
+	}
+	return strings.Join(parts, ", ")
+}
+
+// AdvancedDocument is an identity or registration document, like a passport or SWIFT/BIC.
+type AdvancedDocument struct {
+	Type             string `json:"type"`
+	Number           string `json:"number"`
+	IssuedBy         string `json:"issuedBy,omitempty"`
+	IssuedByISO2     string `json:"issuedByISO2,omitempty"`
+	IssuingAuthority string `json:"issuingAuthority,omitempty"`
+	IssueDate        string `json:"issueDate,omitempty"`
+	ExpirationDate   string `json:"expirationDate,omitempty"`
+}
+
+// AdvancedRelationship links a party to another, such as "Owned or Controlled By" or "Associate Of".
+type AdvancedRelationship struct {
+	Type string `json:"type"`
+
+	// RelatedFixedRef and RelatedName identify the other party
+	RelatedFixedRef string `json:"relatedFixedRef"`
+	RelatedName     string `json:"relatedName"`
+
+	Former bool `json:"former,omitempty"`
+}
+
+type AdvancedResults struct {
+	Entries []AdvancedEntry `json:"entries"`
+
+	ListHash string
+}
+
+// ReadAdvanced parses the SDN_ADVANCED.XML and CONS_ADVANCED.XML files published by OFAC.
+// Unlike the CSV files, identity documents, dates and places of birth and relationships between
+// parties are structured, so they're read directly rather than from remarks.
+func ReadAdvanced(files download.Files) (*AdvancedResults, error) {
+	results := &AdvancedResults{}
+
+	// Hash the files in a stable order
+	names := slices.Sorted(maps.Keys(files))
+
+	var hashes bytes.Buffer
+	for _, filename := range names {
+		file := files[filename]
+
+		switch strings.ToLower(filepath.Base(filename)) {
+		case "sdn_advanced.xml", "cons_advanced.xml":
+			// The files are hundreds of MB, so hash them as they're read rather than buffering
+			h := sha256.New()
+			r := io.TeeReader(file, h)
+			entries, err := readAdvancedFile(r)
+			if err == nil {
+				_, err = io.Copy(io.Discard, r)
+			}
+			file.Close()
+			if err != nil {
+				return nil, fmt.Errorf("%s: %w", filename, err)
+			}
+			hashes.WriteString(hex.EncodeToString(h.Sum(nil)))
+			results.Entries = append(results.Entries, entries...)
+
+		default:
+			file.Close()
+			return nil, fmt.Errorf("error: file %s does not have a handler for processing", filename)
+		}
+	}
+	results.ListHash = calculateHash(hashes.Bytes())
+
+	return results, nil
+}
+
+// advancedReader resolves the reference values and locations which parties refer to by ID.
+type advancedReader struct {
+	refs      advancedReferences
+	locations map[string]*AdvancedLocation
+
+	// documents are keyed by the IdentityID they belong to
+	documents map[string][]AdvancedDocument
+
+	// profiles maps each profile ID to its party
+	profiles map[string]int
+	entries  []AdvancedEntry
+}
+
+func readAdvancedFile(r io.Reader) ([]AdvancedEntry, error) {
+	ar := &advancedReader{
+		locations: make(map[string]*AdvancedLocation),
+		documents: make(map[string][]AdvancedDocument),
+		profiles:  make(map[string]int),
+	}
+
+	// The file is large, so decode one record at a time
+	d := xml.NewDecoder(r)
+	var found bool
+	for {
+		token, err := d.Token()
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				break
+			}
+			return nil, fmt.Errorf("reading advanced XML: %w", err)
+		}
+
+		start, ok := token.(xml.StartElement)
+		if !ok {
+			continue
+		}
+
+		switch start.Name.Local {
+		case "Sanctions":
+			found = true
+
+		case "ReferenceValueSets":
+			var sets advancedReferenceValueSets
+			if err := d.DecodeElement(&sets, &start); err != nil {
+				return nil, fmt.Errorf("reading reference values: %w", err)
+			}
+			ar.refs = sets.index()
+
+		case "Location":
+			var loc xmlLocation
+			if err := d.DecodeElement(&loc, &start); err != nil {
+				return nil, fmt.Errorf("reading location: %w", err)
+			}
+			ar.addLocation(loc)
+
+		case "IDRegDocument":
+			var doc xmlIDRegDocument
+			if err := d.DecodeElement(&doc, &start); err != nil {
+				return nil, fmt.Errorf("reading identity document: %w", err)
+			}
+			ar.addDocument(doc)
+
+		case "DistinctParty":
+			var party xmlDistinctParty
+			if err := d.DecodeElement(&party, &start); err != nil {
+				return nil, fmt.Errorf("reading distinct party: %w", err)
+			}
+			ar.addParty(party)
+
+		case "ProfileRelationship":
+			var rel xmlProfileRelationship
+			if err := d.DecodeElement(&rel, &start); err != nil {
+				return nil, fmt.Errorf("reading profile relationship: %w", err)
+			}
+			ar.addRelationship(rel)
+
+		case "SanctionsEntry":
+			var entry xmlSanctionsEntry
+			if err := d.DecodeElement(&entry, &start); err != nil {
+				return nil, fmt.Errorf("reading sanctions entry: %w", err)
+			}
+			ar.addSanctionsEntry(entry)
+		}
+	}
+	if !found {
+		return nil, errors.New("no Sanctions element found")
+	}
+
+	return ar.entries, nil
+}
+
+func (ar *advancedReader) addLocation(loc xmlLocation) {
+	out := &AdvancedLocation{}
+	for _, c := range loc.Countries {
+		out.Country = ar.refs.countries[c.CountryID]
+		out.CountryISO2 = ar.refs.countryISO2[c.CountryID]
+	}
+	for _, part := range loc.Parts {
+		var value string
+		for _, v := range part.Values {
+			if value == "" || v.Primary {
+				value = strings.TrimSpace(v.Value)
+			}
+		}
+
+		switch strings.ToUpper(ar.refs.locPartTypes[part.TypeID]) {
+		case "ADDRESS1":
+			out.Address1 = value
+		case "ADDRESS2":
+			out.Address2 = value
+		case "ADDRESS3":
+			out.Address3 = value
+		case "CITY":
+			out.City = value
+		case "STATE/PROVINCE":
+			out.StateProvince = value
+		case "POSTAL CODE":
+			out.PostalCode = value
+		default:
+			out.Region = value
+		}
+	}
+	ar.locations[loc.ID] = out
+}
+
+func (ar *advancedReader) addDocument(doc xmlIDRegDocument) {
+	out := AdvancedDocument{
+		Type:             ar.refs.documentTypes[doc.TypeID],
+		Number:           strings.TrimSpace(doc.RegistrationNo),
+		IssuedBy:         ar.refs.countries[doc.IssuedByCountryID],
+		IssuedByISO2:     ar.refs.countryISO2[doc.IssuedByCountryID],
+		IssuingAuthority: strings.TrimSpace(doc.IssuingAuthority),
+	}
+	for _, date := range doc.Dates {
+		formatted, _ := date.Period.format()
+		switch strings.ToLower(ar.refs.documentDateTypes[date.TypeID]) {
+		case "issue date":
+			out.IssueDate = formatted
+		case "expiration date":
+			out.ExpirationDate = formatted
+		}
+	}
+	if out.Number != "" {
+		ar.documents[doc.IdentityID] = append(ar.documents[doc.IdentityID], out)
+	}
+}
+
+func (ar *advancedReader) addParty(party xmlDistinctParty) {
+	for _, profile := range party.Profiles {
+		entry := AdvancedEntry{
+			FixedRef: party.FixedRef,
+			Comment:  strings.TrimSpace(party.Comment),
+		}
+
+		subType := ar.refs.partySubTypes[profile.PartySubTypeID]
+		entry.PartyType = subType.name
+		if entry.PartyType == "" || strings.EqualFold(entry.PartyType, "Unknown") {
+			entry.PartyType = ar.refs.partyTypes[subType.partyTypeID]
+		}
+
+		for _, identity := range profile.Identities {
+			// Map each name part group to its type (Last Name, Entity Name, etc)
+			groups := make(map[string]string)
+			for _, master := range identity.NamePartGroups {
+				for _, group := range master.Groups {
+					groups[group.ID] = ar.refs.namePartTypes[group.NamePartTypeID]
+				}
+			}
+
+			for _, alias := range identity.Aliases {
+				for _, name := range alias.DocumentedNames {
+					out := AdvancedName{
+						AliasType:  ar.refs.aliasTypes[alias.AliasTypeID],
+						Primary:    alias.Primary,
+						LowQuality: alias.LowQuality,
+					}
+					for _, part := range name.Parts {
+						value := strings.TrimSpace(part.Value.Value)
+						if value == "" {
+							continue
+						}
+						out.Script = ar.refs.scripts[part.Value.ScriptID]
+						out.Parts = append(out.Parts, AdvancedNamePart{
+							Type:  groups[part.Value.NamePartGroupID],
+							Value: value,
+						})
+					}
+					if len(out.Parts) > 0 {
+						entry.Names = append(entry.Names, out)
+					}
+				}
+			}
+
+			entry.Documents = append(entry.Documents, ar.documents[identity.ID]...)
+		}
+
+		for _, feature := range profile.Features {
+			for _, version := range feature.Versions {
+				entry.Features = append(entry.Features, ar.feature(ar.refs.featureTypes[feature.TypeID], version))
+			}
+		}
+
+		ar.profiles[profile.ID] = len(ar.entries)
+		ar.entries = append(ar.entries, entry)
+	}
+}
+
+func (ar *advancedReader) feature(featureType string, version xmlFeatureVersion) AdvancedFeature {
+	out := AdvancedFeature{
+		Type: featureType,
+	}
+	for _, detail := range version.Details {
+		value := strings.TrimSpace(detail.Value)
+		if value == "" && detail.ReferenceID != "" {
+			value = ar.refs.detailReferences[detail.ReferenceID]
+			if value == "" {
+				value = ar.refs.countries[detail.ReferenceID]
+			}
+		}
+		if value != "" {
+			out.Value = value
+		}
+	}
+	for _, period := range version.DatePeriods {
+		out.Date, out.Approximate = period.format()
+	}
+	for _, loc := range version.Locations {
+		if l, exists := ar.locations[loc.LocationID]; exists {
+			out.Location = l
+		}
+	}
+	return out
+}
+
+func (ar *advancedReader) addRelationship(rel xmlProfileRelationship) {
+	from, found := ar.profiles[rel.FromProfileID]
+	if !found {
+		return
+	}
+	out := AdvancedRelationship{
+		Type:   ar.refs.relationTypes[rel.RelationTypeID],
+		Former: rel.Former,
+	}
+	if to, found := ar.profiles[rel.ToProfileID]; found {
+		out.RelatedFixedRef = ar.entries[to].FixedRef
+		out.RelatedName = ar.entries[to].PrimaryName()
+	}
+	ar.entries[from].Relationships = append(ar.entries[from].Relationships, out)
+}
+
+func (ar *advancedReader) addSanctionsEntry(entry xmlSanctionsEntry) {
+	idx, found := ar.profiles[entry.ProfileID]
+	if !found {
+		return
+	}
+	out := &ar.entries[idx]
+
+	if list := ar.refs.lists[entry.ListID]; list != "" && !slices.Contains(out.Lists, list) {
+		out.Lists = append(out.Lists, list)
+	}
+	for _, measure := range entry.Measures {
+		program := strings.TrimSpace(measure.Comment)
+		if program == "" || !strings.EqualFold(ar.refs.sanctionsTypes[measure.SanctionsTypeID], "Program") {
+			continue
+		}
+		if !slices.Contains(out.Programs, program) {
+			out.Programs = append(out.Programs, program)
+		}
+	}
+}
+
+// PrimaryName returns the party's primary name in Latin script, or else its first name.
+func (e AdvancedEntry) PrimaryName() string {
+	var fallback string
+	for _, name := range e.Names {
+		if !name.Primary {
+			continue
+		}
+		if name.Script == "" || name.Script == "Latin" {
+			return name.FullName()
+		}
+		if fallback == "" {
+			fallback = name.FullName()
+		}
+	}
+	if fallback == "" && len(e.Names) > 0 {
+		fallback = e.Names[0].FullName()
+	}
+	return fallback
+}
+
+type advancedReferences struct {
+	aliasTypes        map[string]string
+	countries         map[string]string
+	countryISO2       map[string]string
+	detailReferences  map[string]string
+	featureTypes      map[string]string
+	documentTypes     map[string]string
+	documentDateTypes map[string]string
+	lists             map[string]string
+	locPartTypes      map[string]string
+	namePartTypes     map[string]string
+	partySubTypes     map[string]advancedPartySubType
+	partyTypes        map[string]string
+	relationTypes     map[string]string
+	sanctionsTypes    map[string]string
+	scripts           map[string]string
+}
+
+type advancedPartySubType struct {
+	name        string
+	partyTypeID string
+}
+
+type xmlReferenceValue struct {
+	ID    string `xml:"ID,attr"`
+	Value string `xml:",chardata"`
+}
+
+type advancedReferenceValueSets struct {
+	AliasTypes        []xmlReferenceValue `xml:"AliasTypeValues>AliasType"`
+	Countries         []xmlCountry        `xml:"CountryValues>Country"`
+	DetailReferences  []xmlReferenceValue `xml:"DetailReferenceValues>DetailReference"`
+	FeatureTypes      []xmlReferenceValue `xml:"FeatureTypeValues>FeatureType"`
+	DocumentTypes     []xmlReferenceValue `xml:"IDRegDocTypeValues>IDRegDocType"`
+	DocumentDateTypes []xmlReferenceValue `xml:"IDRegDocDateTypeValues>IDRegDocDateType"`
+	Lists             []xmlReferenceValue `xml:"ListValues>List"`
+	LocPartTypes      []xmlReferenceValue `xml:"LocPartTypeValues>LocPartType"`
+	NamePartTypes     []xmlReferenceValue `xml:"NamePartTypeValues>NamePartType"`
+	PartySubTypes     []xmlPartySubType   `xml:"PartySubTypeValues>PartySubType"`
+	PartyTypes        []xmlReferenceValue `xml:"PartyTypeValues>PartyType"`
+	RelationTypes     []xmlReferenceValue `xml:"RelationTypeValues>RelationType"`
+	SanctionsTypes    []xmlReferenceValue `xml:"SanctionsTypeValues>SanctionsType"`
+	Scripts           []xmlReferenceValue `xml:"ScriptValues>Script"`
+}
+
+type xmlCountry struct {
+	ID    string `xml:"ID,attr"`
+	ISO2  string `xml:"ISO2,attr"`
+	Value string `xml:",chardata"`
+}
+
+type xmlPartySubType struct {
+	ID          string `xml:"ID,attr"`
+	PartyTypeID string `xml:"PartyTypeID,attr"`
+	Value       string `xml:",chardata"`
+}
+
+func (sets advancedReferenceValueSets) index() advancedReferences {
+	values := func(in []xmlReferenceValue) map[string]string {
+		out := make(map[string]string, len(in))
+		for _, v := range in {
+			out[v.ID] = strings.TrimSpace(v.Value)
+		}
+		return out
+	}
+
+	refs := advancedReferences{
+		aliasTypes:        values(sets.AliasTypes),
+		countries:         make(map[string]string, len(sets.Countries)),
+		countryISO2:       make(map[string]string, len(sets.Countries)),
+		detailReferences:  values(sets.DetailReferences),
+		featureTypes:      values(sets.FeatureTypes),
+		documentTypes:     values(sets.DocumentTypes),
+		documentDateTypes: values(sets.DocumentDateTypes),
+		lists:             values(sets.Lists),
+		locPartTypes:      values(sets.LocPartTypes),
+		namePartTypes:     values(sets.NamePartTypes),
+		partySubTypes:     make(map[string]advancedPartySubType, len(sets.PartySubTypes)),
+		partyTypes:        values(sets.PartyTypes),
+		relationTypes:     values(sets.RelationTypes),
+		sanctionsTypes:    values(sets.SanctionsTypes),
+		scripts:           values(sets.Scripts),
+	}
+	for _, c := range sets.Countries {
+		refs.countries[c.ID] = strings.TrimSpace(c.Value)
+		refs.countryISO2[c.ID] = strings.TrimSpace(c.ISO2)
+	}
+	for _, st := range sets.PartySubTypes {
+		refs.partySubTypes[st.ID] = advancedPartySubType{
+			name:        strings.TrimSpace(st.Value),
+			partyTypeID: st.PartyTypeID,
+		}
+	}
+	return refs
+}
+
+type xmlLocation struct {
+	ID        string `xml:"ID,attr"`
+	Countries []struct {
+		CountryID string `xml:"CountryID,attr"`
+	} `xml:"LocationCountry"`
+	Parts []struct {
+		TypeID string `xml:"LocPartTypeID,attr"`
+		Values []struct {
+			Primary bool   `xml:"Primary,attr"`
+			Value   string `xml:"Value"`
+		} `xml:"LocationPartValue"`
+	} `xml:"LocationPart"`
+}
+
+type xmlIDRegDocument struct {
+	TypeID            string `xml:"IDRegDocTypeID,attr"`
+	IdentityID        string `xml:"IdentityID,attr"`
+	IssuedByCountryID string `xml:"IssuedBy-CountryID,attr"`
+	RegistrationNo    string `xml:"IDRegistrationNo"`
+	IssuingAuthority  string `xml:"IssuingAuthority"`
+	Dates             []struct {
+		TypeID string        `xml:"IDRegDocDateTypeID,attr"`
+		Period xmlDatePeriod `xml:"DatePeriod"`
+	} `xml:"DocumentDate"`
+}
+
+type xmlDistinctParty struct {
+	FixedRef string       `xml:"FixedRef,attr"`
+	Comment  string       `xml:"Comment"`
+	Profiles []xmlProfile `xml:"Profile"`
+}
+
+type xmlProfile struct {
+	ID             string        `xml:"ID,attr"`
+	PartySubTypeID string        `xml:"PartySubTypeID,attr"`
+	Identities     []xmlIdentity `xml:"Identity"`
+	Features       []struct {
+		TypeID   string              `xml:"FeatureTypeID,attr"`
+		Versions []xmlFeatureVersion `xml:"FeatureVersion"`
+	} `xml:"Feature"`
+}
+
+type xmlIdentity struct {
+	ID      string `xml:"ID,attr"`
+	Aliases []struct {
+		AliasTypeID     string `xml:"AliasTypeID,attr"`
+		Primary         bool   `xml:"Primary,attr"`
+		LowQuality      bool   `xml:"LowQuality,attr"`
+		DocumentedNames []struct {
+			Parts []struct {
+				Value struct {
+					NamePartGroupID string `xml:"NamePartGroupID,attr"`
+					ScriptID        string `xml:"ScriptID,attr"`
+					Value           string `xml:",chardata"`
+				} `xml:"NamePartValue"`
+			} `xml:"DocumentedNamePart"`
+		} `xml:"DocumentedName"`
+	} `xml:"Alias"`
+	NamePartGroups []struct {
+		Groups []struct {
+			ID             string `xml:"ID,attr"`
+			NamePartTypeID string `xml:"NamePartTypeID,attr"`
+		} `xml:"NamePartGroup"`
+	} `xml:"NamePartGroups>MasterNamePartGroup"`
+}
+
+type xmlFeatureVersion struct {
+	DatePeriods []xmlDatePeriod `xml:"DatePeriod"`
+	Details     []struct {
+		ReferenceID string `xml:"DetailReferenceID,attr"`
+		Value       string `xml:",chardata"`
+	} `xml:"VersionDetail"`
+	Locations []struct {
+		LocationID string `xml:"LocationID,attr"`
+	} `xml:"VersionLocation"`
+}
+
+type xmlProfileRelationship struct {
+	FromProfileID  string `xml:"From-ProfileID,attr"`
+	ToProfileID    string `xml:"To-ProfileID,attr"`
+	RelationTypeID string `xml:"RelationTypeID,attr"`
+	Former         bool   `xml:"Former,attr"`
+}
+
+type xmlSanctionsEntry struct {
+	ProfileID string `xml:"ProfileID,attr"`
+	ListID    string `xml:"ListID,attr"`
+	Measures  []struct {
+		SanctionsTypeID string `xml:"SanctionsTypeID,attr"`
+		Comment         string `xml:"Comment"`
+	} `xml:"SanctionsMeasure"`
+}
+
+type xmlDatePeriod struct {
+	Start xmlDateBoundary `xml:"Start"`
+	End   xmlDateBoundary `xml:"End"`
+}
+
+type xmlDateBoundary struct {
+	Approximate bool    `xml:"Approximate,attr"`
+	From        xmlDate `xml:"From"`
+	To          xmlDate `xml:"To"`
+}
+
+type xmlDate struct {
+	Year  int `xml:"Year"`
+	Month int `xml:"Month"`
+	Day   int `xml:"Day"`
+}
+
+// format writes the period with the precision it was published in. Periods covering a whole year or
+// month, like a birth year, are written as "1965" or "1965-04", and longer periods as "1957 to 1962".
+func (p xmlDatePeriod) format() (string, bool) {
+	start, end := p.Start.From, p.End.To
+	if start.Year == 0 {
+		return "", false
+	}
+	if end.Year == 0 {
+		end = p.Start.To
+	}
+	approximate := p.Start.Approximate || p.End.Approximate
+
+	switch {
+	case end.Year == 0 || start == end:
+		return fmt.Sprintf("%04d-%02d-%02d", start.Year, start.Month, start.Day), approximate
+
+	case start.Month == 1 && start.Day == 1 && end.Month == 12 && end.Day == 31:
+		if start.Year == end.Year {
+			return fmt.Sprintf("%04d", start.Year), approximate
+		}
+		return fmt.Sprintf("%04d to %04d", start.Year, end.Year), approximate
+
+	case start.Year == end.Year && start.Month == end.Month && start.Day == 1 && end.Day >= 28:
+		return fmt.Sprintf("%04d-%02d", start.Year, start.Month), approximate
+	}
+	return fmt.Sprintf("%04d-%02d-%02d to %04d-%02d-%02d", start.Year, start.Month, start.Day, end.Year, end.Month, end.Day), approximate
+}
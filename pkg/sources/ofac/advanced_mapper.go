@@ -0,0 +1,347 @@
+// Copyright The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package ofac
+
+import (
+	"runtime"
+	"strings"
+	"time"
+
+	"github.com/moov-io/watchman/internal/indices"
+	"github.com/moov-io/watchman/internal/norm"
+	"github.com/moov-io/watchman/internal/prepare"
+	"github.com/moov-io/watchman/pkg/search"
+)
+
+var (
+	advancedDatePatterns = []string{"2006-01-02", "2006-01", "2006"}
+)
+
+// advancedDocumentTypes maps the IDRegDocType values of the advanced XML to government ID types.
+// Document types are matched by prefix, so "Passport" doesn't match "Diplomatic Passport".
+var advancedDocumentTypes = []struct {
+	prefix string
+	kind   search.GovernmentIDType
+}{
+	{"passport", search.GovernmentIDPassport},
+	{"diplomatic passport", search.GovernmentIDDiplomaticPass},
+	{"national id", search.GovernmentIDNational},
+	{"identification number", search.GovernmentIDNational},
+	{"personal id", search.GovernmentIDPersonalID},
+	{"driver's license", search.GovernmentIDDriversLicense},
+	{"tax id", search.GovernmentIDTax},
+	{"ssn", search.GovernmentIDSSN},
+	{"cedula", search.GovernmentIDCedula},
+	{"c.u.r.p.", search.GovernmentIDCURP},
+	{"curp", search.GovernmentIDCURP},
+	{"c.u.i.t.", search.GovernmentIDCUIT},
+	{"cuit", search.GovernmentIDCUIT},
+	{"electoral", search.GovernmentIDElectoral},
+	{"business registration", search.GovernmentIDBusinessRegisration},
+	{"registration number", search.GovernmentIDBusinessRegisration},
+	{"company number", search.GovernmentIDBusinessRegisration},
+	{"legal entity number", search.GovernmentIDBusinessRegisration},
+	{"commercial registry", search.GovernmentIDCommercialRegistry},
+	{"birth certificate", search.GovernmentIDBirthCert},
+	{"refugee", search.GovernmentIDRefugee},
+	{"swift/bic", search.GovernmentIDSWIFT},
+}
+
+// GroupAdvancedEntities converts every entry read from the advanced XML files into an entity.
+func GroupAdvancedEntities(entries []AdvancedEntry, overrides ...MappingOverride) []search.Entity[search.Value] {
+	fn := func(entry AdvancedEntry) search.Entity[search.Value] {
+		return AdvancedToEntity(entry, overrides...)
+	}
+
+	groups := runtime.NumCPU()
+	return indices.ProcessSlice(entries, groups, fn)
+}
+
+// AdvancedToEntity converts an entry of the advanced XML files into an entity. The structured documents,
+// features and relationships are mapped directly instead of being parsed from remarks.
+func AdvancedToEntity(entry AdvancedEntry, overrides ...MappingOverride) search.Entity[search.Value] {
+	out := search.Entity[search.Value]{
+		Source:     search.SourceUSOFAC,
+		SourceData: entry,
+		SourceID:   entry.FixedRef,
+	}
+
+	// Names
+	var altNames []string
+	var historicalInfo []search.HistoricalInfo
+	for _, name := range entry.Names {
+		full := name.FullName()
+		if out.Name == "" && name.Primary && (name.Script == "" || name.Script == "Latin") {
+			out.Name = full
+			continue
+		}
+		altNames = append(altNames, full)
+
+		if strings.EqualFold(name.AliasType, "F.K.A.") {
+			historicalInfo = append(historicalInfo, search.HistoricalInfo{
+				Type:  "Former Name",
+				Value: full,
+			})
+		}
+	}
+	if out.Name == "" {
+		out.Name = entry.PrimaryName()
+	}
+	altNames = deduplicateStrings(altNames)
+
+	// Features
+	var titles []string
+	var birthDates []string
+	var governmentIDs []search.GovernmentID
+	var placesOfBirth []string
+	var gender search.Gender
+	var created *time.Time
+	sanctionsInfo := &search.SanctionsInfo{
+		Programs: entry.Programs,
+	}
+	vessel := &search.Vessel{}
+	aircraft := &search.Aircraft{}
+
+	for _, feature := range entry.Features {
+		featureType := strings.ToLower(feature.Type)
+		value := feature.Value
+
+		switch {
+		case featureType == "birthdate":
+			birthDates = append(birthDates, feature.Date)
+
+		case featureType == "place of birth":
+			if feature.Location != nil {
+				placesOfBirth = append(placesOfBirth, feature.Location.String())
+			}
+
+		case featureType == "nationality country", featureType == "citizenship country":
+			country := value
+			if feature.Location != nil {
+				country = feature.Location.Country
+			}
+			kind := search.GovernmentIDNationality
+			if featureType == "citizenship country" {
+				kind = search.GovernmentIDCitizenship
+			}
+			if country != "" {
+				governmentIDs = append(governmentIDs, search.GovernmentID{
+					Type:       kind,
+					Country:    norm.Country(country),
+					Identifier: country,
+				})
+			}
+
+		case featureType == "gender":
+			gender = search.Gender(strings.ToLower(value))
+
+		case featureType == "title":
+			titles = append(titles, value)
+
+		case featureType == "location", featureType == "address":
+			if feature.Location != nil {
+				out.Addresses = append(out.Addresses, search.Address{
+					Line1:      feature.Location.Address1,
+					Line2:      strings.TrimSpace(feature.Location.Address2 + " " + feature.Location.Address3),
+					City:       feature.Location.City,
+					PostalCode: feature.Location.PostalCode,
+					State:      feature.Location.StateProvince,
+					Country:    norm.Country(feature.Location.Country),
+				})
+			}
+
+		case featureType == "email address":
+			out.Contact.EmailAddresses = append(out.Contact.EmailAddresses, value)
+		case featureType == "phone number":
+			out.Contact.PhoneNumbers = append(out.Contact.PhoneNumbers, value)
+		case featureType == "fax":
+			out.Contact.FaxNumbers = append(out.Contact.FaxNumbers, value)
+		case featureType == "website":
+			out.Contact.Websites = append(out.Contact.Websites, value)
+
+		case strings.HasPrefix(featureType, "digital currency address - "):
+			out.CryptoAddresses = append(out.CryptoAddresses, search.CryptoAddress{
+				Currency: strings.TrimSpace(feature.Type[len("digital currency address - "):]),
+				Address:  value,
+			})
+
+		case featureType == "organization established date":
+			created = parseAdvancedDate(feature.Date)
+
+		case featureType == "additional sanctions information -":
+			sanctionsInfo.Description = value
+			if strings.Contains(strings.ToLower(value), "secondary sanctions") {
+				sanctionsInfo.Secondary = true
+			}
+		case featureType == "secondary sanctions risk:":
+			sanctionsInfo.Secondary = true
+			sanctionsInfo.Description = value
+
+		// Vessels
+		case featureType == "vessel call sign":
+			vessel.CallSign = value
+		case featureType == "vessel type":
+			vessel.Type = normalizeVesselType(value)
+		case featureType == "vessel flag":
+			vessel.Flag = norm.Country(value)
+		case featureType == "former vessel flag":
+			historicalInfo = append(historicalInfo, search.HistoricalInfo{
+				Type:  "Previous Flag",
+				Value: value,
+			})
+		case featureType == "vessel owner":
+			vessel.Owner = value
+		case featureType == "vessel tonnage":
+			vessel.Tonnage = parseTonnage(value)
+		case featureType == "vessel gross registered tonnage":
+			vessel.GrossRegisteredTonnage = parseTonnage(value)
+		case featureType == "mmsi":
+			vessel.MMSI = value
+		case featureType == "vessel year of build":
+			vessel.Built = parseAdvancedDate(feature.Date)
+
+		// Aircraft
+		case featureType == "aircraft construction number (also called l/n or s/n or f/n)", featureType == "aircraft manufacturer's serial number (msn)":
+			aircraft.SerialNumber = value
+		case featureType == "aircraft model":
+			aircraft.Model = value
+		case featureType == "aircraft manufacture date":
+			aircraft.Built = parseAdvancedDate(feature.Date)
+		case featureType == "aircraft tail number", featureType == "previous aircraft tail number":
+			altNames = append(altNames, value)
+		}
+	}
+
+	// Identity documents
+	for _, doc := range entry.Documents {
+		docType := strings.ToLower(doc.Type)
+
+		switch {
+		case strings.Contains(docType, "vessel registration"):
+			vessel.IMONumber = strings.TrimSpace(strings.TrimPrefix(doc.Number, "IMO"))
+		case strings.HasPrefix(docType, "aircraft"):
+			continue
+		default:
+			if kind, found := advancedDocumentType(docType); found {
+				governmentIDs = append(governmentIDs, search.GovernmentID{
+					Name:       doc.Type,
+					Type:       kind,
+					Country:    norm.Country(doc.IssuedBy),
+					Identifier: doc.Number,
+				})
+			}
+		}
+	}
+
+	// Relationships
+	for _, rel := range entry.Relationships {
+		if rel.RelatedName == "" {
+			continue
+		}
+		aff := search.Affiliation{
+			EntityName: rel.RelatedName,
+			Type:       rel.Type,
+		}
+		if rel.Former {
+			aff.Details = "former"
+		}
+		out.Affiliations = append(out.Affiliations, aff)
+	}
+	out.Affiliations = deduplicateAffiliations(out.Affiliations)
+	out.SanctionsInfo = sanitizeSanctionsInfo(sanctionsInfo)
+
+	switch strings.ToLower(entry.PartyType) {
+	case "individual":
+		out.Type = search.EntityPerson
+		out.Person = &search.Person{
+			Name:          out.Name,
+			AltNames:      altNames,
+			Gender:        gender,
+			Titles:        deduplicateTitles(titles),
+			GovernmentIDs: governmentIDs,
+		}
+
+		// A person has one birth date and place of birth, so any others OFAC lists are kept
+		// in HistoricalInfo rather than dropped.
+		for _, date := range birthDates {
+			when := parseAdvancedDate(date)
+			switch {
+			case when == nil:
+				continue
+			case out.Person.BirthDate == nil:
+				out.Person.BirthDate = when
+			case !when.Equal(*out.Person.BirthDate):
+				historicalInfo = append(historicalInfo, search.HistoricalInfo{
+					Type:  "Alternate Birth Date",
+					Value: date,
+					Date:  *when,
+				})
+			}
+		}
+		for idx, place := range deduplicateStrings(placesOfBirth) {
+			if idx == 0 {
+				out.Person.PlaceOfBirth = place
+				continue
+			}
+			historicalInfo = append(historicalInfo, search.HistoricalInfo{
+				Type:  "Alternate Place of Birth",
+				Value: place,
+			})
+		}
+
+	case "vessel":
+		out.Type = search.EntityVessel
+		vessel.Name = out.Name
+		vessel.AltNames = altNames
+		out.Vessel = vessel
+
+	case "aircraft":
+		out.Type = search.EntityAircraft
+		aircraft.Name = out.Name
+		aircraft.AltNames = altNames
+		out.Aircraft = aircraft
+
+	default:
+		out.Type = search.EntityBusiness
+		out.Business = &search.Business{
+			Name:          prepare.RemoveCompanyTitles(out.Name),
+			AltNames:      altNames,
+			Created:       created,
+			GovernmentIDs: governmentIDs,
+		}
+	}
+
+	out.HistoricalInfo = deduplicateHistoricalInfo(historicalInfo)
+
+	for _, fn := range overrides {
+		fn(&out)
+	}
+
+	return out.Normalize()
+}
+
+func advancedDocumentType(docType string) (search.GovernmentIDType, bool) {
+	for _, t := range advancedDocumentTypes {
+		if strings.HasPrefix(docType, t.prefix) {
+			return t.kind, true
+		}
+	}
+	return "", false
+}
+
+// parseAdvancedDate reads the start of a date formatted by the advanced XML reader
+func parseAdvancedDate(value string) *time.Time {
+	value, _, _ = strings.Cut(value, " to ")
+	if value == "" {
+		return nil
+	}
+	for _, layout := range advancedDatePatterns {
+		t, err := time.Parse(layout, value)
+		if err == nil && !invalidDate(t) {
+			return &t
+		}
+	}
+	return nil
+}
@@ -0,0 +1,224 @@
+// Copyright The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package ofac
+
+import (
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/moov-io/watchman/pkg/download"
+	"github.com/moov-io/watchman/pkg/search"
+
+	"github.com/stretchr/testify/require"
+)
+
+func readAdvancedEntities(t *testing.T) map[string]search.Entity[search.Value] {
+	t.Helper()
+
+	res, err := ReadAdvanced(testInputs(t, filepath.Join("testdata", "sdn_advanced.xml")))
+	require.NoError(t, err)
+	require.Len(t, res.Entries, 3)
+	require.NotEmpty(t, res.ListHash)
+
+	out := make(map[string]search.Entity[search.Value])
+	for _, entity := range GroupAdvancedEntities(res.Entries) {
+		out[entity.SourceID] = entity
+	}
+	return out
+}
+
+func TestReadAdvanced(t *testing.T) {
+	res, err := ReadAdvanced(testInputs(t, filepath.Join("testdata", "sdn_advanced.xml")))
+	require.NoError(t, err)
+
+	entry := res.Entries[0]
+	require.Equal(t, "2000", entry.FixedRef)
+	require.Equal(t, "Individual", entry.PartyType)
+	require.Equal(t, []string{"SDN List"}, entry.Lists)
+	require.Equal(t, []string{"RUSSIA-EO14024"}, entry.Programs)
+
+	var dates []string
+	for _, f := range entry.Features {
+		if f.Type == "Birthdate" {
+			dates = append(dates, f.Date)
+		}
+	}
+	require.Equal(t, []string{"1964-10-18", "1965"}, dates)
+
+	data, err := os.ReadFile(filepath.Join("testdata", "sdn_advanced.xml"))
+	require.NoError(t, err)
+	require.Equal(t, calculateHash([]byte(calculateHash(data))), res.ListHash)
+
+	expected := []AdvancedDocument{
+		{Type: "Passport", Number: "753869612", IssuedBy: "Russia", IssuedByISO2: "RU", IssueDate: "2014-03-12"},
+	}
+	require.Equal(t, expected, entry.Documents)
+
+	t.Run("unknown file", func(t *testing.T) {
+		_, err := ReadAdvanced(download.Files{
+			"sdn.xml": io.NopCloser(strings.NewReader("<Sanctions />")),
+		})
+		require.ErrorContains(t, err, "does not have a handler")
+	})
+
+	t.Run("not advanced XML", func(t *testing.T) {
+		_, err := ReadAdvanced(download.Files{
+			"sdn_advanced.xml": io.NopCloser(strings.NewReader("<html></html>")),
+		})
+		require.ErrorContains(t, err, "no Sanctions element found")
+	})
+}
+
+func TestAdvancedDatePeriod(t *testing.T) {
+	day := xmlDate{Year: 1957, Month: 4, Day: 2}
+
+	cases := []struct {
+		period   xmlDatePeriod
+		expected string
+	}{
+		{xmlDatePeriod{Start: xmlDateBoundary{From: day, To: day}, End: xmlDateBoundary{From: day, To: day}}, "1957-04-02"},
+		{xmlDatePeriod{Start: xmlDateBoundary{From: xmlDate{1957, 1, 1}}, End: xmlDateBoundary{To: xmlDate{1957, 12, 31}}}, "1957"},
+		{xmlDatePeriod{Start: xmlDateBoundary{From: xmlDate{1957, 1, 1}}, End: xmlDateBoundary{To: xmlDate{1962, 12, 31}}}, "1957 to 1962"},
+		{xmlDatePeriod{Start: xmlDateBoundary{From: xmlDate{1957, 4, 1}}, End: xmlDateBoundary{To: xmlDate{1957, 4, 30}}}, "1957-04"},
+		{xmlDatePeriod{}, ""},
+	}
+	for _, tc := range cases {
+		got, _ := tc.period.format()
+		require.Equal(t, tc.expected, got)
+	}
+}
+
+func TestAdvancedToEntity_Person(t *testing.T) {
+	e := readAdvancedEntities(t)["2000"]
+
+	require.Equal(t, search.EntityPerson, e.Type)
+	require.Equal(t, search.SourceUSOFAC, e.Source)
+	require.Equal(t, "Sergei Petrovich IVANOV", e.Name)
+
+	require.NotNil(t, e.Person)
+	require.Equal(t, []string{"Сергей Петрович ИВАНОВ", "Serge IVANOFF"}, e.Person.AltNames)
+	require.Equal(t, search.GenderMale, e.Person.Gender)
+	require.Equal(t, "Moscow, Russia", e.Person.PlaceOfBirth)
+	require.Equal(t, []string{"Director General"}, e.Person.Titles)
+
+	require.NotNil(t, e.Person.BirthDate)
+	require.Equal(t, time.Date(1964, time.October, 18, 0, 0, 0, 0, time.UTC), *e.Person.BirthDate)
+
+	expectedHistory := []search.HistoricalInfo{
+		{Type: "Alternate Birth Date", Value: "1965", Date: time.Date(1965, time.January, 1, 0, 0, 0, 0, time.UTC)},
+		{Type: "Alternate Place of Birth", Value: "Saint Petersburg, Russia"},
+	}
+	require.Equal(t, expectedHistory, e.HistoricalInfo)
+
+	expectedIDs := []search.GovernmentID{
+		{Type: search.GovernmentIDNationality, Country: "Russia", Identifier: "Russia"},
+		{Name: "Passport", Type: search.GovernmentIDPassport, Country: "Russia", Identifier: "753869612"},
+	}
+	require.Equal(t, expectedIDs, e.Person.GovernmentIDs)
+
+	require.Equal(t, []search.CryptoAddress{{Currency: "XBT", Address: "1ABCDEFGHJKLMNPQRSTUVWXYZ23456789"}}, e.CryptoAddresses)
+
+	expectedAffiliations := []search.Affiliation{
+		{EntityName: "BANCO NACIONAL DE CUBA", Type: "Leader or official of", Details: "former"},
+	}
+	require.Equal(t, expectedAffiliations, e.Affiliations)
+
+	require.NotNil(t, e.SanctionsInfo)
+	require.Equal(t, []string{"RUSSIA-EO14024"}, e.SanctionsInfo.Programs)
+	require.False(t, e.SanctionsInfo.Secondary)
+}
+
+func TestAdvancedToEntity_BirthDates(t *testing.T) {
+	entry := AdvancedEntry{
+		FixedRef:  "1",
+		PartyType: "Individual",
+		Names:     []AdvancedName{{Primary: true, Parts: []AdvancedNamePart{{Type: "First Name", Value: "John"}}}},
+		Features: []AdvancedFeature{
+			{Type: "Birthdate", Date: "1970-02-03"},
+			{Type: "Birthdate", Date: "unknown"},
+			{Type: "Birthdate", Date: "1971"},
+			{Type: "Birthdate", Date: "1970-02-03"},
+			{Type: "Place of Birth", Location: &AdvancedLocation{City: "Kyiv", Country: "Ukraine"}},
+			{Type: "Place of Birth", Location: &AdvancedLocation{City: "Odesa", Country: "Ukraine"}},
+		},
+	}
+	e := AdvancedToEntity(entry)
+
+	require.NotNil(t, e.Person.BirthDate)
+	require.Equal(t, time.Date(1970, time.February, 3, 0, 0, 0, 0, time.UTC), *e.Person.BirthDate)
+	require.Equal(t, "Kyiv, Ukraine", e.Person.PlaceOfBirth)
+
+	expected := []search.HistoricalInfo{
+		{Type: "Alternate Birth Date", Value: "1971", Date: time.Date(1971, time.January, 1, 0, 0, 0, 0, time.UTC)},
+		{Type: "Alternate Place of Birth", Value: "Odesa, Ukraine"},
+	}
+	require.Equal(t, expected, e.HistoricalInfo)
+}
+
+func TestAdvancedToEntity_Business(t *testing.T) {
+	e := readAdvancedEntities(t)["3000"]
+
+	require.Equal(t, search.EntityBusiness, e.Type)
+	require.Equal(t, "BANCO NACIONAL DE CUBA", e.Name)
+
+	require.NotNil(t, e.Business)
+	require.Equal(t, []string{"BNC", "NATIONAL BANK OF CUBA"}, e.Business.AltNames)
+	require.Equal(t, []search.HistoricalInfo{{Type: "Former Name", Value: "NATIONAL BANK OF CUBA"}}, e.HistoricalInfo)
+
+	require.NotNil(t, e.Business.Created)
+	require.Equal(t, time.Date(1948, time.December, 23, 0, 0, 0, 0, time.UTC), *e.Business.Created)
+
+	expectedIDs := []search.GovernmentID{
+		{Name: "SWIFT/BIC", Type: search.GovernmentIDSWIFT, Identifier: "BNACCUHH"},
+		{Name: "Tax ID No.", Type: search.GovernmentIDTax, Country: "Cuba", Identifier: "CU-4711"},
+	}
+	require.Equal(t, expectedIDs, e.Business.GovernmentIDs)
+
+	require.Len(t, e.Addresses, 1)
+	require.Equal(t, "Avenida Salvador Allende 416", e.Addresses[0].Line1)
+	require.Equal(t, "Havana", e.Addresses[0].City)
+	require.Equal(t, "10400", e.Addresses[0].PostalCode)
+	require.Equal(t, "Cuba", e.Addresses[0].Country)
+
+	require.Equal(t, []string{"info@bnc.cu"}, e.Contact.EmailAddresses)
+	require.Equal(t, []string{"www.bnc.cu"}, e.Contact.Websites)
+
+	require.NotNil(t, e.SanctionsInfo)
+	require.True(t, e.SanctionsInfo.Secondary)
+	require.Equal(t, "Section 1(b)", e.SanctionsInfo.Description)
+	require.Equal(t, []string{"CUBA"}, e.SanctionsInfo.Programs)
+}
+
+func TestAdvancedToEntity_Vessel(t *testing.T) {
+	e := readAdvancedEntities(t)["4000"]
+
+	require.Equal(t, search.EntityVessel, e.Type)
+	require.Equal(t, "OCEAN STAR", e.Name)
+
+	require.NotNil(t, e.Vessel)
+	require.Equal(t, "9187629", e.Vessel.IMONumber)
+	require.Equal(t, "3FZA7", e.Vessel.CallSign)
+	require.Equal(t, "Panama", e.Vessel.Flag)
+	require.Equal(t, "352001234", e.Vessel.MMSI)
+	require.Equal(t, 156040, e.Vessel.GrossRegisteredTonnage)
+	require.NotNil(t, e.Vessel.Built)
+	require.Equal(t, 1999, e.Vessel.Built.Year())
+
+	require.Equal(t, []search.HistoricalInfo{{Type: "Previous Flag", Value: "Iran"}}, e.HistoricalInfo)
+	require.Equal(t, []search.Affiliation{{EntityName: "BANCO NACIONAL DE CUBA", Type: "Owned or Controlled By"}}, e.Affiliations)
+	require.Equal(t, []string{"CUBA", "IRAN-EO13846"}, e.SanctionsInfo.Programs)
+
+	t.Run("source list override", func(t *testing.T) {
+		entry, ok := e.SourceData.(AdvancedEntry)
+		require.True(t, ok)
+
+		other := AdvancedToEntity(entry, WithSourceList(search.SourceUSNonSDN))
+		require.Equal(t, search.SourceUSNonSDN, other.Source)
+	})
+}
@@ -21,6 +21,8 @@ var (
 		"sdn_comments.csv", // Specially Designated National Comments
 	}
 
+	ofacAdvancedFilename = "SDN_ADVANCED.XML"
+
 	ofacURLTemplate = func() string {
 		if v := os.Getenv("OFAC_DOWNLOAD_TEMPLATE"); v != "" {
 			return v
@@ -39,3 +41,14 @@ func Download(ctx context.Context, logger log.Logger, initialDir string) (downlo
 
 	return dl.GetFiles(ctx, initialDir, addrs)
 }
+
+// DownloadAdvanced retrieves SDN_ADVANCED.XML, which contains the SDN list with structured identity
+// documents, features and relationships. Use ReadAdvanced to parse the file.
+func DownloadAdvanced(ctx context.Context, logger log.Logger, initialDir string) (download.Files, error) {
+	dl := download.New(logger, nil)
+
+	addrs := map[string]string{
+		ofacAdvancedFilename: fmt.Sprintf(ofacURLTemplate, ofacAdvancedFilename),
+	}
+	return dl.GetFiles(ctx, initialDir, addrs)
+}
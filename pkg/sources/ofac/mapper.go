@@ -354,6 +354,9 @@ var (
 
 	// Refugee Documents
 	governmentIDRefugeeRegex = regexp.MustCompile(`(?i)Refugee\s+ID\s+(?:Card)?\s*([A-Z0-9]+)`)
+
+	// Bank Identifier Codes
+	governmentIDSWIFTRegex = regexp.MustCompile(`(?i)SWIFT/BIC\s+([A-Z0-9]{8,11})`)
 )
 
 // governmentIDPattern pairs an identifier regex with the lowercase keyword that
@@ -388,6 +391,7 @@ var governmentIDPatterns = []governmentIDPattern{
 	{"social", chinaUSCCRegex, search.GovernmentID{Type: search.GovernmentIDBusinessRegisration}},
 	{"birth", governmentIDBirthCertRegex, search.GovernmentID{Type: search.GovernmentIDBirthCert}},
 	{"refugee", governmentIDRefugeeRegex, search.GovernmentID{Type: search.GovernmentIDRefugee}},
+	{"swift", governmentIDSWIFTRegex, search.GovernmentID{Type: search.GovernmentIDSWIFT}},
 }
 
 // containsFold reports whether marker, which must be lowercase ASCII, appears in s
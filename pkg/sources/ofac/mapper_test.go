@@ -182,6 +182,14 @@ func TestParseGovernmentIDs(t *testing.T) {
 				{Name: "Unified Social Credit Code (USCC)", Type: "business-registration", Country: "China", Identifier: "91110000100009563N"},
 			},
 		},
+		{
+			name:    "SWIFT/BIC",
+			remarks: splitRemarks("SWIFT/BIC KDBKKPPY; Website www.example.com; alt. SWIFT/BIC FTBDKPPY."),
+			want: []search.GovernmentID{
+				{Name: "SWIFT/BIC", Type: "swift-bic", Identifier: "KDBKKPPY"},
+				{Name: "SWIFT/BIC", Type: "swift-bic", Identifier: "FTBDKPPY"},
+			},
+		},
 	}
 
 	for _, tt := range tests {
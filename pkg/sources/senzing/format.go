@@ -105,6 +105,9 @@ const (
 	RecordTypeOrganization = "ORGANIZATION"
 )
 
+// RelationshipOccupancy is the REL_POINTER_ROLE OpenSanctions uses for positions held by a PEP
+const RelationshipOccupancy = "Occupancy"
+
 // Feature field name constants used in the FEATURES array
 const (
 	FieldRecordType        = "RECORD_TYPE"
@@ -145,6 +148,11 @@ const (
 	FieldRelPointerDomain  = "REL_POINTER_DOMAIN"
 	FieldRelPointerKey     = "REL_POINTER_KEY"
 	FieldRelPointerRole    = "REL_POINTER_ROLE"
+
+	// FieldRelPointerFromDate and FieldRelPointerThruDate are when a relationship started and ended,
+	// such as the tenure of an Occupancy
+	FieldRelPointerFromDate = "REL_POINTER_FROM_DATE"
+	FieldRelPointerThruDate = "REL_POINTER_THRU_DATE"
 )
 
 // ExportOptions controls how entities are exported to Senzing format
@@ -33,4 +33,12 @@ func TestSenzing_US_Congress(t *testing.T) {
 	require.Nil(t, found.Vessel)
 
 	require.Equal(t, 1986, found.Person.BirthDate.Year()) // no other data in file
+
+	// Positions are anchored records in the same file
+	require.Equal(t, []string{search.TopicPEP}, found.Topics)
+	require.Nil(t, found.SanctionsInfo)
+	require.Equal(t, []search.Affiliation{{EntityName: "United States representative", Type: "Occupancy"}}, found.Affiliations)
+	require.Equal(t, []search.Position{{Title: "United States representative", Country: "United States"}}, found.Person.Positions)
+	require.True(t, found.InCategory(search.CategoryPEP))
+	require.False(t, found.InCategory(search.CategorySanctions))
 }
@@ -6,9 +6,11 @@ import (
 	"encoding/json"
 	"fmt"
 	"io"
+	"slices"
 	"strings"
 	"time"
 
+	"github.com/moov-io/watchman/internal/norm"
 	"github.com/moov-io/watchman/pkg/search"
 )
 
@@ -41,10 +43,19 @@ func ReadEntities(r io.Reader, sourceList search.SourceList) ([]search.Entity[se
 	}
 
 	// Detect format: JSON Array starts with '[', JSON Lines starts with '{'
+	var entities []search.Entity[search.Value]
 	if firstChar == '[' {
-		return readJSONArray(br, sourceList)
+		entities, err = readJSONArray(br, sourceList)
+	} else {
+		entities, err = readJSONLines(br, sourceList)
+	}
+	if err != nil {
+		return nil, err
 	}
-	return readJSONLines(br, sourceList)
+
+	resolveRelationships(entities)
+
+	return entities, nil
 }
 
 func readJSONArray(r io.Reader, sourceList search.SourceList) ([]search.Entity[search.Value], error) {
@@ -155,6 +166,13 @@ func ToWatchmanEntity(rec SenzingRecord, sourceList search.SourceList) (search.E
 	// Extract contact info
 	entity.Contact = extractContactInfo(normalized)
 
+	// Relationships and risk topics
+	entity.Affiliations = extractAffiliations(normalized)
+	entity.Topics = extractTopics(normalized)
+	if slices.Contains(entity.Topics, search.TopicSanction) {
+		entity.SanctionsInfo = &search.SanctionsInfo{}
+	}
+
 	return entity.Normalize(), nil
 }
 
@@ -500,3 +518,134 @@ func extractContactInfo(rec SenzingRecord) search.ContactInfo {
 
 	return info
 }
+
+// extractAffiliations returns the records this record points to. The pointer key is used as the
+// affiliation's name until resolveRelationships finds the record anchored at that key.
+func extractAffiliations(rec SenzingRecord) []search.Affiliation {
+	var out []search.Affiliation
+	add := func(key, role string) {
+		key = strings.TrimSpace(key)
+		if key == "" {
+			return
+		}
+		aff := search.Affiliation{
+			EntityName: key,
+			Type:       strings.TrimSpace(role),
+		}
+		if !slices.Contains(out, aff) {
+			out = append(out, aff)
+		}
+	}
+
+	for _, rel := range rec.Relationships {
+		add(rel[FieldRelPointerKey], rel[FieldRelPointerRole])
+	}
+	add(rec.RelPointerKey, rec.RelPointerRole)
+
+	return out
+}
+
+// anchorKeys returns the keys other records can point to this record with
+func anchorKeys(rec SenzingRecord) []string {
+	var out []string
+	for _, rel := range rec.Relationships {
+		if key := strings.TrimSpace(rel[FieldRelAnchorKey]); key != "" {
+			out = append(out, key)
+		}
+	}
+	if key := strings.TrimSpace(rec.RelAnchorKey); key != "" {
+		out = append(out, key)
+	}
+	return out
+}
+
+// resolveRelationships replaces the pointer keys of affiliations with the name of the record
+// anchored at that key within the same file. Keys are matched regardless of their domain.
+//
+// Occupancy relationships point at the positions a PEP holds, which are also added to the
+// person's Positions and Titles.
+func resolveRelationships(entities []search.Entity[search.Value]) {
+	anchors := make(map[string]int)
+	for i, entity := range entities {
+		rec, ok := entity.SourceData.(SenzingRecord)
+		if !ok || entity.Name == "" {
+			continue
+		}
+		for _, key := range anchorKeys(rec) {
+			anchors[key] = i
+		}
+	}
+	if len(anchors) == 0 {
+		return
+	}
+
+	for i := range entities {
+		for j, aff := range entities[i].Affiliations {
+			idx, exists := anchors[aff.EntityName]
+			if !exists {
+				continue
+			}
+			anchored := entities[idx]
+			entities[i].Affiliations[j].EntityName = anchored.Name
+
+			if person := entities[i].Person; person != nil && strings.EqualFold(aff.Type, RelationshipOccupancy) {
+				rec, _ := entities[i].SourceData.(SenzingRecord)
+				addPosition(person, anchored, relationshipTo(rec, aff.EntityName))
+			}
+		}
+	}
+}
+
+// relationshipTo returns the RELATIONSHIPS entry of rec pointing at key
+func relationshipTo(rec SenzingRecord, key string) RelationshipEntry {
+	for _, rel := range rec.Relationships {
+		if strings.TrimSpace(rel[FieldRelPointerKey]) == key {
+			return rel
+		}
+	}
+	return nil
+}
+
+// addPosition adds the position anchored at the other end of an Occupancy relationship. The tenure
+// is read from the relationship's REL_POINTER_FROM_DATE and REL_POINTER_THRU_DATE.
+func addPosition(person *search.Person, anchored search.Entity[search.Value], rel RelationshipEntry) {
+	var country string
+	if rec, ok := anchored.SourceData.(SenzingRecord); ok {
+		country = norm.Country(cmp.Or(rec.Nationality, rec.AddrCountry))
+	}
+	position := search.Position{
+		Title:   anchored.Name,
+		Country: country,
+		Start:   parseDate(rel[FieldRelPointerFromDate]),
+		End:     parseDate(rel[FieldRelPointerThruDate]),
+	}
+	same := func(p search.Position) bool {
+		return p.Title == position.Title && p.Country == position.Country &&
+			sameDate(p.Start, position.Start) && sameDate(p.End, position.End)
+	}
+	if !slices.ContainsFunc(person.Positions, same) {
+		person.Positions = append(person.Positions, position)
+	}
+	if !slices.Contains(person.Titles, position.Title) {
+		person.Titles = append(person.Titles, position.Title)
+	}
+}
+
+func sameDate(a, b *time.Time) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return a.Equal(*b)
+}
+
+// extractTopics returns the TOPIC of each RISKS entry, such as "sanction" or "role.pep"
+func extractTopics(rec SenzingRecord) []string {
+	var out []string
+	for _, risk := range rec.Risks {
+		topic := strings.TrimSpace(risk["TOPIC"])
+		if topic != "" && !slices.Contains(out, topic) {
+			out = append(out, topic)
+		}
+	}
+	return out
+}
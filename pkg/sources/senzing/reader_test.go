@@ -264,3 +264,36 @@ func TestReadEntities_InvalidJSON(t *testing.T) {
 	_, err = ReadEntities(strings.NewReader(`{"invalid": json}`), "test")
 	require.Error(t, err)
 }
+
+func TestReadEntities_RelationshipsAndRisks(t *testing.T) {
+	input := `{"DATA_SOURCE":"OS","RECORD_ID":"Q1","RECORD_TYPE":"PERSON","NAMES":[{"NAME_TYPE":"PRIMARY","NAME_FULL":"Jane Doe"}],"RISKS":[{"TOPIC":"role.pep"},{"TOPIC":"sanction"},{"TOPIC":"role.pep"}],"RELATIONSHIPS":[{"REL_POINTER_ROLE":"Occupancy","REL_POINTER_DOMAIN":"OPEN_SANCTIONS","REL_POINTER_KEY":"Q13218630","REL_POINTER_FROM_DATE":"2015-01-03","REL_POINTER_THRU_DATE":"2019-01-03"},{"REL_POINTER_ROLE":"Owner","REL_POINTER_DOMAIN":"OPEN_SANCTIONS","REL_POINTER_KEY":"NK-missing"}]}
+{"DATA_SOURCE":"OS","RECORD_ID":"Q13218630","NAMES":[{"NAME_TYPE":"PRIMARY","NAME_FULL":"United States representative"}],"COUNTRIES":[{"NATIONALITY":"us"}],"RELATIONSHIPS":[{"REL_ANCHOR_DOMAIN":"OPEN_SANCTIONS","REL_ANCHOR_KEY":"Q13218630"}]}
+{"DATA_SOURCE":"OS","RECORD_ID":"Q2","RECORD_TYPE":"ORGANIZATION","NAME_ORG":"Acme Corp","REL_POINTER_KEY":"Q1","REL_POINTER_ROLE":"Director"}`
+
+	entities, err := ReadEntities(strings.NewReader(input), "test")
+	require.NoError(t, err)
+	require.Len(t, entities, 3)
+
+	person := entities[0]
+	require.Equal(t, []string{"role.pep", "sanction"}, person.Topics)
+	require.NotNil(t, person.SanctionsInfo)
+
+	expected := []search.Affiliation{
+		{EntityName: "United States representative", Type: "Occupancy"},
+		{EntityName: "NK-missing", Type: "Owner"}, // not in the file
+	}
+	require.Equal(t, expected, person.Affiliations)
+
+	// Occupancies are the positions held by a PEP
+	start, end := time.Date(2015, time.January, 3, 0, 0, 0, 0, time.UTC), time.Date(2019, time.January, 3, 0, 0, 0, 0, time.UTC)
+	expectedPositions := []search.Position{{Title: "United States representative", Country: "United States", Start: &start, End: &end}}
+	require.Equal(t, expectedPositions, person.Person.Positions)
+	require.Equal(t, &end, person.Person.TenureEnded())
+	require.Equal(t, []string{"United States representative"}, person.Person.Titles)
+
+	// Q1 has no anchor, so the pointer to it is left unresolved
+	org := entities[2]
+	require.Equal(t, []search.Affiliation{{EntityName: "Q1", Type: "Director"}}, org.Affiliations)
+	require.Empty(t, org.Topics)
+	require.Nil(t, org.SanctionsInfo)
+}
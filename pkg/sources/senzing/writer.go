@@ -5,6 +5,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"io"
+	"slices"
 	"strings"
 
 	"github.com/moov-io/watchman/pkg/search"
@@ -19,6 +20,7 @@ func WriteEntities(w io.Writer, entities []search.Entity[search.Value], opts Exp
 		rec := FromWatchmanEntity(entity, opts.DataSource)
 		records = append(records, rec)
 	}
+	linkRecords(records, entities)
 
 	return writeSenzingRecords(w, records, opts)
 }
@@ -28,10 +30,13 @@ func WriteEntities(w io.Writer, entities []search.Entity[search.Value], opts Exp
 func WriteSearchedEntities(w io.Writer, entities []search.SearchedEntity[search.Value], opts ExportOptions) error {
 	records := make([]SenzingRecord, 0, len(entities))
 
+	plain := make([]search.Entity[search.Value], 0, len(entities))
 	for _, entity := range entities {
 		rec := FromWatchmanEntity(entity.Entity, opts.DataSource)
 		records = append(records, rec)
+		plain = append(plain, entity.Entity)
 	}
+	linkRecords(records, plain)
 
 	return writeSenzingRecords(w, records, opts)
 }
@@ -139,9 +144,67 @@ func FromWatchmanEntity(entity search.Entity[search.Value], defaultDataSource st
 		rec.Website = entity.Contact.Websites[0]
 	}
 
+	// Relationships point to the affiliated entity by name, WriteEntities replaces
+	// the name with the RECORD_ID of an affiliated entity in the same export.
+	for _, aff := range entity.Affiliations {
+		if aff.EntityName == "" {
+			continue
+		}
+		rec.Relationships = append(rec.Relationships, RelationshipEntry{
+			FieldRelPointerDomain: rec.DataSource,
+			FieldRelPointerKey:    aff.EntityName,
+			FieldRelPointerRole:   aff.Type,
+		})
+	}
+
+	// Risks
+	topics := entity.Topics
+	if entity.SanctionsInfo != nil && !slices.Contains(topics, search.TopicSanction) {
+		topics = append(slices.Clone(topics), search.TopicSanction)
+	}
+	for _, topic := range topics {
+		rec.Risks = append(rec.Risks, RiskEntry{"TOPIC": topic})
+	}
+
 	return rec
 }
 
+// linkRecords points relationships at the RECORD_ID of affiliated entities which are part of
+// the same export and anchors those records so readers can resolve them.
+func linkRecords(records []SenzingRecord, entities []search.Entity[search.Value]) {
+	ids := make(map[string]int)
+	for i, entity := range entities {
+		key := strings.ToLower(entity.Name)
+		if _, exists := ids[key]; !exists && records[i].RecordID != "" {
+			ids[key] = i
+		}
+	}
+
+	anchored := make(map[int]bool)
+	for i := range records {
+		for j, rel := range records[i].Relationships {
+			idx, exists := ids[strings.ToLower(rel[FieldRelPointerKey])]
+			if !exists || idx == i {
+				continue
+			}
+			target := records[idx]
+			records[i].Relationships[j] = RelationshipEntry{
+				FieldRelPointerDomain: target.DataSource,
+				FieldRelPointerKey:    target.RecordID,
+				FieldRelPointerRole:   rel[FieldRelPointerRole],
+			}
+			anchored[idx] = true
+		}
+	}
+
+	for idx := range anchored {
+		records[idx].Relationships = append(records[idx].Relationships, RelationshipEntry{
+			FieldRelAnchorDomain: records[idx].DataSource,
+			FieldRelAnchorKey:    records[idx].RecordID,
+		})
+	}
+}
+
 func populatePersonFields(rec *SenzingRecord, person *search.Person) {
 	// Try to parse name into components using simple heuristics
 	rec.NameFull = person.Name
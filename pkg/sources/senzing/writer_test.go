@@ -363,3 +363,55 @@ func TestRoundTrip(t *testing.T) {
 		require.Equal(t, entities[0].Addresses[0].City, reimported[0].Addresses[0].City)
 	}
 }
+
+func TestRoundTrip_RelationshipsAndRisks(t *testing.T) {
+	entities := []search.Entity[search.Value]{
+		{
+			Name:     "Jane Doe",
+			Type:     search.EntityPerson,
+			SourceID: "1",
+			Person:   &search.Person{Name: "Jane Doe"},
+			Affiliations: []search.Affiliation{
+				{EntityName: "Acme Corp", Type: "Director Of"},
+				{EntityName: "Unknown Holdings", Type: "Owner"},
+			},
+			Topics:        []string{search.TopicPEP},
+			SanctionsInfo: &search.SanctionsInfo{Programs: []string{"SDGT"}},
+		},
+		{
+			Name:     "Acme Corp",
+			Type:     search.EntityBusiness,
+			SourceID: "2",
+			Business: &search.Business{Name: "Acme Corp"},
+		},
+	}
+
+	var buf bytes.Buffer
+	err := WriteEntities(&buf, entities, ExportOptions{DataSource: "TEST", Format: "jsonl"})
+	require.NoError(t, err)
+
+	lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+	require.Len(t, lines, 2)
+
+	var jane, acme SenzingRecord
+	require.NoError(t, json.Unmarshal([]byte(lines[0]), &jane))
+	require.NoError(t, json.Unmarshal([]byte(lines[1]), &acme))
+
+	require.Equal(t, []RiskEntry{{"TOPIC": "role.pep"}, {"TOPIC": "sanction"}}, jane.Risks)
+	require.Equal(t, []RelationshipEntry{
+		{FieldRelPointerDomain: "TEST", FieldRelPointerKey: "2", FieldRelPointerRole: "Director Of"},
+		{FieldRelPointerDomain: "TEST", FieldRelPointerKey: "Unknown Holdings", FieldRelPointerRole: "Owner"},
+	}, jane.Relationships)
+	require.Equal(t, []RelationshipEntry{
+		{FieldRelAnchorDomain: "TEST", FieldRelAnchorKey: "2"},
+	}, acme.Relationships)
+
+	reimported, err := ReadEntities(&buf, "test")
+	require.NoError(t, err)
+	require.Len(t, reimported, 2)
+
+	require.Equal(t, entities[0].Affiliations, reimported[0].Affiliations)
+	require.Equal(t, []string{"role.pep", "sanction"}, reimported[0].Topics)
+	require.NotNil(t, reimported[0].SanctionsInfo)
+	require.Empty(t, reimported[1].Affiliations)
+}
@@ -21,6 +21,8 @@ var (
 		"CONS_COMMENTS.CSV", // Comments
 	}
 
+	advancedFilename = "CONS_ADVANCED.XML"
+
 	urlTemplate = func() string {
 		if v := os.Getenv("US_NON_SDN_DOWNLOAD_TEMPLATE"); v != "" {
 			return v
@@ -39,3 +41,13 @@ func Download(ctx context.Context, logger log.Logger, initialDir string) (downlo
 
 	return dl.GetFiles(ctx, initialDir, addrs)
 }
+
+// DownloadAdvanced retrieves CONS_ADVANCED.XML, which is read with ofac.ReadAdvanced.
+func DownloadAdvanced(ctx context.Context, logger log.Logger, initialDir string) (download.Files, error) {
+	dl := download.New(logger, nil)
+
+	addrs := map[string]string{
+		advancedFilename: fmt.Sprintf(urlTemplate, advancedFilename),
+	}
+	return dl.GetFiles(ctx, initialDir, addrs)
+}
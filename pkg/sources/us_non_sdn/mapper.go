@@ -0,0 +1,80 @@
+// Copyright The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package us_non_sdn
+
+import (
+	"strings"
+
+	"github.com/moov-io/watchman/pkg/search"
+	"github.com/moov-io/watchman/pkg/sources/ofac"
+)
+
+// subLists maps the program tags of Non-SDN entries to the list they're published on.
+// The names match the abbreviations used by the US CSL for the same lists.
+// Entries tagged with several programs are on the first list which matches.
+var subLists = []struct {
+	prefix  string
+	subList string
+}{
+	{"UKRAINE-EO13662", "SSI"},
+	{"FSE-", "FSE"},
+	{"NS-PLC", "PLC"},
+	{"561-", "CAP"},
+	{"CMIC-", "CMIC"},
+	{"ISA", "NS-ISA"},
+	{"CAATSA - RUSSIA", "NS-MBS"},
+	{"RUSSIA-EO14024", "NS-MBS"},
+}
+
+// listNames maps the names of lists in CONS_ADVANCED.XML, without their " List" suffix, onto
+// the same abbreviations.
+var listNames = map[string]string{
+	"SSI":          "SSI",
+	"FSE":          "FSE",
+	"NS-PLC":       "PLC",
+	"PLC":          "PLC",
+	"561":          "CAP",
+	"CAPTA":        "CAP",
+	"CAP":          "CAP",
+	"NON-SDN CMIC": "CMIC",
+	"CMIC":         "CMIC",
+	"NS-ISA":       "NS-ISA",
+	"NS-MBS":       "NS-MBS",
+}
+
+// SubList returns the Non-SDN list an entry with the given programs is on, such as "SSI"
+// for Sectoral Sanctions Identifications. An empty string is returned for unknown programs.
+func SubList(programs []string) string {
+	for _, list := range subLists {
+		for _, program := range programs {
+			if strings.HasPrefix(strings.ToUpper(strings.TrimSpace(program)), list.prefix) {
+				return list.subList
+			}
+		}
+	}
+	return ""
+}
+
+// WithSubList sets the SubList of entities read from the Non-SDN files.
+//
+// The list names of CONS_ADVANCED.XML are used when known, otherwise it's found from the programs.
+func WithSubList() ofac.MappingOverride {
+	return func(entity *search.Entity[search.Value]) {
+		switch data := entity.SourceData.(type) {
+		case ofac.SDN:
+			entity.SubList = SubList(data.Programs)
+
+		case ofac.AdvancedEntry:
+			for _, list := range data.Lists {
+				name := strings.ToUpper(strings.TrimSpace(strings.TrimSuffix(strings.TrimSpace(list), " List")))
+				if subList, found := listNames[name]; found {
+					entity.SubList = subList
+					return
+				}
+			}
+			entity.SubList = SubList(data.Programs)
+		}
+	}
+}
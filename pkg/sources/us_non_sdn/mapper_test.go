@@ -0,0 +1,87 @@
+// Copyright The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package us_non_sdn_test
+
+import (
+	"testing"
+
+	"github.com/moov-io/watchman/pkg/search"
+	"github.com/moov-io/watchman/pkg/sources/csl_us"
+	"github.com/moov-io/watchman/pkg/sources/ofac"
+	"github.com/moov-io/watchman/pkg/sources/us_non_sdn"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestSubList(t *testing.T) {
+	cases := []struct {
+		programs []string
+		expected string
+	}{
+		{[]string{"UKRAINE-EO13662"}, "SSI"},
+		{[]string{"UKRAINE-EO13662", "RUSSIA-EO14024"}, "SSI"},
+		{[]string{"RUSSIA-EO14024"}, "NS-MBS"},
+		{[]string{"CAATSA - RUSSIA"}, "NS-MBS"},
+		{[]string{"SDGT", "NS-PLC"}, "PLC"},
+		{[]string{"CMIC-EO13959"}, "CMIC"},
+		{[]string{"561-Related"}, "CAP"},
+		{[]string{"FSE-IR"}, "FSE"},
+		{[]string{"BURMA-EO14014"}, ""},
+		{nil, ""},
+	}
+	for _, tc := range cases {
+		require.Equal(t, tc.expected, us_non_sdn.SubList(tc.programs), tc.programs)
+	}
+}
+
+func TestWithSubList(t *testing.T) {
+	sdn := ofac.SDN{
+		EntityID: "9639",
+		SDNName:  "HANIYA, Ismail Abdul Salah",
+		SDNType:  "individual",
+		Programs: []string{"NS-PLC"},
+	}
+	entity := ofac.ToEntity(sdn, nil, nil, nil, ofac.WithSourceList(search.SourceUSNonSDN), us_non_sdn.WithSubList())
+	require.Equal(t, search.SourceUSNonSDN, entity.Source)
+	require.Equal(t, "PLC", entity.SubList)
+
+	advanced := ofac.AdvancedEntry{
+		Lists:    []string{"SSI List"},
+		Programs: []string{"UKRAINE-EO13662"},
+	}
+	entity = ofac.AdvancedToEntity(advanced, us_non_sdn.WithSubList())
+	require.Equal(t, "SSI", entity.SubList)
+}
+
+func TestWithSubList_MatchesCSL(t *testing.T) {
+	// Each Non-SDN list as it's named in the CSV programs, CONS_ADVANCED.XML and the US CSL
+	cases := []struct {
+		program   string
+		list      string
+		cslSource string
+	}{
+		{"UKRAINE-EO13662", "SSI List", "Sectoral Sanctions Identifications List (SSI) - Treasury Department"},
+		{"FSE-SY", "FSE List", "Foreign Sanctions Evaders (FSE) - Treasury Department"},
+		{"NS-PLC", "NS-PLC List", "Palestinian Legislative Council List (PLC) - Treasury Department"},
+		{"561-Related", "CAPTA List", "Capta List (CAP) - Treasury Department"},
+		{"CMIC-EO13959", "Non-SDN CMIC List", "Non-SDN Chinese Military-Industrial Complex Companies List (CMIC) - Treasury Department"},
+		{"ISA", "NS-ISA List", "Non-SDN Iran Sanctions Act List (NS-ISA) - Treasury Department"},
+		{"RUSSIA-EO14024", "NS-MBS List", "Non-SDN Menu-Based Sanctions List (NS-MBS List) - Treasury Department"},
+	}
+	for _, tc := range cases {
+		sdn := ofac.SDN{EntityID: "1", SDNName: "ACME", SDNType: "entity", Programs: []string{tc.program}}
+		fromCSV := ofac.ToEntity(sdn, nil, nil, nil, us_non_sdn.WithSubList()).SubList
+
+		// Programs aren't used when the list is known
+		advanced := ofac.AdvancedEntry{Lists: []string{tc.list}}
+		fromXML := ofac.AdvancedToEntity(advanced, us_non_sdn.WithSubList()).SubList
+
+		fromCSL := csl_us.ToEntity(csl_us.SanctionsEntry{Source: tc.cslSource, Type: "Entity", Name: "ACME"}).SubList
+
+		require.NotEmpty(t, fromCSV, tc.program)
+		require.Equal(t, fromCSV, fromXML, tc.list)
+		require.Equal(t, fromCSV, fromCSL, tc.cslSource)
+	}
+}